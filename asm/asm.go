@@ -1,6 +1,8 @@
 package asm
 
 import (
+	"fmt"
+
 	"github.com/mmcloughlin/avo/build"
 	"github.com/mmcloughlin/avo/ir"
 	"github.com/mmcloughlin/avo/pass"
@@ -18,9 +20,30 @@ func Compile(ctx *build.Context) (*ir.File, error) {
 		return nil, err
 	}
 
+	// Functions that use the base pointer register need a stack frame, so that
+	// the assembler saves and restores it.
+	for _, fn := range f.Functions() {
+		if fn.LocalSize == 0 && UsesBasePointer(fn) {
+			fn.AllocLocal(8)
+		}
+	}
+
 	return f, nil
 }
 
+// UsesBasePointer reports whether the compiled function fn references the base
+// pointer register.
+func UsesBasePointer(fn *ir.Function) bool {
+	for _, i := range fn.Instructions() {
+		for _, r := range i.Registers() {
+			if r.ID() == reg.RBP.ID() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Zero64 returns a 64-bit register initialized to zero.
 func Zero64(ctx *build.Context) reg.Register {
 	zero := ctx.GP64()
@@ -39,3 +62,33 @@ func IsRegisterName(name string) bool {
 	}
 	return false
 }
+
+// ISA identifies the instruction set generated code may assume.
+type ISA int
+
+// Supported instruction sets.
+const (
+	// Baseline is the instruction set available on all amd64 processors.
+	Baseline ISA = iota
+
+	// ADX extends Baseline with the BMI2 MULX instruction and the ADX
+	// instructions ADCX and ADOX, which allow multi-precision arithmetic with
+	// two independent carry chains.
+	ADX
+)
+
+// ISAs lists all supported instruction sets.
+var ISAs = []ISA{Baseline, ADX}
+
+// String returns the name of the instruction set. This is also used as a
+// suffix for functions specialized to it.
+func (i ISA) String() string {
+	switch i {
+	case Baseline:
+		return "Baseline"
+	case ADX:
+		return "ADX"
+	default:
+		return fmt.Sprintf("ISA(%d)", int(i))
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/mmcloughlin/ec3/asm"
 	"github.com/mmcloughlin/ec3/asm/fp"
 	"github.com/mmcloughlin/ec3/asm/mp"
-	"github.com/mmcloughlin/ec3/internal/errutil"
 	"github.com/mmcloughlin/ec3/internal/ints"
 	"github.com/mmcloughlin/ec3/prime"
 )
@@ -19,6 +18,18 @@ func New(p prime.Crandall) fp.Field {
 	return Field{p: p}
 }
 
+// Supported reports whether fields modulo p can be built by this package.
+// Reduction requires the reduction multiplier to fit in a sign-extended 32-bit
+// immediate. See ReductionMultiplier.
+func Supported(p prime.Crandall) bool {
+	if p.C <= 0 {
+		return false
+	}
+	l := ints.NextMultiple(p.Bits(), 64)
+	d := new(big.Int).Lsh(big.NewInt(int64(p.C)), uint(l-p.Bits()))
+	return d.BitLen() < 32
+}
+
 type Field struct {
 	p prime.Crandall
 }
@@ -58,16 +69,28 @@ func (f Field) ReductionMultiplier() uint32 {
 	return uint32((1 << uint(l-n)) * f.p.C)
 }
 
-func (f Field) Build(ctx *build.Context) fp.Builder {
+func (f Field) Build(ctx *build.Context, isa asm.ISA) fp.Builder {
 	return &builder{
 		Field:   f,
 		Context: ctx,
+		isa:     isa,
 	}
 }
 
 type builder struct {
 	Field
 	*build.Context
+	isa asm.ISA
+}
+
+// adc adds x into y with carry, using ADCX where available so that the
+// overflow flag is preserved.
+func (b builder) adc(x, y operand.Op) {
+	if b.isa == asm.ADX {
+		b.ADCXQ(x, y)
+	} else {
+		b.ADCQ(x, y)
+	}
 }
 
 func (b builder) Add(x, y mp.Int) {
@@ -84,7 +107,7 @@ func (b builder) Add(x, y mp.Int) {
 	// Add y into x.
 	b.ADDQ(y[0], x[0]) // TODO(mbm): can we replace this with `ADCX`? need to ensure the carry flag is 0
 	for i := 1; i < k; i++ {
-		b.ADCXQ(y[i], x[i])
+		b.adc(y[i], x[i])
 	}
 
 	// Both inputs are < 2ˡ so the result is < 2ˡ⁺¹.
@@ -98,7 +121,7 @@ func (b builder) Add(x, y mp.Int) {
 	// Now add the addend into x.
 	b.ADDQ(addend, x[0]) // TODO(mbm): replace with ADCX?
 	for i := 1; i < k; i++ {
-		b.ADCXQ(zero, x[i])
+		b.adc(zero, x[i])
 	}
 
 	// We have added d into the low l bits. Therefore the result is less than 2ˡ + d.
@@ -114,16 +137,45 @@ func (b builder) Add(x, y mp.Int) {
 	// will be no carry.
 	// TODO(mbm): assert d is within an acceptable range
 	b.ADDQ(addend, x[0]) // TODO(mbm): replace with ADCX?
+
+	b.reduce(x)
 }
 
 func (b *builder) Sub(x, y mp.Int) {
-	// TODO(mbm): implement subtraction in Crandall fields.
-	panic(errutil.ErrNotImplemented)
+	k := b.Limbs()
+
+	// Prepare a zero register.
+	zero := asm.Zero64(b.Context)
+
+	// Load reduction multiplier.
+	d := b.ReductionMultiplier()
+	dreg := b.GP64()
+	b.MOVQ(operand.U32(d), dreg)
+
+	// Subtract y from x.
+	b.SUBQ(y[0], x[0])
+	for i := 1; i < k; i++ {
+		b.SBBQ(y[i], x[i])
+	}
+
+	// If the subtraction borrowed, x now holds x - y + 2ˡ, which is congruent
+	// to x - y + d. Therefore we need to subtract d. Since x, y < p the result
+	// of the subtraction is at least 2ˡ - p, which exceeds d, so this cannot
+	// borrow again.
+	subtrahend := b.GP64()
+	b.MOVQ(zero, subtrahend)
+	b.CMOVQCS(dreg, subtrahend)
+
+	b.SUBQ(subtrahend, x[0])
+	for i := 1; i < k; i++ {
+		b.SBBQ(zero, x[i])
+	}
+
+	b.reduce(x)
 }
 
 // ReduceDouble computes z congruent to x modulo p. Let the element size be 2ˡ.
-// This function assumes x < 2²ˡ and produces z < 2ˡ. Note that z is not
-// guaranteed to be less than p.
+// This function assumes x < 2²ˡ and produces z < p.
 func (b builder) ReduceDouble(z, x mp.Int) {
 	k := b.Limbs()
 
@@ -146,20 +198,43 @@ func (b builder) ReduceDouble(z, x mp.Int) {
 	// additional limb.
 
 	// Multiply r = d*H.
-	r := mp.NewIntLimb64(b.Context, k+1)
-	b.MOVQ(dreg, reg.RDX)
-	b.XORQ(r[0], r[0]) // also clears flags
-	for i := 0; i < k; i++ {
-		lo := b.GP64()
-		b.MULXQ(x[i+k], lo, r[i+1])
-		b.ADCXQ(lo, r[i])
-	}
-
-	// Add r += x.
-	for i := 0; i < k; i++ {
-		b.ADOXQ(x[i], r[i])
+	var r mp.Int
+	if b.isa == asm.ADX {
+		r = mp.NewIntLimb64(b.Context, k+1)
+		b.MOVQ(dreg, reg.RDX)
+		b.XORQ(r[0], r[0]) // also clears flags
+		for i := 0; i < k; i++ {
+			lo := b.GP64()
+			b.MULXQ(x[i+k], lo, r[i+1])
+			b.ADCXQ(lo, r[i])
+		}
+
+		// Add r += x.
+		for i := 0; i < k; i++ {
+			b.ADOXQ(x[i], r[i])
+		}
+		b.ADOXQ(zero, r[k])
+	} else {
+		// Without a second carry chain, accumulate d*H directly into a copy of
+		// the low limbs of x, carrying the high word of each product into the
+		// next.
+		r = mp.NewIntLimb64(b.Context, k)
+		mp.Copy(b.Context, r, x[:k])
+		var carry operand.Op
+		for i := 0; i < k; i++ {
+			b.MOVQ(dreg, reg.RAX)
+			b.MULQ(x[i+k])
+			b.ADDQ(reg.RAX, r[i])
+			b.ADCQ(operand.U32(0), reg.RDX)
+			if carry != nil {
+				b.ADDQ(carry, r[i])
+				b.ADCQ(operand.U32(0), reg.RDX)
+			}
+			carry = b.GP64()
+			b.MOVQ(reg.RDX, carry)
+		}
+		r = r.Extend(carry)
 	}
-	b.ADOXQ(zero, r[k])
 
 	// Stage 2: (d+1)*2ˡ → 2ˡ + (d+1)*d
 	//
@@ -170,10 +245,10 @@ func (b builder) ReduceDouble(z, x mp.Int) {
 	// TODO(mbm): assert d is within an acceptable range
 
 	top := r[k]
-	b.IMULQ(dreg, top) // clears flags
-	b.ADCXQ(top, r[0])
+	b.IMULQ(dreg, top)
+	b.ADDQ(top, r[0])
 	for i := 1; i < k; i++ {
-		b.ADCXQ(zero, r[i])
+		b.adc(zero, r[i])
 	}
 
 	// Stage 3: finish
@@ -185,8 +260,75 @@ func (b builder) ReduceDouble(z, x mp.Int) {
 	b.CMOVQCS(dreg, addend)
 	b.ADDQ(addend, r[0])
 
-	// Write out the result.
+	// Fully reduce and write out the result.
+	r = r[:k]
+	b.reduce(r)
 	for i := 0; i < k; i++ {
 		b.MOVQ(r[i], z[i])
 	}
 }
+
+// reduce fully reduces x < 2ˡ modulo p, in place.
+func (b builder) reduce(x mp.Int) {
+	k := b.Limbs()
+	n := b.p.Bits()
+	s := b.ElementBits() - n
+
+	// If the prime is not on a limb boundary, fold the bits above 2ⁿ into the
+	// low bits. Write x = 2ⁿ * H + L, then
+	//
+	//	x ≡ c*H + L (mod p)
+	//
+	// Since H < 2ˢ the result is less than 2ⁿ + d, where d is the reduction
+	// multiplier.
+	if s > 0 {
+		h := b.GP64()
+		b.MOVQ(x[k-1], h)
+		b.SHRQ(operand.U8(64-s), h)
+		b.IMUL3Q(operand.U32(b.p.C), h, h)
+
+		// Clear the high bits.
+		b.SHLQ(operand.U8(s), x[k-1])
+		b.SHRQ(operand.U8(s), x[k-1])
+
+		b.ADDQ(h, x[0])
+		for i := 1; i < k; i++ {
+			b.ADCQ(operand.U32(0), x[i])
+		}
+	}
+
+	// Now x < 2p, so at most one subtraction of p is required. Note that
+	//
+	//	x + c = x - p + 2ⁿ
+	//
+	// Therefore x ⩾ p exactly when x + c ⩾ 2ⁿ, in which case the result is
+	// x + c - 2ⁿ. Determine the condition first, keeping only the top limb of
+	// the sum. Bit n is the carry out of the top limb if n is on a limb
+	// boundary.
+	t := b.GP64()
+	b.MOVQ(x[0], t)
+	b.ADDQ(operand.U32(b.p.C), t)
+	for i := 1; i < k; i++ {
+		b.MOVQ(x[i], t)
+		b.ADCQ(operand.U32(0), t)
+	}
+	if s > 0 {
+		b.BTQ(operand.U8(64-s), t)
+	}
+
+	// Add c if the condition holds.
+	addend := b.GP64()
+	b.SBBQ(addend, addend)
+	b.ANDQ(operand.U32(b.p.C), addend)
+	b.ADDQ(addend, x[0])
+	for i := 1; i < k; i++ {
+		b.ADCQ(operand.U32(0), x[i])
+	}
+
+	// Subtract 2ⁿ. When n is on a limb boundary this is implicit in the
+	// overflow of the top limb. Otherwise clear bit n, which is only set if
+	// the condition holds, since p < 2ⁿ.
+	if s > 0 {
+		b.BTRQ(operand.U8(64-s), x[k-1])
+	}
+}
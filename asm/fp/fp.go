@@ -5,6 +5,7 @@ import (
 
 	"github.com/mmcloughlin/avo/build"
 
+	"github.com/mmcloughlin/ec3/asm"
 	"github.com/mmcloughlin/ec3/asm/mp"
 )
 
@@ -26,7 +27,9 @@ type Properties interface {
 type Field interface {
 	Properties
 
-	Build(*build.Context) Builder
+	// Build returns a builder generating code in the given context, with
+	// instructions from the instruction set isa.
+	Build(ctx *build.Context, isa asm.ISA) Builder
 }
 
 type Builder interface {
@@ -45,3 +48,13 @@ type Builder interface {
 	// guaranteed to be less than p.
 	ReduceDouble(z, x mp.Int)
 }
+
+// Multiplier is an optional interface for builders that implement field
+// multiplication directly, rather than with a double-width product followed by
+// ReduceDouble.
+type Multiplier interface {
+	// Mul generates code to multiply x and y modulo p, returning the result
+	// in registers. The result has the same representation as that produced by
+	// a double-width product followed by ReduceDouble.
+	Mul(x, y mp.Int) mp.Int
+}
@@ -1,11 +1,13 @@
 package mont
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/mmcloughlin/avo/build"
 	"github.com/mmcloughlin/avo/operand"
 	"github.com/mmcloughlin/avo/reg"
+	"golang.org/x/xerrors"
 
 	"github.com/mmcloughlin/ec3/asm"
 	"github.com/mmcloughlin/ec3/asm/fp"
@@ -23,13 +25,70 @@ import (
 //	[hac:impl]           Alfred J. Menezes, Paul C. van Oorschot and Scott A. Vanstone. Efficient
 //	                     Implementation. Handbook of Applied Cryptography, chapter 14. 1996.
 //	                     http://cacr.uwaterloo.ca/hac/about/chap14.pdf
+//	[montgomeryanalysis] Cetin Kaya Koc, Tolga Acar and Burton S. Kaliski Jr. Analyzing and Comparing
+//	                     Montgomery Multiplication Algorithms. IEEE Micro, 16(3):26-33. 1996.
+//	                     https://pdfs.semanticscholar.org/5e39/41ff482ec3ee41dc53c3298f0be085c69483.pdf
+
+// Multiplication selects the implementation of field multiplication.
+type Multiplication int
+
+// Supported multiplication implementations.
+const (
+	// Separated computes the double-width product before performing
+	// Montgomery reduction on it.
+	Separated Multiplication = iota
+
+	// Interleaved alternates multiplication and reduction steps limb by limb,
+	// following the Coarsely Integrated Operand Scanning (CIOS) method of
+	// [montgomeryanalysis]. The accumulator is only k+2 limbs, so it can be kept
+	// in registers. Fields with more than MaxInterleavedLimbs limbs, and code
+	// for the baseline instruction set, fall back to Separated.
+	Interleaved
+)
+
+// multiplications maps names to multiplication implementations.
+var multiplications = map[string]Multiplication{
+	"separated":   Separated,
+	"interleaved": Interleaved,
+}
+
+// ParseMultiplication looks up a multiplication implementation by name.
+func ParseMultiplication(s string) (Multiplication, error) {
+	m, ok := multiplications[s]
+	if !ok {
+		return 0, xerrors.Errorf("unknown multiplication %q", s)
+	}
+	return m, nil
+}
+
+func (m Multiplication) String() string {
+	for s, n := range multiplications {
+		if n == m {
+			return s
+		}
+	}
+	return fmt.Sprintf("Multiplication(%d)", int(m))
+}
+
+// MaxInterleavedLimbs is the largest field size supported by Interleaved
+// multiplication, limited by the number of general purpose registers.
+const MaxInterleavedLimbs = 6
 
+// New builds a Montgomery field for the prime p, with Separated
+// multiplication.
 func New(p prime.Prime) fp.Field {
-	return Field{p: p}
+	return NewWithMultiplication(p, Separated)
+}
+
+// NewWithMultiplication builds a Montgomery field for the prime p with the
+// given multiplication implementation.
+func NewWithMultiplication(p prime.Prime, m Multiplication) fp.Field {
+	return Field{p: p, mul: m}
 }
 
 type Field struct {
-	p prime.Prime
+	p   prime.Prime
+	mul Multiplication
 }
 
 func (f Field) Prime() *big.Int {
@@ -49,16 +108,22 @@ func (f Field) Limbs() int {
 	return f.ElementBits() / 64
 }
 
-func (f Field) Build(ctx *build.Context) fp.Builder {
-	return &builder{
+func (f Field) Build(ctx *build.Context, isa asm.ISA) fp.Builder {
+	b := &builder{
 		Field:   f,
 		Context: ctx,
+		isa:     isa,
+	}
+	if f.mul == Interleaved && f.Limbs() <= MaxInterleavedLimbs && isa == asm.ADX {
+		return interleaved{b}
 	}
+	return b
 }
 
 type builder struct {
 	Field
 	*build.Context
+	isa asm.ISA
 
 	modulus mp.Int
 	mprime  operand.Op
@@ -175,6 +240,10 @@ func (b *builder) ModulusPrime() operand.Op {
 func (b *builder) ReduceDouble(z, x mp.Int) {
 	// Reduction is performed with multi-word Montgomery reduction. See [hac:impl]
 	// Algorithm 14.32.
+	if b.isa != asm.ADX {
+		b.reducedoublebaseline(z, x)
+		return
+	}
 
 	k := b.Limbs()
 
@@ -227,3 +296,137 @@ func (b *builder) ReduceDouble(z, x mp.Int) {
 		b.MOVQ(result[i], z[i])
 	}
 }
+
+// reducedoublebaseline implements ReduceDouble with the baseline instruction
+// set, following the same algorithm with a single carry chain.
+func (b *builder) reducedoublebaseline(z, x mp.Int) {
+	k := b.Limbs()
+
+	// Set up accumulator registers.
+	acc := mp.NewIntLimb64(b.Context, 2*k+1)
+	mp.Copy(b.Context, acc, x[:k])
+
+	// Step 2: iterate over limbs. The carry out of limb i+k is kept pending
+	// for the next iteration.
+	var pending operand.Op
+	for i := 0; i < k; i++ {
+		// Step 2.1: u_i = x_i * m' (mod b)
+		u := b.GP64()
+		b.MOVQ(acc[i], u)
+		if !b.IsFriendly() {
+			b.IMULQ(b.ModulusPrime(), u)
+		}
+
+		// Step 2.2: x += u_i * m * b^i
+		b.MOVQ(x.Limb(i+k), acc[i+k])
+		m := b.Modulus()
+		var carry operand.Op
+		for j := 0; j < k; j++ {
+			b.MOVQ(m[j], reg.RAX)
+			b.MULQ(u)
+			b.ADDQ(reg.RAX, acc[i+j])
+			b.ADCQ(operand.U32(0), reg.RDX)
+			if carry != nil {
+				b.ADDQ(carry, acc[i+j])
+				b.ADCQ(operand.U32(0), reg.RDX)
+			}
+			carry = b.GP64()
+			b.MOVQ(reg.RDX, carry)
+		}
+
+		next := asm.Zero64(b.Context)
+		b.ADDQ(carry, acc[i+k])
+		b.ADCQ(operand.U32(0), next)
+		if pending != nil {
+			b.ADDQ(pending, acc[i+k])
+			b.ADCQ(operand.U32(0), next)
+		}
+		pending = next
+	}
+	acc[2*k] = pending
+
+	// Step 4: if x ⩾ m subtract m
+	result := acc[k:]
+	b.ConditionalSubtractModulus(result)
+
+	// Write result.
+	for i := 0; i < k; i++ {
+		b.MOVQ(result[i], z[i])
+	}
+}
+
+// interleaved is a builder that implements fp.Multiplier with Interleaved
+// multiplication.
+type interleaved struct {
+	*builder
+}
+
+// Mul computes the Montgomery product of x and y with the CIOS method. See
+// [montgomeryanalysis] Section 4.
+func (b interleaved) Mul(x, y mp.Int) mp.Int {
+	k := b.Limbs()
+	p := b.Modulus()
+
+	// The accumulator is a window of k+2 limbs sliding up through acc. At the
+	// start of iteration i it occupies limbs i to i+k+1.
+	acc := make([]operand.Op, 2*k+2)
+	zero := b.GP64()
+
+	for i := 0; i < k; i++ {
+		// Step 1: acc += x * y[i] * bⁱ
+		b.Commentf("Multiply by y[%d].", i)
+		b.MOVQ(y[i], reg.RDX)
+		b.muladd(acc, zero, x, i)
+
+		// Step 2: acc += u * p * bⁱ where u = acc[i] * m' (mod b), which clears
+		// limb i.
+		b.Commentf("Reduce limb %d.", i)
+		b.MOVQ(acc[i], reg.RDX)
+		if !b.IsFriendly() {
+			b.IMULQ(b.ModulusPrime(), reg.RDX)
+		}
+		b.muladd(acc, zero, p, i)
+	}
+
+	// The result is less than 2p, so at most one subtraction is required.
+	result := acc[k : 2*k+1]
+	b.ConditionalSubtractModulus(result)
+
+	return result[:k]
+}
+
+// muladd adds RDX * x * bⁱ to the accumulator, allocating limbs as required.
+// Nil limbs of the accumulator are treated as zero.
+func (b interleaved) muladd(acc []operand.Op, zero reg.GPVirtual, x mp.Int, i int) {
+	k := len(x)
+	top := i + k + 1
+	if acc[top] == nil {
+		acc[top] = b.GP64()
+		b.XORQ(acc[top], acc[top])
+	}
+
+	// Low halves of products are added with the carry flag, and high halves with
+	// the overflow flag.
+	b.XORQ(zero, zero) // clears flags
+	for j := 0; j < k; j++ {
+		lo, hi := b.GP64(), b.GP64()
+		b.MULXQ(x[j], lo, hi)
+
+		if acc[i+j] == nil {
+			acc[i+j] = lo
+		} else {
+			b.ADCXQ(lo, acc[i+j])
+		}
+
+		if acc[i+j+1] == nil {
+			acc[i+j+1] = hi
+		} else {
+			b.ADOXQ(hi, acc[i+j+1])
+		}
+	}
+
+	// Propagate both carry chains into the top limbs.
+	b.ADCXQ(zero, acc[top-1])
+	b.ADCXQ(zero, acc[top])
+	b.ADOXQ(zero, acc[top])
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/mmcloughlin/avo/reg"
 	"golang.org/x/xerrors"
 
+	"github.com/mmcloughlin/ec3/asm"
 	"github.com/mmcloughlin/ec3/internal/bigint"
 )
 
@@ -131,8 +132,19 @@ func ConditionalMove(ctx *build.Context, y, x Int, c operand.Op) {
 	}
 }
 
-// Mul does a full multiply z = x*y.
-func Mul(ctx *build.Context, z, x, y Int) {
+// Mul does a full multiply z = x*y, with instructions from the given
+// instruction set.
+func Mul(ctx *build.Context, isa asm.ISA, z, x, y Int) {
+	switch isa {
+	case asm.ADX:
+		muladx(ctx, z, x, y)
+	default:
+		mulbaseline(ctx, z, x, y)
+	}
+}
+
+// muladx implements Mul with the ADX instruction set.
+func muladx(ctx *build.Context, z, x, y Int) {
 	// TODO(mbm): multi-precision multiply is ugly
 
 	acc := make([]operand.Op, len(z))
@@ -141,56 +153,254 @@ func Mul(ctx *build.Context, z, x, y Int) {
 	for j := 0; j < len(y); j++ {
 		ctx.Commentf("y[%d]", j)
 		ctx.MOVQ(y[j], reg.RDX)
-		ctx.XORQ(zero, zero) // clears flags
-		carryinto := [2]int{-1, -1}
-		for i := 0; i < len(x); i++ {
-			k := i + j
-			ctx.Commentf("x[%d] * y[%d] -> z[%d]", i, j, k)
-
-			// Determine where the results should go.
-			var product [2]operand.Op
-			var add [2]bool
-			for b := 0; b < 2; b++ {
-				if acc[k+b] == nil {
-					acc[k+b] = ctx.GP64()
-					product[b] = acc[k+b]
-				} else {
-					product[b] = ctx.GP64()
-					add[b] = true
-				}
-			}
+		muladd(ctx, acc, zero, x, 0, j)
+		ctx.MOVQ(acc[j], z[j])
+	}
+
+	for j := len(y); j < len(z); j++ {
+		ctx.MOVQ(acc[j], z[j])
+	}
+}
+
+// mulbaseline implements Mul with the baseline instruction set.
+func mulbaseline(ctx *build.Context, z, x, y Int) {
+	acc := make([]operand.Op, len(z))
+
+	for j := 0; j < len(y); j++ {
+		ctx.Commentf("y[%d]", j)
+		muladdbaseline(ctx, acc, y[j], x, 0, j)
+		ctx.MOVQ(acc[j], z[j])
+	}
 
-			// Do the multiply.
-			ctx.MULXQ(x[i], product[0], product[1])
+	for j := len(y); j < len(z); j++ {
+		ctx.MOVQ(acc[j], z[j])
+	}
+}
 
-			// Do the adds.
-			if add[0] {
-				ctx.ADCXQ(product[0], acc[k])
-				carryinto[0] = k + 1
+// Sqr does a full square z = x², with instructions from the given instruction
+// set. The cross products xᵢ*xⱼ for i < j are computed once and doubled, then
+// the squares xᵢ² are added on the diagonal. The output z must not overlap x.
+func Sqr(ctx *build.Context, isa asm.ISA, z, x Int) {
+	switch isa {
+	case asm.ADX:
+		sqradx(ctx, z, x)
+	default:
+		sqrbaseline(ctx, z, x)
+	}
+}
+
+// sqradx implements Sqr with the ADX instruction set.
+func sqradx(ctx *build.Context, z, x Int) {
+	k := len(x)
+	acc := make([]operand.Op, 2*k)
+	zero := ctx.GP64()
+
+	// Cross products. Row i contributes to limbs 2i+1 up to i+k, so limbs up
+	// to 2i+2 are complete after it. For larger sizes these are written out
+	// to z early to relieve register pressure.
+	spill := k > sqrregisterlimbs
+	for i := 0; i+1 < k; i++ {
+		ctx.Commentf("x[%d] * x[%d:]", i, i+1)
+		ctx.MOVQ(x[i], reg.RDX)
+		muladd(ctx, acc, zero, x, i+1, i)
+		if spill {
+			for _, l := range []int{2*i + 1, 2*i + 2} {
+				ctx.MOVQ(acc[l], z[l])
+				acc[l] = z[l]
+			}
+		}
+	}
+
+	// Double the cross products with the carry flag chain, and add the
+	// diagonal terms with the overflow flag chain.
+	ctx.Comment("Double cross products and add squares.")
+	ctx.XORQ(zero, zero) // clears flags
+	diagonal := func(limb int, t operand.Op) {
+		switch {
+		case limb == 0:
+			// No cross products.
+		case limb == 2*k-1:
+			// No cross products, but may receive the carry from doubling.
+			ctx.ADCXQ(zero, t)
+			ctx.ADOXQ(zero, t)
+		default:
+			r := acc[limb]
+			if spill {
+				r = ctx.GP64()
+				ctx.MOVQ(acc[limb], r)
 			}
-			if add[1] {
-				ctx.ADOXQ(product[1], acc[k+1])
-				carryinto[1] = k + 2
+			ctx.ADCXQ(r, r)
+			ctx.ADOXQ(t, r)
+			t = r
+		}
+		ctx.MOVQ(t, z[limb])
+	}
+
+	for i := 0; i < k; i++ {
+		ctx.Commentf("x[%d]²", i)
+		ctx.MOVQ(x[i], reg.RDX)
+		lo, hi := ctx.GP64(), ctx.GP64()
+		ctx.MULXQ(reg.RDX, lo, hi)
+		diagonal(2*i, lo)
+		diagonal(2*i+1, hi)
+	}
+}
+
+// sqrbaseline implements Sqr with the baseline instruction set.
+func sqrbaseline(ctx *build.Context, z, x Int) {
+	k := len(x)
+	acc := make([]operand.Op, 2*k)
+
+	// Cross products, spilled to z as in the ADX version. Note that for larger
+	// sizes this means all cross products end up in memory.
+	spill := k > sqrregisterlimbs
+	for i := 0; i+1 < k; i++ {
+		ctx.Commentf("x[%d] * x[%d:]", i, i+1)
+		muladdbaseline(ctx, acc, x[i], x, i+1, i)
+		if spill {
+			for _, l := range []int{2*i + 1, 2*i + 2} {
+				ctx.MOVQ(acc[l], z[l])
+				acc[l] = z[l]
 			}
 		}
+	}
 
-		if carryinto[0] > 0 {
-			ctx.ADCXQ(zero, acc[carryinto[0]])
+	// Double the cross products. Without a second carry flag this is a
+	// separate pass, carrying into the top limb.
+	ctx.Comment("Double cross products.")
+	top := ctx.GP64()
+	ctx.XORQ(top, top) // clears flags
+	for l := 1; l < 2*k-1; l++ {
+		if !spill {
+			ctx.ADCQ(acc[l], acc[l])
+			continue
 		}
-		if carryinto[1] > 0 {
-			ctx.ADOXQ(zero, acc[carryinto[1]])
+		r := ctx.GP64()
+		ctx.MOVQ(acc[l], r)
+		ctx.ADCQ(r, r)
+		ctx.MOVQ(r, acc[l])
+	}
+	ctx.ADCQ(operand.U32(0), top)
+	acc[2*k-1] = top
+
+	// Add the squares. The multiply clobbers flags, so the carry between
+	// iterations is saved in a register as 0 or -1 and restored with NEGQ.
+	// Spilled limbs are already in place in z.
+	ctx.Comment("Add squares.")
+	store := func(l int) {
+		if acc[l] != z[l] {
+			ctx.MOVQ(acc[l], z[l])
 		}
+	}
+	carry := ctx.GP64()
+	for i := 0; i < k; i++ {
+		ctx.Commentf("x[%d]²", i)
+		ctx.MOVQ(x[i], reg.RAX)
+		ctx.MULQ(reg.RAX)
+		if i == 0 {
+			ctx.MOVQ(reg.RAX, z[0])
+			ctx.ADDQ(reg.RDX, acc[1])
+		} else {
+			ctx.NEGQ(carry)
+			ctx.ADCQ(reg.RAX, acc[2*i])
+			ctx.ADCQ(reg.RDX, acc[2*i+1])
+			store(2 * i)
+		}
+		if i+1 < k {
+			ctx.SBBQ(carry, carry)
+		}
+		store(2*i + 1)
+	}
+}
 
-		//
-		ctx.MOVQ(acc[j], z[j])
+// sqrregisterlimbs is the largest input size for which Sqr keeps all cross
+// products in registers.
+const sqrregisterlimbs = 4
+
+// muladd adds RDX times the limbs of x from index from onwards into the
+// accumulator acc, with the product of x[i] going to limb i+shift. Limbs of acc
+// are allocated as required; nil limbs are treated as zero. The zero register
+// is used to clear flags.
+func muladd(ctx *build.Context, acc []operand.Op, zero reg.GPVirtual, x Int, from, shift int) {
+	ctx.XORQ(zero, zero) // clears flags
+	carryinto := [2]int{-1, -1}
+	for i := from; i < len(x); i++ {
+		k := i + shift
+		ctx.Commentf("x[%d] * RDX -> acc[%d]", i, k)
+
+		// Determine where the results should go.
+		var product [2]operand.Op
+		var add [2]bool
+		for b := 0; b < 2; b++ {
+			if acc[k+b] == nil {
+				acc[k+b] = ctx.GP64()
+				product[b] = acc[k+b]
+			} else {
+				product[b] = ctx.GP64()
+				add[b] = true
+			}
+		}
+
+		// Do the multiply.
+		ctx.MULXQ(x[i], product[0], product[1])
+
+		// Do the adds.
+		if add[0] {
+			ctx.ADCXQ(product[0], acc[k])
+			carryinto[0] = k + 1
+		}
+		if add[1] {
+			ctx.ADOXQ(product[1], acc[k+1])
+			carryinto[1] = k + 2
+		}
 	}
 
-	for j := len(y); j < len(z); j++ {
-		ctx.MOVQ(acc[j], z[j])
+	if carryinto[0] > 0 {
+		ctx.ADCXQ(zero, acc[carryinto[0]])
+	}
+	if carryinto[1] > 0 {
+		ctx.ADOXQ(zero, acc[carryinto[1]])
 	}
 }
 
-// Sqr does a full square z = x².
-func Sqr(ctx *build.Context, z, x Int) {
-	Mul(ctx, z, x, x)
+// muladdbaseline adds m times the limbs of x from index from onwards into the
+// accumulator acc, with the product of x[i] going to limb i+shift. Limbs of acc
+// are allocated as required; nil limbs are treated as zero. This is the
+// baseline counterpart of muladd, with the multiplier m as a MULQ operand and a
+// single carry chain. Each high half absorbs the carries from the additions
+// into its limb, which cannot overflow since (2⁶⁴-1)² + 2*(2⁶⁴-1) < 2¹²⁸.
+func muladdbaseline(ctx *build.Context, acc []operand.Op, m operand.Op, x Int, from, shift int) {
+	var carry operand.Op
+	for i := from; i < len(x); i++ {
+		k := i + shift
+		ctx.Commentf("x[%d] * m -> acc[%d]", i, k)
+
+		// Do the multiply.
+		ctx.MOVQ(x[i], reg.RAX)
+		ctx.MULQ(m)
+
+		// Add the low half and the carry in.
+		if acc[k] == nil {
+			acc[k] = ctx.GP64()
+			ctx.MOVQ(reg.RAX, acc[k])
+		} else {
+			ctx.ADDQ(reg.RAX, acc[k])
+			ctx.ADCQ(operand.U32(0), reg.RDX)
+		}
+		if carry != nil {
+			ctx.ADDQ(carry, acc[k])
+			ctx.ADCQ(operand.U32(0), reg.RDX)
+		}
+
+		// The high half carries into the next limb.
+		carry = ctx.GP64()
+		ctx.MOVQ(reg.RDX, carry)
+	}
+
+	k := len(x) + shift
+	if acc[k] == nil {
+		acc[k] = carry
+	} else {
+		ctx.ADDQ(carry, acc[k])
+	}
 }
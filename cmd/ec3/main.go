@@ -1,57 +1,180 @@
 package main
 
 import (
+	"crypto"
 	"crypto/elliptic"
 	"errors"
 	"flag"
 	"fmt"
 	"log"
+	"math/big"
 	"os"
 	"strings"
 
 	"github.com/mmcloughlin/addchain/acc"
 	"github.com/mmcloughlin/addchain/acc/ir"
+	"github.com/mmcloughlin/addchain/alg/ensemble"
 
-	"github.com/mmcloughlin/ec3/asm/fp/mont"
-	"github.com/mmcloughlin/ec3/efd"
+	asmfp "github.com/mmcloughlin/ec3/asm/fp"
+	"github.com/mmcloughlin/ec3/efd/db"
+	"github.com/mmcloughlin/ec3/efd/eqn"
 	"github.com/mmcloughlin/ec3/efd/op3/ast"
 	"github.com/mmcloughlin/ec3/gen"
 	"github.com/mmcloughlin/ec3/gen/curve"
 	"github.com/mmcloughlin/ec3/gen/fmla"
 	"github.com/mmcloughlin/ec3/gen/fp"
+	"github.com/mmcloughlin/ec3/internal/weierstrass"
 	"github.com/mmcloughlin/ec3/name"
 	"github.com/mmcloughlin/ec3/prime"
 )
 
 var (
 	directory = flag.String("dir", "", "directory to write to")
+	curvename = flag.String("curve", "p256", "curve to generate (p256 or secp256k1)")
 
-	inverse       = flag.String("inv", "", "addition chain for field inversion")
-	scalarinverse = flag.String("scalarinv", "", "addition chain for scalar field inversion")
+	inverse       = flag.String("inv", "", "addition chain for field inversion (default computed)")
+	sqrt          = flag.String("sqrt", "", "addition chain for field square root exponent (default computed)")
+	scalarinverse = flag.String("scalarinv", "", "addition chain for scalar field inversion (default computed)")
+	full          = flag.Bool("ensemble", false, "search for addition chains with the full algorithm ensemble (slow)")
+	cachedir      = flag.String("cache", "", "directory to cache computed addition chains")
+
+	mul       = flag.String("mul", "auto", "field multiplication (auto, separated or interleaved)")
+	scalarmul = flag.String("scalarmul", "auto", "scalar field multiplication (auto, separated or interleaved)")
+
+	inversion       = flag.String("inversion", "chain", "field inversion algorithm (chain or safegcd)")
+	scalarinversion = flag.String("scalarinversion", "chain", "scalar field inversion algorithm (chain or safegcd)")
+
+	databases = flag.String("efd", "", "comma-separated additional formula databases (directories or tarballs)")
+	addition  = flag.String("add", "", "jacobian addition formula (default depends on curve)")
+	doubling  = flag.String("dbl", "", "jacobian doubling formula (default depends on curve)")
+	complete  = flag.String("compadd", "", "projective complete addition formula (default depends on curve)")
 )
 
+// spec describes a short Weierstrass curve supported by the generator.
+type spec struct {
+	Name   string
+	Params *elliptic.CurveParams
+	Prime  prime.Prime
+
+	// A is the curve coefficient a, which selects the formula representations.
+	A int64
+
+	// Default formulae.
+	Addition         string
+	Doubling         string
+	CompleteAddition string
+
+	// Endomorphism enables GLV scalar multiplication.
+	Endomorphism bool
+
+	HashToCurve *curve.HashToCurve
+}
+
+var specs = map[string]spec{
+	"p256": {
+		Name:             "p256",
+		Params:           elliptic.P256().Params(),
+		Prime:            prime.NISTP256,
+		A:                -3,
+		Addition:         "g1p/shortw/jacobian-3/addition/add-2007-bl",
+		Doubling:         "g1p/shortw/jacobian-3/doubling/dbl-2001-b",
+		CompleteAddition: "g1p/shortw/projective-3/addition/add-2015-rcb",
+		HashToCurve: &curve.HashToCurve{
+			Hash:          crypto.SHA256,
+			SecurityLevel: 128,
+		},
+	},
+	"secp256k1": {
+		Name:             "secp256k1",
+		Params:           weierstrass.Secp256k1().Params(),
+		Prime:            prime.Secp256k1,
+		A:                0,
+		Addition:         "g1p/shortw/jacobian-0/addition/add-2007-bl",
+		Doubling:         "g1p/shortw/jacobian-0/doubling/dbl-2009-l",
+		CompleteAddition: "g1p/shortw/projective-0/addition/add-2015-rcb",
+		Endomorphism:     true,
+	},
+}
+
+// representation returns the name of the representation with the given
+// coordinates, specialized to the curve coefficient a.
+func (s spec) representation(coords string) string {
+	return fmt.Sprintf("g1p/shortw/%s-%d", coords, -s.A)
+}
+
 func main() {
 	flag.Parse()
 
-	// Load inversion chains.
-	if *inverse == "" {
-		log.Fatal("must provide addition chain for inversion")
+	// Load addition chains. Those not provided are computed.
+	p, err := loadchain(*inverse)
+	if err != nil {
+		log.Fatal(err)
 	}
-	p, err := acc.LoadFile(*inverse)
+
+	sqrtp, err := loadchain(*sqrt)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	if *scalarinverse == "" {
-		log.Fatal("must provide addition chain for scalar inversion")
+	scalarinvp, err := loadchain(*scalarinverse)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Curve specification, with formula overrides.
+	s, ok := specs[*curvename]
+	if !ok {
+		log.Fatalf("unknown curve %q", *curvename)
+	}
+	if *addition != "" {
+		s.Addition = *addition
+	}
+	if *doubling != "" {
+		s.Doubling = *doubling
+	}
+	if *complete != "" {
+		s.CompleteAddition = *complete
+	}
+
+	// Field backends.
+	field, err := fp.ParseField(s.Prime, *mul)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	scalarfield, err := fp.ParseField(prime.NewOther(s.Params.N), *scalarmul)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Inversion algorithms.
+	fieldinv, err := fp.ParseInversion(*inversion)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	scalarfieldinv, err := fp.ParseInversion(*scalarinversion)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	search := fp.ChainSearch{CacheDir: *cachedir}
+	if *full {
+		search.Algorithms = ensemble.Ensemble()
+	}
+
+	// Load formula database.
+	var filenames []string
+	if *databases != "" {
+		filenames = strings.Split(*databases, ",")
 	}
-	scalarinvp, err := acc.LoadFile(*scalarinverse)
+	d, err := db.Load(filenames...)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	// Build file set.
-	fs, err := p256(p, scalarinvp)
+	fs, err := shortw(s, d, search, field, scalarfield, fieldinv, scalarfieldinv, p, sqrtp, scalarinvp)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -73,15 +196,27 @@ func main() {
 	}
 }
 
-func p256(p, scalarinvp *ir.Program) (gen.Files, error) {
-	params := elliptic.P256().Params()
+// loadchain loads an addition chain from filename, if non-empty.
+func loadchain(filename string) (*ir.Program, error) {
+	if filename == "" {
+		return nil, nil
+	}
+	return acc.LoadFile(filename)
+}
+
+func shortw(s spec, d *db.Database, search fp.ChainSearch, field, scalarfield asmfp.Field, fieldinv, scalarfieldinv fp.Inversion, p, sqrtp, scalarinvp *ir.Program) (gen.Files, error) {
+	params := s.Params
 
 	// Field config.
 	fieldcfg := fp.Config{
-		Field:        mont.New(prime.NISTP256),
+		Field:        field,
+		Inversion:    fieldinv,
 		InverseChain: p,
+		Sqrt:         true,
+		SqrtChain:    sqrtp,
+		ChainSearch:  search,
 
-		PackageName:     "p256",
+		PackageName:     s.Name,
 		ElementTypeName: "Elt",
 		FilenamePrefix:  "fp",
 		Scheme:          name.Plain,
@@ -92,27 +227,8 @@ func p256(p, scalarinvp *ir.Program) (gen.Files, error) {
 		return nil, err
 	}
 
-	// Scalar field config.
-	scalarcfg := fp.Config{
-		Field:        mont.New(prime.NewOther(params.N)),
-		InverseChain: scalarinvp,
-
-		PackageName:     "p256",
-		ElementTypeName: "scalar",
-		FilenamePrefix:  "scalar",
-		Scheme: name.CompositeScheme(
-			name.Prefixed("scalar"),
-			name.LowerCase,
-		),
-	}
-
-	scalarfiles, err := fp.Package(scalarcfg)
-	if err != nil {
-		return nil, err
-	}
-
 	// Point config.
-	shape := efd.LookupShape("g1p/shortw")
+	shape := d.LookupShape("g1p/shortw")
 	if shape == nil {
 		return nil, errors.New("unknown shape")
 	}
@@ -128,7 +244,7 @@ func p256(p, scalarinvp *ir.Program) (gen.Files, error) {
 		Coordinates: affinecoords,
 	}
 
-	reprjac := efd.LookupRepresentation("g1p/shortw/jacobian-3")
+	reprjac := d.LookupRepresentation(s.representation("jacobian"))
 	if reprjac == nil {
 		return nil, errors.New("unknown representation")
 	}
@@ -139,7 +255,7 @@ func p256(p, scalarinvp *ir.Program) (gen.Files, error) {
 		Coordinates: reprjac.Variables,
 	}
 
-	reprproj := efd.LookupRepresentation("g1p/shortw/projective-3")
+	reprproj := d.LookupRepresentation(s.representation("projective"))
 	if reprproj == nil {
 		return nil, errors.New("unknown representation")
 	}
@@ -181,7 +297,7 @@ func p256(p, scalarinvp *ir.Program) (gen.Files, error) {
 		},
 	}
 
-	scalef := efd.LookupFormula("g1p/shortw/jacobian-3/scaling/z")
+	scalef := d.LookupFormula(s.representation("jacobian") + "/scaling/z")
 	if scalef == nil {
 		return nil, errors.New("unknown formula")
 	}
@@ -211,7 +327,7 @@ func p256(p, scalarinvp *ir.Program) (gen.Files, error) {
 		},
 	}
 
-	pscalef := efd.LookupFormula("g1p/shortw/projective-3/scaling/z")
+	pscalef := d.LookupFormula(s.representation("projective") + "/scaling/z")
 	if pscalef == nil {
 		return nil, errors.New("unknown formula")
 	}
@@ -263,9 +379,9 @@ func p256(p, scalarinvp *ir.Program) (gen.Files, error) {
 		},
 	}
 
-	addf := efd.LookupFormula("g1p/shortw/jacobian-3/addition/add-2007-bl")
+	addf := d.LookupFormula(s.Addition)
 	if addf == nil {
-		return nil, errors.New("unknown formula")
+		return nil, fmt.Errorf("unknown formula %q", s.Addition)
 	}
 
 	add := fmla.NewAsmFunctionDefault(fmla.Function{
@@ -278,9 +394,9 @@ func p256(p, scalarinvp *ir.Program) (gen.Files, error) {
 		Formula: addf.Program,
 	})
 
-	dblf := efd.LookupFormula("g1p/shortw/jacobian-3/doubling/dbl-2001-b")
+	dblf := d.LookupFormula(s.Doubling)
 	if dblf == nil {
-		return nil, errors.New("unknown formula")
+		return nil, fmt.Errorf("unknown formula %q", s.Doubling)
 	}
 
 	dbl := fmla.NewAsmFunctionDefault(fmla.Function{
@@ -312,9 +428,9 @@ func p256(p, scalarinvp *ir.Program) (gen.Files, error) {
 		},
 	}
 
-	compaddf := efd.LookupFormula("g1p/shortw/projective-3/addition/add-2015-rcb")
+	compaddf := d.LookupFormula(s.CompleteAddition)
 	if compaddf == nil {
-		return nil, errors.New("unknown formula")
+		return nil, fmt.Errorf("unknown formula %q", s.CompleteAddition)
 	}
 
 	compadd := fmla.NewAsmFunctionDefault(fmla.Function{
@@ -328,34 +444,107 @@ func p256(p, scalarinvp *ir.Program) (gen.Files, error) {
 		Formula: compaddf.Program,
 	})
 
+	// Curve equation, specialized to the assumptions of the projective
+	// representation.
+	equation, err := eqn.Parse(shape.Satisfying[0])
+	if err != nil {
+		return nil, err
+	}
+
+	for _, assumption := range reprproj.Assume {
+		a, err := eqn.Parse(assumption)
+		if err != nil {
+			return nil, err
+		}
+		v, ok := a.LHS.(eqn.Variable)
+		if !ok {
+			return nil, fmt.Errorf("unsupported assumption %q", assumption)
+		}
+		equation = equation.Substitute(v, a.RHS)
+	}
+
+	for i, v := range shape.Coordinates {
+		equation = equation.Substitute(eqn.Variable(v), eqn.Variable(affinecoords[i]+"1"))
+	}
+
+	equationp, err := equation.Program("lhs", "rhs")
+	if err != nil {
+		return nil, err
+	}
+
+	oncurve := fmla.Equation{
+		Name:     "IsOnCurve",
+		Receiver: fmla.Point("a", fmla.R, affine, 1),
+		Globals:  []fmla.Parameter{b},
+		Formula:  equationp,
+		LHS:      "lhs",
+		RHS:      "rhs",
+	}
+
+	components := []fmla.Component{
+		// Constants.
+		b,
+
+		// Affine representation.
+		affine,
+		atoj,
+		atop,
+		oncurve,
+
+		// Jacobian representation.
+		jacobian,
+		jtoa,
+		jtop,
+		lookup,
+		cmov,
+		jcneg,
+		add,
+		dbl,
+
+		// Projective representation.
+		projective,
+		ptoa,
+		pcneg,
+		compadd,
+	}
+
+	// Endomorphism (βX, Y, Z) for GLV scalar multiplication.
+	var endo *curve.Endomorphism
+	if s.Endomorphism {
+		endo, err = curve.FindEndomorphism(weierstrass.New(params, big.NewInt(s.A)))
+		if err != nil {
+			return nil, err
+		}
+
+		beta := fmla.Constant{
+			VariableName: "beta",
+			ElementType:  fieldcfg.Type(),
+			Value:        endo.Beta,
+		}
+
+		phi := fmla.Function{
+			Name:     "Endomorphism",
+			Receiver: fmla.Point("p", fmla.W, jacobian, 3),
+			Params: []fmla.Parameter{
+				fmla.Point("q", fmla.R, jacobian, 1),
+			},
+			Globals: []fmla.Parameter{beta},
+			Formula: &ast.Program{
+				Assignments: []ast.Assignment{
+					{LHS: "X3", RHS: ast.Mul{X: ast.Variable("beta"), Y: ast.Variable("X1")}},
+					{LHS: "Y3", RHS: ast.Variable("Y1")},
+					{LHS: "Z3", RHS: ast.Variable("Z1")},
+				},
+			},
+		}
+
+		components = append(components, beta, phi)
+	}
+
 	pointcfg := fmla.Config{
-		PackageName: "p256",
+		PackageName: s.Name,
 		Field:       fieldcfg,
-		Components: []fmla.Component{
-			// Constants.
-			b,
-
-			// Affine representation.
-			affine,
-			atoj,
-			atop,
-
-			// Jacobian representation.
-			jacobian,
-			jtoa,
-			jtop,
-			lookup,
-			cmov,
-			jcneg,
-			add,
-			dbl,
-
-			// Projective representation.
-			projective,
-			ptoa,
-			pcneg,
-			compadd,
-		},
+		Components:  components,
 	}
 
 	pointfiles, err := fmla.Package(pointcfg)
@@ -365,9 +554,21 @@ func p256(p, scalarinvp *ir.Program) (gen.Files, error) {
 
 	// Curve operations.
 	shortw := curve.ShortWeierstrass{
-		PackageName: "p256",
+		PackageName: s.Name,
 		Params:      params,
-		ShortName:   "p256",
+		ShortName:   s.Name,
+		A:           big.NewInt(s.A),
+
+		Endomorphism: endo,
+
+		ScalarInversion:    scalarfieldinv,
+		ScalarInverseChain: scalarinvp,
+		ScalarField:        scalarfield,
+		ChainSearch:        search,
+
+		ECDSA:       true,
+		ECDH:        true,
+		HashToCurve: s.HashToCurve,
 	}
 
 	curvefiles, err := shortw.Generate()
@@ -376,5 +577,5 @@ func p256(p, scalarinvp *ir.Program) (gen.Files, error) {
 	}
 
 	// Merge and output.
-	return gen.Merge(fieldfiles, scalarfiles, pointfiles, curvefiles), nil
+	return gen.Merge(fieldfiles, pointfiles, curvefiles), nil
 }
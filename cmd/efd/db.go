@@ -0,0 +1,173 @@
+package main
+
+import (
+	"flag"
+	"reflect"
+	"sort"
+
+	"github.com/mmcloughlin/ec3/efd"
+	"github.com/mmcloughlin/ec3/efd/db"
+)
+
+// selection provides flags for selecting formulae from a database.
+type selection struct {
+	database string
+	class    string
+	shape    string
+	repr     string
+	op       string
+}
+
+// AddFlags registers selection flags on f.
+func (s *selection) AddFlags(f *flag.FlagSet) {
+	f.StringVar(&s.database, "db", "", "database directory or tarball (default built-in)")
+	f.StringVar(&s.class, "class", "", "class of curve")
+	f.StringVar(&s.shape, "shape", "", "curve shape")
+	f.StringVar(&s.repr, "repr", "", "representation")
+	f.StringVar(&s.op, "op", "", "operation")
+}
+
+// Predicates returns predicates for the selected formulae.
+func (s *selection) Predicates() []efd.Predicate {
+	predicates := []efd.Predicate{}
+	if s.class != "" {
+		predicates = append(predicates, efd.WithClass(s.class))
+	}
+	if s.shape != "" {
+		predicates = append(predicates, efd.WithShape(s.shape))
+	}
+	if s.repr != "" {
+		predicates = append(predicates, efd.WithRepresentation(s.repr))
+	}
+	if s.op != "" {
+		predicates = append(predicates, efd.WithOperation(s.op))
+	}
+	return predicates
+}
+
+// Formulae loads the database and returns the selected formulae.
+func (s *selection) Formulae() (efd.Formulae, error) {
+	fs, err := load(s.database)
+	if err != nil {
+		return nil, err
+	}
+	return fs.Filter(s.Predicates()...), nil
+}
+
+// load formulae from the database at filename. Returns the built-in database
+// if filename is empty.
+func load(filename string) (efd.Formulae, error) {
+	if filename == "" {
+		return efd.All, nil
+	}
+
+	s, err := db.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := db.Read(s)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := make(efd.Formulae, 0, len(d.Formulae))
+	for _, f := range d.Formulae {
+		fs = append(fs, f)
+	}
+	sort.Slice(fs, func(i, j int) bool {
+		return fs[i].ID < fs[j].ID
+	})
+
+	return fs, nil
+}
+
+// lookup returns the formula with the given ID, or nil if not found.
+func lookup(fs efd.Formulae, id string) *efd.Formula {
+	for _, f := range fs {
+		if f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+// Change describes the difference in a formula between two databases.
+type Change struct {
+	ID     string
+	Old    *efd.Formula
+	New    *efd.Formula
+	Fields []string
+}
+
+// Compare returns the formulae that have been added, removed or modified from
+// a to b.
+func Compare(a, b efd.Formulae) []Change {
+	// Index.
+	olds := map[string]*efd.Formula{}
+	news := map[string]*efd.Formula{}
+	ids := []string{}
+	for _, f := range a {
+		olds[f.ID] = f
+		ids = append(ids, f.ID)
+	}
+	for _, f := range b {
+		news[f.ID] = f
+		if _, ok := olds[f.ID]; !ok {
+			ids = append(ids, f.ID)
+		}
+	}
+	sort.Strings(ids)
+
+	// Compare.
+	changes := []Change{}
+	for _, id := range ids {
+		c := Change{ID: id, Old: olds[id], New: news[id]}
+		if c.Old != nil && c.New != nil {
+			c.Fields = fields(c.Old, c.New)
+			if len(c.Fields) == 0 {
+				continue
+			}
+		}
+		changes = append(changes, c)
+	}
+
+	return changes
+}
+
+// fields returns the names of fields that differ between a and b.
+func fields(a, b *efd.Formula) []string {
+	program := func(f *efd.Formula) string {
+		if f.Program == nil {
+			return ""
+		}
+		return f.Program.String()
+	}
+
+	values := []struct {
+		Name string
+		A, B interface{}
+	}{
+		{"tag", a.Tag, b.Tag},
+		{"class", a.Class, b.Class},
+		{"shape", a.Shape.ID, b.Shape.ID},
+		{"repr", a.Representation.ID, b.Representation.ID},
+		{"operation", a.Operation, b.Operation},
+		{"collection", a.Collection, b.Collection},
+		{"url", a.URL, b.URL},
+		{"source", a.Source, b.Source},
+		{"appliesto", a.AppliesTo, b.AppliesTo},
+		{"assume", a.Assume, b.Assume},
+		{"compute", a.Compute, b.Compute},
+		{"params", a.Parameters, b.Parameters},
+		{"op3", program(a), program(b)},
+	}
+
+	changed := []string{}
+	for _, v := range values {
+		if !reflect.DeepEqual(v.A, v.B) {
+			changed = append(changed, v.Name)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mmcloughlin/ec3/efd"
+	"github.com/mmcloughlin/ec3/efd/op3/parse"
+)
+
+// formula builds a test formula with the given ID and op3 program.
+func formula(t *testing.T, id, src string) *efd.Formula {
+	t.Helper()
+	p, err := parse.String(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &efd.Formula{
+		ID:             id,
+		Tag:            "tag",
+		Class:          "g1p",
+		Shape:          &efd.Shape{ID: "g1p/shortw"},
+		Representation: &efd.Representation{ID: "g1p/shortw/jacobian"},
+		Operation:      "addition",
+		Program:        p,
+	}
+}
+
+func TestCompare(t *testing.T) {
+	same := formula(t, "same", "X3 = X1+X2\n")
+	removed := formula(t, "removed", "X3 = X1*X2\n")
+	added := formula(t, "added", "X3 = X1-X2\n")
+	old := formula(t, "changed", "X3 = X1+X2\n")
+	changed := formula(t, "changed", "X3 = X1*X2\n")
+	changed.Assume = []string{"Z1=1"}
+
+	a := efd.Formulae{same, removed, old}
+	b := efd.Formulae{same, added, changed}
+
+	expect := []Change{
+		{ID: "added", New: added},
+		{ID: "changed", Old: old, New: changed, Fields: []string{"assume", "op3"}},
+		{ID: "removed", Old: removed},
+	}
+
+	got := Compare(a, b)
+	if !reflect.DeepEqual(got, expect) {
+		t.Fatalf("Compare() = %#v; expect %#v", got, expect)
+	}
+}
+
+func TestCompareIdentical(t *testing.T) {
+	fs := efd.Formulae{
+		formula(t, "a", "X3 = X1+X2\n"),
+		formula(t, "b", "X3 = X1*X2\n"),
+	}
+	if changes := Compare(fs, fs); len(changes) != 0 {
+		t.Fatalf("expected no changes; got %v", changes)
+	}
+}
+
+func TestFields(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Modify func(f *efd.Formula)
+		Expect []string
+	}{
+		{"none", func(f *efd.Formula) {}, []string{}},
+		{"tag", func(f *efd.Formula) { f.Tag = "other" }, []string{"tag"}},
+		{"shape", func(f *efd.Formula) { f.Shape = &efd.Shape{ID: "g1p/edwards"} }, []string{"shape"}},
+		{"repr", func(f *efd.Formula) { f.Representation = &efd.Representation{ID: "g1p/shortw/projective"} }, []string{"repr"}},
+		{"url", func(f *efd.Formula) { f.URL = "https://example.com" }, []string{"url"}},
+		{"params", func(f *efd.Formula) { f.Parameters = []string{"k"} }, []string{"params"}},
+		{"op3", func(f *efd.Formula) { f.Program = nil }, []string{"op3"}},
+		{
+			Name: "multiple",
+			Modify: func(f *efd.Formula) {
+				f.Operation = "doubling"
+				f.Compute = []string{"k = 2 a"}
+			},
+			Expect: []string{"operation", "compute"},
+		},
+	}
+	for _, c := range cases {
+		c := c // scopelint
+		t.Run(c.Name, func(t *testing.T) {
+			a := formula(t, "id", "X3 = X1+X2\n")
+			b := formula(t, "id", "X3 = X1+X2\n")
+			c.Modify(b)
+			if got := fields(a, b); !reflect.DeepEqual(got, c.Expect) {
+				t.Fatalf("fields() = %v; expect %v", got, c.Expect)
+			}
+		})
+	}
+}
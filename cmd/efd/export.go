@@ -0,0 +1,121 @@
+package main
+
+import (
+	"io"
+
+	"golang.org/x/xerrors"
+
+	"github.com/mmcloughlin/ec3/efd"
+	"github.com/mmcloughlin/ec3/efd/efdjson"
+	"github.com/mmcloughlin/ec3/efd/op3/ast"
+	"github.com/mmcloughlin/ec3/internal/gocode"
+	"github.com/mmcloughlin/ec3/internal/print"
+)
+
+// Exporter writes formulae in some output format.
+type Exporter func(w io.Writer, fs efd.Formulae) error
+
+// Exporters maps format names to exporters.
+var Exporters = map[string]Exporter{
+	"op3":  ExportOp3,
+	"json": ExportJSON,
+	"go":   ExportGo,
+}
+
+// ExportOp3 writes formula programs in op3 format, separated by blank lines.
+func ExportOp3(w io.Writer, fs efd.Formulae) error {
+	p := print.New(w)
+	for i, f := range fs {
+		if f.Program == nil {
+			return xerrors.Errorf("formula %q has no program", f.ID)
+		}
+		if i > 0 {
+			p.NL()
+		}
+		for _, line := range programlines(f.Program) {
+			p.Linef("%s", line)
+		}
+	}
+	return p.Error()
+}
+
+// ExportJSON writes formulae in the versioned efdjson format, together with the
+// shapes and representations they refer to.
+func ExportJSON(w io.Writer, fs efd.Formulae) error {
+	shapes := []*efd.Shape{}
+	reprs := []*efd.Representation{}
+	seen := map[interface{}]bool{}
+	for _, f := range fs {
+		if !seen[f.Shape] {
+			shapes = append(shapes, f.Shape)
+			seen[f.Shape] = true
+		}
+		if !seen[f.Representation] {
+			reprs = append(reprs, f.Representation)
+			seen[f.Representation] = true
+		}
+	}
+
+	d, err := efdjson.Encode(shapes, reprs, fs)
+	if err != nil {
+		return err
+	}
+
+	return efdjson.Write(w, d)
+}
+
+// ExportGo writes formula programs as a Go map literal of ast.Program values
+// keyed by formula ID.
+func ExportGo(w io.Writer, fs efd.Formulae) error {
+	g := gocode.NewGenerator()
+	g.CodeGenerationWarning("efd export")
+	g.Package("formulae")
+	g.Import("github.com/mmcloughlin/ec3/efd/op3/ast")
+
+	g.NL()
+	g.Comment("Programs maps formula IDs to op3 programs.")
+	g.Printf("var Programs = map[string]*ast.Program")
+	g.EnterBlock()
+	for _, f := range fs {
+		if f.Program == nil {
+			return xerrors.Errorf("formula %q has no program", f.ID)
+		}
+		g.Linef("%q: {", f.ID)
+		program(&g, f.Program)
+		g.Linef("},")
+	}
+	g.LeaveBlock()
+
+	b, err := g.Formatted()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(b)
+	return err
+}
+
+// program writes the fields of a composite literal for p, with one assignment
+// per line.
+func program(g *gocode.Generator, p *ast.Program) {
+	g.Indent()
+	variables(g, "Parameters", p.Parameters)
+	variables(g, "Inputs", p.Inputs)
+	variables(g, "Outputs", p.Outputs)
+	g.Linef("Assignments: []ast.Assignment{")
+	g.Indent()
+	for _, a := range p.Assignments {
+		g.Linef("{LHS: %#v, RHS: %#v},", a.LHS, a.RHS)
+	}
+	g.Dedent()
+	g.Linef("},")
+	g.Dedent()
+}
+
+// variables writes a field of type []ast.Variable, omitting it if empty.
+func variables(g *gocode.Generator, field string, vs []ast.Variable) {
+	if len(vs) == 0 {
+		return
+	}
+	g.Linef("%s: %#v,", field, vs)
+}
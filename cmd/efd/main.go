@@ -1,139 +1,425 @@
+// Command efd provides tools for querying the Explicit-Formulas Database.
 package main
 
 import (
+	"context"
 	"flag"
-	"log"
+	"fmt"
+	"math/big"
 	"os"
-	"sort"
 	"strings"
 
+	"github.com/google/subcommands"
+
 	"github.com/mmcloughlin/ec3/efd"
 	"github.com/mmcloughlin/ec3/efd/cost"
-	"github.com/mmcloughlin/ec3/efd/op3"
-	"github.com/mmcloughlin/ec3/efd/op3/ast"
+	"github.com/mmcloughlin/ec3/internal/cli"
 	"github.com/mmcloughlin/ec3/internal/print"
-)
-
-var (
-	class = flag.String("class", "g1p", "class of curve")
-	shape = flag.String("shape", "shortw", "curve shape")
-	repr  = flag.String("repr", "jacobian-3", "representation")
-	op    = flag.String("op", "addition", "operation")
+	"github.com/mmcloughlin/ec3/prime"
 )
 
 func main() {
+	base := cli.NewBaseCommand("efd")
+	subcommands.Register(&list{Command: base}, "")
+	subcommands.Register(&show{Command: base}, "")
+	subcommands.Register(&search{Command: base}, "")
+	subcommands.Register(&export{Command: base}, "")
+	subcommands.Register(&verify{Command: base}, "")
+	subcommands.Register(&diff{Command: base}, "")
+	subcommands.Register(subcommands.HelpCommand(), "")
+
 	flag.Parse()
+	ctx := context.Background()
+	os.Exit(int(subcommands.Execute(ctx)))
+}
 
-	// Prepare filters.
-	predicates := []efd.Predicate{}
-	if *class != "" {
-		predicates = append(predicates, efd.WithClass(*class))
+// list subcommand.
+type list struct {
+	cli.Command
+	selection
+}
+
+func (*list) Name() string     { return "list" }
+func (*list) Synopsis() string { return "list formulae" }
+func (*list) Usage() string {
+	return `Usage: list [-db <database>] [-class <class>] [-shape <shape>] [-repr <repr>] [-op <op>]
+
+List formula identifiers with a summary of their cost.
+
+`
+}
+
+func (cmd *list) SetFlags(f *flag.FlagSet) {
+	cmd.selection.AddFlags(f)
+}
+
+func (cmd *list) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+	fs, err := cmd.Formulae()
+	if err != nil {
+		return cmd.Error(err)
 	}
-	if *shape != "" {
-		predicates = append(predicates, efd.WithShape(*shape))
+
+	p := newprinter()
+	for _, formula := range fs {
+		summary := ""
+		if formula.Program != nil {
+			counts, err := cost.Operations(formula)
+			if err != nil {
+				return cmd.Error(err)
+			}
+			summary = counts.Summary()
+		}
+		p.field(formula.ID, summary)
 	}
-	if *repr != "" {
-		predicates = append(predicates, efd.WithRepresentation(*repr))
+	p.Flush()
+
+	if err := p.Error(); err != nil {
+		return cmd.Error(err)
 	}
-	if *op != "" {
-		predicates = append(predicates, efd.WithOperation(*op))
+
+	return subcommands.ExitSuccess
+}
+
+// show subcommand.
+type show struct {
+	cli.Command
+
+	database string
+}
+
+func (*show) Name() string     { return "show" }
+func (*show) Synopsis() string { return "show formulae" }
+func (*show) Usage() string {
+	return `Usage: show [-db <database>] <id> ...
+
+Show details of the formulae with the given identifiers.
+
+`
+}
+
+func (cmd *show) SetFlags(f *flag.FlagSet) {
+	f.StringVar(&cmd.database, "db", "", "database directory or tarball (default built-in)")
+}
+
+func (cmd *show) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+	if f.NArg() == 0 {
+		return cmd.UsageError("must provide formula identifiers")
 	}
 
-	// Get and print list of selected formulae.
-	fs := efd.Select(predicates...)
+	all, err := load(cmd.database)
+	if err != nil {
+		return cmd.Error(err)
+	}
 
-	p := &printer{
-		TabWriter: print.NewTabWriter(os.Stdout, 1, 4, 4, ' ', 0),
+	p := newprinter()
+	for i, id := range f.Args() {
+		formula := lookup(all, id)
+		if formula == nil {
+			return cmd.Fail("unknown formula %q", id)
+		}
+		if i > 0 {
+			p.NL()
+		}
+		p.formula(formula)
 	}
-	p.formulae(fs)
 	p.Flush()
 
 	if err := p.Error(); err != nil {
-		log.Fatal(err)
+		return cmd.Error(err)
 	}
+
+	return subcommands.ExitSuccess
 }
 
-type printer struct {
-	*print.TabWriter
+// search subcommand.
+type search struct {
+	cli.Command
+	selection
+
+	assume    string
+	noassume  bool
+	weights   string
+	maxweight float64
+	limit     int
 }
 
-func (p *printer) formulae(fs []*efd.Formula) {
-	for _, f := range fs {
-		p.formula(f)
+func (*search) Name() string     { return "search" }
+func (*search) Synopsis() string { return "search formulae ranked by cost" }
+func (*search) Usage() string {
+	return `Usage: search [selection flags] [-assume <list>] [-noassume] [-weights <file>] [-maxweight <w>] [-limit <n>]
+
+Search for formulae matching the given criteria, ranked in ascending order of
+cost. Costs are computed with the default weights unless a weights file is
+provided, for example from the calibrate tool.
+
+`
+}
+
+func (cmd *search) SetFlags(f *flag.FlagSet) {
+	cmd.selection.AddFlags(f)
+	f.StringVar(&cmd.assume, "assume", "", "comma-separated assumptions the formula must make (for example Z1=1)")
+	f.BoolVar(&cmd.noassume, "noassume", false, "only select formulae without assumptions")
+	f.StringVar(&cmd.weights, "weights", "", "cost model weights file (default built-in weights)")
+	f.Float64Var(&cmd.maxweight, "maxweight", 0, "maximum weight (zero for no limit)")
+	f.IntVar(&cmd.limit, "limit", 0, "maximum number of results (zero for no limit)")
+}
+
+func (cmd *search) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+	// Cost model.
+	m := cost.DefaultWeights
+	if cmd.weights != "" {
+		w, err := cost.LoadWeights(cmd.weights)
+		if err != nil {
+			return cmd.Error(err)
+		}
+		m = w
+	}
+
+	// Select and rank.
+	fs, err := cmd.Formulae()
+	if err != nil {
+		return cmd.Error(err)
+	}
+
+	predicates := []efd.Predicate{}
+	if cmd.assume != "" {
+		predicates = append(predicates, withassumptions(strings.Split(cmd.assume, ",")))
+	}
+	if cmd.noassume {
+		predicates = append(predicates, withoutassumptions)
+	}
+	fs = fs.Filter(predicates...)
+
+	rs, err := cost.Rank(fs, m)
+	if err != nil {
+		return cmd.Error(err)
+	}
+
+	// Apply limits.
+	var results []cost.Ranked
+	for _, r := range rs {
+		if cmd.maxweight > 0 && r.Weight > cmd.maxweight {
+			break
+		}
+		if cmd.limit > 0 && len(results) == cmd.limit {
+			break
+		}
+		results = append(results, r)
+	}
+
+	// Output.
+	p := newprinter()
+	p.ranked(results)
+	p.Flush()
+
+	if err := p.Error(); err != nil {
+		return cmd.Error(err)
+	}
+
+	return subcommands.ExitSuccess
+}
+
+// withassumptions returns a predicate matching formulae making all the given
+// assumptions. Whitespace is ignored in the comparison.
+func withassumptions(assumptions []string) efd.Predicate {
+	return func(f *efd.Formula) bool {
+		have := map[string]bool{}
+		for _, a := range f.Assume {
+			have[nospace(a)] = true
+		}
+		for _, a := range assumptions {
+			if !have[nospace(a)] {
+				return false
+			}
+		}
+		return true
 	}
 }
 
-func (p *printer) formula(f *efd.Formula) {
-	p.field("id", f.ID)
-	p.field("tag", f.Tag)
-	p.field("class", f.Class)
-	p.field("shape", f.Shape.Tag)
-	p.field("repr", f.Representation.Tag)
-	p.field("operation", f.Operation)
-	p.field("collection", f.Collection)
-	p.maybe("url", f.URL)
+// withoutassumptions matches formulae that make no assumptions.
+func withoutassumptions(f *efd.Formula) bool {
+	return len(f.Assume) == 0
+}
 
-	p.cost(f)
-	p.maybe("source", f.Source)
-	p.maybe("appliesto", f.AppliesTo)
-	p.values("params", f.Parameters)
-	p.values("assume", f.Assume)
-	p.values("compute", f.Compute)
-	p.program(f.Program)
+// nospace removes all whitespace from s.
+func nospace(s string) string {
+	return strings.Join(strings.Fields(s), "")
 }
 
-func (p *printer) field(key, value string) {
-	p.Linef("%s\t%s", key, value)
+// export subcommand.
+type export struct {
+	cli.Command
+	selection
+
+	format     string
+	outputfile string
 }
 
-func (p *printer) maybe(key, value string) {
-	if len(value) > 0 {
-		p.field(key, value)
-	}
+func (*export) Name() string     { return "export" }
+func (*export) Synopsis() string { return "export formulae" }
+func (*export) Usage() string {
+	return `Usage: export [selection flags] [-format <format>] [-out <file>] [<id> ...]
+
+Export formulae in op3, JSON or Go format. Formulae may be selected either by
+identifier or with selection flags.
+
+`
 }
 
-func (p *printer) values(key string, values []string) {
-	if len(values) == 0 {
-		return
+func (cmd *export) SetFlags(f *flag.FlagSet) {
+	cmd.selection.AddFlags(f)
+	f.StringVar(&cmd.format, "format", "op3", "output format (op3, json or go)")
+	f.StringVar(&cmd.outputfile, "out", "", "output file (default stdout)")
+}
+
+func (cmd *export) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+	exporter, ok := Exporters[cmd.format]
+	if !ok {
+		return cmd.UsageError("unknown format %q", cmd.format)
 	}
-	p.field(key, values[0])
-	for _, value := range values[1:] {
-		p.field("", value)
+
+	// Select formulae.
+	fs, err := cmd.Formulae()
+	if err != nil {
+		return cmd.Error(err)
 	}
+
+	if f.NArg() > 0 {
+		selected := efd.Formulae{}
+		for _, id := range f.Args() {
+			formula := lookup(fs, id)
+			if formula == nil {
+				return cmd.Fail("unknown formula %q", id)
+			}
+			selected = append(selected, formula)
+		}
+		fs = selected
+	}
+
+	// Export.
+	_, w, err := cli.OpenOutput(cmd.outputfile)
+	if err != nil {
+		return cmd.Error(err)
+	}
+	defer w.Close()
+
+	if err := exporter(w, fs); err != nil {
+		return cmd.Error(err)
+	}
+
+	return subcommands.ExitSuccess
 }
 
-func (p *printer) cost(f *efd.Formula) {
-	if f.Program == nil {
-		return
+// verify subcommand.
+type verify struct {
+	cli.Command
+	selection
+
+	modulus string
+	trials  int
+}
+
+func (*verify) Name() string     { return "verify" }
+func (*verify) Synopsis() string { return "verify formula programs" }
+func (*verify) Usage() string {
+	return `Usage: verify [selection flags] [-modulus <m>] [-trials <n>]
+
+Verify op3 programs of the selected formulae. Checks that operation counts can
+be computed, and that programs evaluate identically before and after lowering
+to primitive operations.
+
+`
+}
+
+func (cmd *verify) SetFlags(f *flag.FlagSet) {
+	cmd.selection.AddFlags(f)
+	f.StringVar(&cmd.modulus, "modulus", prime.NISTP256.Int().String(), "modulus for evaluation")
+	f.IntVar(&cmd.trials, "trials", 8, "number of random evaluation trials per formula")
+}
+
+func (cmd *verify) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+	m, ok := new(big.Int).SetString(cmd.modulus, 0)
+	if !ok {
+		return cmd.UsageError("invalid modulus %q", cmd.modulus)
 	}
 
-	counts, err := cost.Operations(f)
+	fs, err := cmd.Formulae()
 	if err != nil {
-		p.SetError(err)
-		return
+		return cmd.Error(err)
+	}
+
+	status := subcommands.ExitSuccess
+	n := 0
+	for _, formula := range fs.Filter(efd.WithProgram) {
+		if err := Verify(formula, m, cmd.trials); err != nil {
+			cmd.Log.Printf("%s: %s", formula.ID, err)
+			status = subcommands.ExitFailure
+		}
+		n++
 	}
+	cmd.Log.Printf("verified %d formulae", n)
+
+	return status
+}
+
+// diff subcommand.
+type diff struct {
+	cli.Command
+}
+
+func (*diff) Name() string     { return "diff" }
+func (*diff) Synopsis() string { return "compare database versions" }
+func (*diff) Usage() string {
+	return `Usage: diff [<old>] <new>
+
+Compare formulae between two databases. Each database may be a directory or
+tarball. If only one database is given, it is compared against the built-in
+database.
+
+Output lines are prefixed with "+" for added formulae, "-" for removed formulae
+and "~" for modified formulae, followed by the list of modified fields.
 
-	p.field("cost", counts.String())
+`
 }
 
-func (p *printer) program(prog *ast.Program) {
-	if prog == nil {
-		return
+func (cmd *diff) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+	var oldfile, newfile string
+	switch f.NArg() {
+	case 1:
+		newfile = f.Arg(0)
+	case 2:
+		oldfile, newfile = f.Arg(0), f.Arg(1)
+	default:
+		return cmd.UsageError("expected one or two databases")
 	}
 
-	// Dump the op3 program.
-	lines := []string{}
-	for _, a := range prog.Assignments {
-		lines = append(lines, a.String())
+	olds, err := load(oldfile)
+	if err != nil {
+		return cmd.Error(err)
 	}
-	p.values("op3", lines)
 
-	// Show inputs.
-	inputs := op3.Inputs(prog)
-	names := []string{}
-	for _, input := range inputs {
-		names = append(names, input.String())
+	news, err := load(newfile)
+	if err != nil {
+		return cmd.Error(err)
+	}
+
+	for _, c := range Compare(olds, news) {
+		switch {
+		case c.Old == nil:
+			fmt.Printf("+ %s\n", c.ID)
+		case c.New == nil:
+			fmt.Printf("- %s\n", c.ID)
+		default:
+			fmt.Printf("~ %s\t%s\n", c.ID, strings.Join(c.Fields, ","))
+		}
+	}
+
+	return subcommands.ExitSuccess
+}
+
+// newprinter builds a printer writing to standard output.
+func newprinter() *printer {
+	return &printer{
+		TabWriter: print.NewTabWriter(os.Stdout, 1, 4, 4, ' ', 0),
 	}
-	sort.Strings(names)
-	p.field("inputs", strings.Join(names, " "))
 }
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/mmcloughlin/ec3/efd"
+)
+
+func TestWithAssumptions(t *testing.T) {
+	f := &efd.Formula{Assume: []string{"Z1 = 1", "Z2=1"}}
+	cases := []struct {
+		Assumptions []string
+		Expect      bool
+	}{
+		{[]string{}, true},
+		{[]string{"Z1=1"}, true},
+		{[]string{"Z1 = 1", " Z2 =1 "}, true},
+		{[]string{"Z1=1", "Z3=1"}, false},
+		{[]string{"Z1=Z2"}, false},
+	}
+	for _, c := range cases {
+		if got := withassumptions(c.Assumptions)(f); got != c.Expect {
+			t.Errorf("withassumptions(%q) = %v; expect %v", c.Assumptions, got, c.Expect)
+		}
+	}
+}
+
+func TestWithoutAssumptions(t *testing.T) {
+	if !withoutassumptions(&efd.Formula{}) {
+		t.Error("expected formula without assumptions to match")
+	}
+	if withoutassumptions(&efd.Formula{Assume: []string{"Z1=1"}}) {
+		t.Error("expected formula with assumptions not to match")
+	}
+}
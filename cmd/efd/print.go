@@ -0,0 +1,115 @@
+package main
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mmcloughlin/ec3/efd"
+	"github.com/mmcloughlin/ec3/efd/cost"
+	"github.com/mmcloughlin/ec3/efd/op3"
+	"github.com/mmcloughlin/ec3/efd/op3/ast"
+	"github.com/mmcloughlin/ec3/internal/print"
+)
+
+type printer struct {
+	*print.TabWriter
+}
+
+func (p *printer) formulae(fs []*efd.Formula) {
+	for _, f := range fs {
+		p.formula(f)
+	}
+}
+
+// ranked prints formulae in the given order, annotated with their weight.
+func (p *printer) ranked(rs []cost.Ranked) {
+	for _, r := range rs {
+		p.field("weight", strconv.FormatFloat(r.Weight, 'f', 3, 64))
+		p.formula(r.Formula)
+	}
+}
+
+func (p *printer) formula(f *efd.Formula) {
+	p.field("id", f.ID)
+	p.field("tag", f.Tag)
+	p.field("class", f.Class)
+	p.field("shape", f.Shape.Tag)
+	p.field("repr", f.Representation.Tag)
+	p.field("operation", f.Operation)
+	p.field("collection", f.Collection)
+	p.maybe("url", f.URL)
+
+	p.cost(f)
+	p.maybe("source", f.Source)
+	p.maybe("appliesto", f.AppliesTo)
+	p.values("params", f.Parameters)
+	p.values("assume", f.Assume)
+	p.values("compute", f.Compute)
+	p.program(f.Program)
+}
+
+func (p *printer) field(key, value string) {
+	p.Linef("%s\t%s", key, value)
+}
+
+func (p *printer) maybe(key, value string) {
+	if len(value) > 0 {
+		p.field(key, value)
+	}
+}
+
+func (p *printer) values(key string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	p.field(key, values[0])
+	for _, value := range values[1:] {
+		p.field("", value)
+	}
+}
+
+func (p *printer) cost(f *efd.Formula) {
+	if f.Program == nil {
+		return
+	}
+
+	counts, err := cost.Operations(f)
+	if err != nil {
+		p.SetError(err)
+		return
+	}
+
+	p.field("cost", counts.String())
+}
+
+func (p *printer) program(prog *ast.Program) {
+	if prog == nil {
+		return
+	}
+
+	// Dump the op3 program.
+	p.values("op3", programlines(prog))
+
+	// Show inputs.
+	p.field("inputs", strings.Join(inputnames(prog), " "))
+}
+
+// programlines returns the assignments in prog as strings.
+func programlines(prog *ast.Program) []string {
+	lines := []string{}
+	for _, a := range prog.Assignments {
+		lines = append(lines, a.String())
+	}
+	return lines
+}
+
+// inputnames returns the sorted names of input variables to prog.
+func inputnames(prog *ast.Program) []string {
+	names := []string{}
+	for _, input := range op3.Inputs(prog) {
+		names = append(names, input.String())
+	}
+	sort.Strings(names)
+	return names
+}
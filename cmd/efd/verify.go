@@ -0,0 +1,131 @@
+package main
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/mmcloughlin/ec3/efd"
+	"github.com/mmcloughlin/ec3/efd/cost"
+	"github.com/mmcloughlin/ec3/efd/op3"
+	"github.com/mmcloughlin/ec3/efd/op3/ast"
+	"github.com/mmcloughlin/ec3/efd/op3/eval"
+)
+
+// Verify checks the program for formula f. Verification confirms that costs
+// can be computed, that the program can be lowered to primitive operations, and
+// that evaluating the original and lowered programs modulo m on random inputs
+// produces the same results. Inputs fixed by the formula's assumptions are
+// initialized accordingly, see assumed.
+func Verify(f *efd.Formula, m *big.Int, trials int) error {
+	p := f.Program
+	if p == nil {
+		return xerrors.New("missing program")
+	}
+
+	if _, err := cost.Operations(f); err != nil {
+		return xerrors.Errorf("operation counts: %w", err)
+	}
+
+	lowered, err := op3.Lower(p)
+	if err != nil {
+		return xerrors.Errorf("lower: %w", err)
+	}
+
+	if !op3.IsPrimitive(lowered) {
+		return xerrors.New("lowered program is not primitive")
+	}
+
+	for trial := 0; trial < trials; trial++ {
+		if err := agree(p, lowered, f.Assume, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// agree evaluates programs a and b on the same random inputs modulo m,
+// subject to the given assumptions, and confirms every variable of a has the
+// same value in both.
+func agree(a, b *ast.Program, assume []string, m *big.Int) error {
+	ea := eval.NewEvaluator(m)
+	eb := eval.NewEvaluator(m)
+
+	inputs := op3.Inputs(a)
+	values := map[ast.Variable]*big.Int{}
+	for _, v := range inputs {
+		r, err := rand.Int(rand.Reader, m)
+		if err != nil {
+			return err
+		}
+		values[v] = r
+	}
+	assumed(values, assume, m)
+
+	for _, v := range inputs {
+		if err := ea.Initialize(v, values[v]); err != nil {
+			return err
+		}
+		if err := eb.Initialize(v, new(big.Int).Set(values[v])); err != nil {
+			return err
+		}
+	}
+
+	if err := ea.Execute(a); err != nil {
+		return xerrors.Errorf("evaluate: %w", err)
+	}
+
+	if err := eb.Execute(b); err != nil {
+		return xerrors.Errorf("evaluate lowered: %w", err)
+	}
+
+	for _, v := range op3.Variables(a) {
+		x, ok := ea.Load(v)
+		if !ok {
+			return xerrors.Errorf("missing value for variable %q", v)
+		}
+		y, ok := eb.Load(v)
+		if !ok {
+			return xerrors.Errorf("missing value for variable %q in lowered program", v)
+		}
+		if x.Cmp(y) != 0 {
+			return xerrors.Errorf("variable %q differs after lowering", v)
+		}
+	}
+
+	return nil
+}
+
+// assumed updates input values to satisfy assumptions. Only assumptions of the
+// form "x = c" for an integer constant c, and "x = y" for another input y, are
+// supported. Others are ignored, as are assumptions about variables that are
+// not inputs. Constants are reduced modulo m.
+func assumed(values map[ast.Variable]*big.Int, assume []string, m *big.Int) {
+	// Constants first, so that equalities see their final values.
+	var equalities [][2]ast.Variable
+	for _, a := range assume {
+		parts := strings.Split(a, "=")
+		if len(parts) != 2 {
+			continue
+		}
+		x := ast.Variable(strings.TrimSpace(parts[0]))
+		rhs := strings.TrimSpace(parts[1])
+		if _, ok := values[x]; !ok {
+			continue
+		}
+		if c, ok := new(big.Int).SetString(rhs, 10); ok {
+			values[x] = c.Mod(c, m)
+			continue
+		}
+		if y := ast.Variable(rhs); values[y] != nil {
+			equalities = append(equalities, [2]ast.Variable{x, y})
+		}
+	}
+
+	for _, e := range equalities {
+		values[e[0]] = new(big.Int).Set(values[e[1]])
+	}
+}
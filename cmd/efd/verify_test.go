@@ -0,0 +1,61 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/mmcloughlin/ec3/efd"
+	"github.com/mmcloughlin/ec3/efd/op3/ast"
+	"github.com/mmcloughlin/ec3/prime"
+)
+
+func TestAssumed(t *testing.T) {
+	m := big.NewInt(101)
+	values := map[ast.Variable]*big.Int{
+		"X1": big.NewInt(42),
+		"Z1": big.NewInt(17),
+		"Z2": big.NewInt(23),
+		"a":  big.NewInt(5),
+		"b":  big.NewInt(7),
+	}
+	assume := []string{
+		"Z1 = Z2", // equality with another input
+		"Z2=1",    // constant
+		"a = -2",  // negative constant
+		"b = 4 a", // unsupported
+		"k = 1",   // not an input
+	}
+
+	assumed(values, assume, m)
+
+	expect := map[ast.Variable]int64{
+		"X1": 42,
+		"Z1": 1,
+		"Z2": 1,
+		"a":  99,
+		"b":  7,
+	}
+	for v, x := range expect {
+		if values[v].Int64() != x {
+			t.Errorf("%s = %s; expect %d", v, values[v], x)
+		}
+	}
+	if _, ok := values["k"]; ok {
+		t.Error("assumption about non-input should be ignored")
+	}
+}
+
+func TestVerifyAll(t *testing.T) {
+	m := prime.NISTP256.Int()
+	for _, f := range efd.All.Filter(efd.WithProgram) {
+		if err := Verify(f, m, 1); err != nil {
+			t.Errorf("%s: %s", f.ID, err)
+		}
+	}
+}
+
+func TestVerifyMissingProgram(t *testing.T) {
+	if err := Verify(&efd.Formula{ID: "id"}, big.NewInt(101), 1); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -1,6 +1,12 @@
 package cost
 
-import "github.com/mmcloughlin/ec3/internal/errutil"
+import (
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/mmcloughlin/ec3/internal/errutil"
+)
 
 type Model interface {
 	Weight(Operation) float64
@@ -16,6 +22,11 @@ type Weights struct {
 	ConstM float64
 }
 
+// DefaultWeights are rough relative costs of field operations in a typical
+// 256-bit prime field implementation. Use the calibrate tool to derive weights
+// reflecting a specific implementation and machine.
+var DefaultWeights = Weights{I: 100, M: 1, S: 0.8, Pow: 1.8, ParamM: 1, Add: 0.1, ConstM: 0.2}
+
 func (w Weights) Weight(op Operation) float64 {
 	switch operation := op.(type) {
 	case Inv:
@@ -37,3 +48,35 @@ func (w Weights) Weight(op Operation) float64 {
 		panic(errutil.UnexpectedType(operation))
 	}
 }
+
+// ReadWeights reads JSON-encoded weights from r.
+func ReadWeights(r io.Reader) (Weights, error) {
+	var w Weights
+	d := json.NewDecoder(r)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&w); err != nil {
+		return Weights{}, err
+	}
+	return w, nil
+}
+
+// LoadWeights loads JSON-encoded weights from the given file.
+func LoadWeights(filename string) (Weights, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return Weights{}, err
+	}
+	defer f.Close()
+	return ReadWeights(f)
+}
+
+// WriteWeights writes w to wr in JSON format.
+func WriteWeights(wr io.Writer, w Weights) error {
+	b, err := json.MarshalIndent(w, "", "\t")
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	_, err = wr.Write(b)
+	return err
+}
@@ -0,0 +1,59 @@
+package cost
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mmcloughlin/ec3/efd"
+)
+
+func TestWeightsRoundTrip(t *testing.T) {
+	w := Weights{I: 91.5, M: 1, S: 0.87, Pow: 1.87, ParamM: 1, Add: 0.14, ConstM: 0.29}
+
+	var buf bytes.Buffer
+	if err := WriteWeights(&buf, w); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ReadWeights(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != w {
+		t.Fatalf("got %#v; expect %#v", got, w)
+	}
+}
+
+func TestReadWeightsUnknownField(t *testing.T) {
+	_, err := ReadWeights(strings.NewReader(`{"M": 1, "Q": 2}`))
+	if err == nil {
+		t.Fatal("expected error for unknown field")
+	}
+}
+
+func TestRank(t *testing.T) {
+	fs := efd.Select(
+		efd.WithShape("shortw"),
+		efd.WithRepresentation("jacobian-3"),
+		efd.WithOperation("doubling"),
+	)
+
+	m := Weights{I: 100, M: 1, S: 0.8, Pow: 1.8, ParamM: 1, Add: 0.1, ConstM: 0.2}
+	rs, err := Rank(fs, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rs) == 0 {
+		t.Fatal("no ranked formulae")
+	}
+
+	for i := 1; i < len(rs); i++ {
+		if rs[i].Weight < rs[i-1].Weight {
+			t.Fatalf("formulae out of order: %s (%v) after %s (%v)",
+				rs[i].Formula.ID, rs[i].Weight, rs[i-1].Formula.ID, rs[i-1].Weight)
+		}
+	}
+}
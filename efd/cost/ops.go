@@ -132,6 +132,9 @@ func Operations(f *efd.Formula) (Counts, error) {
 	for _, p := range f.AllParameters() {
 		isparam[ast.Variable(p)] = true
 	}
+	for _, p := range f.Program.Parameters {
+		isparam[p] = true
+	}
 
 	counts := map[Operation]int{}
 	for _, a := range f.Program.Assignments {
@@ -146,7 +149,7 @@ func Operations(f *efd.Formula) (Counts, error) {
 			op = Pow(e.N)
 		case ast.Add, ast.Sub, ast.Neg:
 			op = Add{}
-		case ast.Variable, ast.Constant:
+		case ast.Variable, ast.Constant, ast.Integer:
 			continue
 		default:
 			return nil, errutil.UnexpectedType(e)
@@ -182,6 +185,12 @@ func mul(m ast.Mul, isparam map[ast.Variable]bool) (Operation, error) {
 		return ConstMul(c), nil
 	}
 
+	// Multiplies by large or negative constants are equivalent to multiplies by
+	// a parameter.
+	if k, ok := m.X.(ast.Integer); ok {
+		return ParamMul(k.String()), nil
+	}
+
 	// Check for parameter multiply.
 	if v, ok := m.X.(ast.Variable); ok && isparam[v] {
 		return ParamMul(v), nil
@@ -0,0 +1,47 @@
+package cost
+
+import (
+	"sort"
+
+	"github.com/mmcloughlin/ec3/efd"
+)
+
+// Ranked is a formula together with its operation counts and total weight
+// under some cost model.
+type Ranked struct {
+	Formula *efd.Formula
+	Counts  Counts
+	Weight  float64
+}
+
+// Rank computes the cost of every formula with a program and returns them in
+// ascending order of weight according to the model m. Ties are broken by
+// formula ID. Formulae without programs are skipped.
+func Rank(fs efd.Formulae, m Model) ([]Ranked, error) {
+	rs := []Ranked{}
+	for _, f := range fs {
+		if f.Program == nil {
+			continue
+		}
+
+		counts, err := Operations(f)
+		if err != nil {
+			return nil, err
+		}
+
+		rs = append(rs, Ranked{
+			Formula: f,
+			Counts:  counts,
+			Weight:  counts.Weight(m),
+		})
+	}
+
+	sort.SliceStable(rs, func(i, j int) bool {
+		if rs[i].Weight != rs[j].Weight {
+			return rs[i].Weight < rs[j].Weight
+		}
+		return rs[i].Formula.ID < rs[j].Formula.ID
+	})
+
+	return rs, nil
+}
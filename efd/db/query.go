@@ -0,0 +1,102 @@
+package db
+
+import (
+	"sort"
+
+	"github.com/mmcloughlin/ec3/efd"
+)
+
+// Builtin returns a database containing the formulae compiled into the efd
+// package. The returned database holds copies of the built-in entries, so it
+// may be safely extended.
+func Builtin() *Database {
+	d := New()
+
+	for _, s := range efd.Shapes() {
+		c := *s
+		d.Shapes[c.ID] = &c
+	}
+
+	for _, r := range efd.Representations() {
+		c := *r
+		c.Shape = d.shape(r.Shape.ID)
+		d.Representations[c.ID] = &c
+	}
+
+	for _, f := range efd.All {
+		c := *f
+		c.Shape = d.shape(f.Shape.ID)
+		c.Representation = d.representation(f.Representation.ID)
+		d.Formulae[c.ID] = &c
+	}
+
+	return d
+}
+
+// Load opens the databases at the given filenames and merges them with the
+// built-in database. Each database may be a directory or tarball. Entries in
+// later databases take precedence over earlier ones, and all take precedence
+// over the built-in database.
+func Load(filenames ...string) (*Database, error) {
+	d := Builtin()
+	for _, filename := range filenames {
+		s, err := Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.Extend(s); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// Extend reads the contents of s into the database. Formulae in s may refer to
+// shapes and representations already present in d. Entries in s replace
+// existing entries with the same ID entirely: properties and programs not
+// present in s are not inherited from the replaced entry. The exception is a
+// formula program provided without metadata, which replaces only the program
+// of the existing formula.
+func (d *Database) Extend(s Store) error {
+	p := parser{
+		DB:       d,
+		replaced: map[string]bool{},
+	}
+	if err := Walk(s, p); err != nil {
+		return err
+	}
+	return d.finalize()
+}
+
+// All returns all formulae in the database, sorted by ID.
+func (d *Database) All() efd.Formulae {
+	return d.Select()
+}
+
+// Select returns formulae matching all the given predicates, sorted by ID.
+func (d *Database) Select(predicates ...efd.Predicate) efd.Formulae {
+	fs := make(efd.Formulae, 0, len(d.Formulae))
+	for _, f := range d.Formulae {
+		fs = append(fs, f)
+	}
+	sort.Slice(fs, func(i, j int) bool {
+		return fs[i].ID < fs[j].ID
+	})
+	return fs.Filter(predicates...)
+}
+
+// LookupShape returns the shape with the given ID, or nil if not found.
+func (d *Database) LookupShape(id string) *efd.Shape {
+	return d.Shapes[id]
+}
+
+// LookupRepresentation returns the representation with the given ID, or nil if
+// not found.
+func (d *Database) LookupRepresentation(id string) *efd.Representation {
+	return d.Representations[id]
+}
+
+// LookupFormula returns the formula with the given ID, or nil if not found.
+func (d *Database) LookupFormula(id string) *efd.Formula {
+	return d.Formulae[id]
+}
@@ -0,0 +1,180 @@
+package db
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mmcloughlin/ec3/efd"
+	"github.com/mmcloughlin/ec3/efd/op3/ast"
+	"github.com/mmcloughlin/ec3/internal/assert"
+)
+
+func TestBuiltin(t *testing.T) {
+	d := Builtin()
+	got := d.All()
+	if len(got) != len(efd.All) {
+		t.Fatalf("got %d formulae; expect %d", len(got), len(efd.All))
+	}
+	for _, f := range efd.All {
+		g := d.LookupFormula(f.ID)
+		if !reflect.DeepEqual(g, f) {
+			t.Fatalf("mismatch formula %q", f.ID)
+		}
+		if g == f {
+			t.Fatalf("formula %q not copied", f.ID)
+		}
+	}
+}
+
+func TestExtend(t *testing.T) {
+	d := Builtin()
+
+	const (
+		base = "custom/g1p/data/shortw/jacobian-3/doubling/"
+		id   = "g1p/shortw/jacobian-3/doubling/dbl-custom"
+	)
+	err := d.Extend(Merge(
+		Single(base+"dbl-custom", "source private\n"),
+		Single(base+"dbl-custom.op3", "X3 = X1\nY3 = Y1\nZ3 = Z1\n"),
+	))
+	assert.NoError(t, err)
+
+	// Lookup.
+	f := d.LookupFormula(id)
+	if f == nil {
+		t.Fatal("custom formula not found")
+	}
+
+	if f.Source != "private" {
+		t.Errorf("source = %q; expect %q", f.Source, "private")
+	}
+
+	if f.Program == nil || len(f.Program.Assignments) != 3 || f.Program.Assignments[0].RHS != ast.Variable("X1") {
+		t.Errorf("unexpected program %v", f.Program)
+	}
+
+	// Custom formula must reference the existing representation.
+	if f.Representation != d.LookupRepresentation("g1p/shortw/jacobian-3") {
+		t.Error("custom formula does not reference built-in representation")
+	}
+
+	// Select with built-in predicates.
+	fs := d.Select(
+		efd.WithShape("shortw"),
+		efd.WithRepresentation("jacobian-3"),
+		efd.WithOperation("doubling"),
+	)
+	if len(fs) != len(efd.Select(
+		efd.WithShape("shortw"),
+		efd.WithRepresentation("jacobian-3"),
+		efd.WithOperation("doubling"),
+	))+1 {
+		t.Errorf("expected selection to include custom formula")
+	}
+
+	// The built-in database should be unaffected.
+	if efd.LookupFormula(id) != nil {
+		t.Error("built-in database modified")
+	}
+}
+
+func TestExtendOverride(t *testing.T) {
+	d := Builtin()
+
+	const (
+		base = "custom/g1p/data/shortw/jacobian-3/addition/"
+		id   = "g1p/shortw/jacobian-3/addition/add-2007-bl"
+	)
+	builtin := efd.LookupFormula(id)
+	if builtin == nil || builtin.Program == nil {
+		t.Fatalf("expected built-in formula %q with program", id)
+	}
+	prev := d.LookupFormula(id)
+
+	// Override the formula without an op3 program.
+	err := d.Extend(Merge(
+		Single("custom/metadata", "formulaurl https://example.com/OPERATION-TAG\n"),
+		Single(base+"add-2007-bl", "source private\n"),
+	))
+	assert.NoError(t, err)
+
+	f := d.LookupFormula(id)
+	if f == nil {
+		t.Fatal("formula not found")
+	}
+
+	if f != prev {
+		t.Error("expected entry to be replaced in place")
+	}
+
+	if f.Collection != "custom" {
+		t.Errorf("collection = %q; expect %q", f.Collection, "custom")
+	}
+
+	if f.Source != "private" {
+		t.Errorf("source = %q; expect %q", f.Source, "private")
+	}
+
+	if f.Program != nil {
+		t.Errorf("program inherited from replaced entry")
+	}
+
+	if len(f.Assume) != 0 || len(f.Compute) != 0 {
+		t.Errorf("properties inherited from replaced entry")
+	}
+
+	const url = "https://example.com/addition-add-2007-bl"
+	if f.URL != url {
+		t.Errorf("url = %q; expect %q", f.URL, url)
+	}
+
+	// The built-in database should be unaffected.
+	if builtin.Program == nil || builtin.Source == "private" {
+		t.Error("built-in database modified")
+	}
+}
+
+func TestExtendOverrideProgram(t *testing.T) {
+	d := Builtin()
+
+	const id = "g1p/shortw/jacobian-3/addition/add-2007-bl"
+	prev := *d.LookupFormula(id)
+
+	// Override only the op3 program.
+	err := d.Extend(Single(
+		"custom/g1p/data/shortw/jacobian-3/addition/add-2007-bl.op3",
+		"X3 = X1\nY3 = Y1\nZ3 = Z1\n",
+	))
+	assert.NoError(t, err)
+
+	f := d.LookupFormula(id)
+	if f == nil {
+		t.Fatal("formula not found")
+	}
+
+	if f.Program == nil || len(f.Program.Assignments) != 3 || f.Program.Assignments[0].RHS != ast.Variable("X1") {
+		t.Errorf("unexpected program %v", f.Program)
+	}
+
+	// Metadata is retained from the existing entry.
+	if f.Shape != prev.Shape || f.Representation != prev.Representation {
+		t.Error("shape or representation not retained")
+	}
+	if f.Collection != prev.Collection || f.Source != prev.Source || f.URL != prev.URL {
+		t.Error("metadata not retained")
+	}
+	if !reflect.DeepEqual(f.Compute, prev.Compute) {
+		t.Error("properties not retained")
+	}
+}
+
+func TestExtendProgramWithoutMetadata(t *testing.T) {
+	d := Builtin()
+	err := d.Extend(Single(
+		"custom/g1p/data/shortw/jacobian-3/addition/add-missing.op3",
+		"X3 = X1\nY3 = Y1\nZ3 = Z1\n",
+	))
+	if err == nil {
+		t.Fatal("expected error for formula without metadata")
+	}
+}
@@ -118,7 +118,15 @@ func (d Database) formula(k string) *efd.Formula {
 	return d.Formulae[k]
 }
 
-func (d Database) finalize() {
+func (d Database) finalize() error {
+	// Every formula must have metadata. A program alone does not locate the
+	// formula within a shape and representation.
+	for _, f := range d.Formulae {
+		if f.Shape == nil || f.Representation == nil {
+			return xerrors.Errorf("formula %q has no metadata", f.ID)
+		}
+	}
+
 	// Set formula URLs.
 	for _, f := range d.Formulae {
 		if f.URL != "" {
@@ -134,21 +142,37 @@ func (d Database) finalize() {
 		tmpl := d.collection(f.Collection).FormulaURL
 		f.URL = r.Replace(tmpl)
 	}
+
+	return nil
 }
 
 func Read(s Store) (*Database, error) {
-	p := parser{
-		DB: New(),
-	}
-	if err := Walk(s, p); err != nil {
+	d := New()
+	if err := d.Extend(s); err != nil {
 		return nil, err
 	}
-	p.DB.finalize()
-	return p.DB, nil
+	return d, nil
 }
 
 type parser struct {
 	DB *Database
+
+	// replaced records entries already reset by this parser. Existing entries
+	// are replaced wholesale the first time their metadata is visited, rather
+	// than merged with the new properties. Formula programs are tracked
+	// separately, so that a program may be overridden on its own.
+	replaced map[string]bool
+}
+
+// replace reports whether the entry of the given kind and ID is visited for the
+// first time by this parser, and so must be reset before it is populated.
+func (p parser) replace(kind, id string) bool {
+	k := kind + ":" + id
+	if p.replaced[k] {
+		return false
+	}
+	p.replaced[k] = true
+	return true
 }
 
 func (p parser) Visit(f File) error {
@@ -193,6 +217,14 @@ func (p parser) metadata(k Key, r io.Reader) error {
 
 func (p parser) formula(k Key, r io.Reader) error {
 	f := p.DB.formula(k.FormulaID())
+	if p.replace("formula", f.ID) {
+		// Keep the program only if this parser provided it.
+		prog := f.Program
+		*f = efd.Formula{ID: f.ID}
+		if p.replaced["program:"+f.ID] {
+			f.Program = prog
+		}
+	}
 	f.Collection = k.Collection
 	f.Tag = k.Name
 	f.Class = k.Class
@@ -232,6 +264,9 @@ func (p parser) formula(k Key, r io.Reader) error {
 
 func (p parser) representation(k Key, r io.Reader) error {
 	repr := p.DB.representation(k.RepresentationID())
+	if p.replace("representation", repr.ID) {
+		*repr = efd.Representation{ID: repr.ID}
+	}
 	repr.Collection = k.Collection
 	repr.Tag = k.Representation
 	repr.Class = k.Class
@@ -267,6 +302,9 @@ func (p parser) representation(k Key, r io.Reader) error {
 
 func (p parser) shape(k Key, r io.Reader) error {
 	s := p.DB.shape(k.ShapeID())
+	if p.replace("shape", s.ID) {
+		*s = efd.Shape{ID: s.ID}
+	}
 	s.Collection = k.Collection
 	s.Tag = k.Shape
 	s.Class = k.Class
@@ -319,7 +357,9 @@ func (p parser) op3(k Key, r io.Reader) error {
 		return nil
 	}
 
+	// Replace only the program, keeping any existing metadata.
 	f := p.DB.formula(k.FormulaID())
+	p.replace("program", f.ID)
 	f.Program = prog
 	return nil
 }
@@ -49,6 +49,16 @@ func WithProgram(f *Formula) bool {
 	return f.Program != nil
 }
 
+// Shapes returns all curve shapes in the database.
+func Shapes() []*Shape {
+	return append([]*Shape{}, shapes...)
+}
+
+// Representations returns all coordinate representations in the database.
+func Representations() []*Representation {
+	return append([]*Representation{}, representations...)
+}
+
 func LookupShape(id string) *Shape {
 	for _, s := range shapes {
 		if s.ID == id {
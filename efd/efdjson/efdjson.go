@@ -0,0 +1,576 @@
+// Package efdjson implements a versioned JSON encoding of the Explicit-Formulas
+// Database.
+//
+// The encoding is intended for consumption by external tools. In addition to
+// the raw database properties, formulae carry their parsed op3 programs in
+// structured form, together with derived information such as program inputs,
+// outputs and operation counts.
+package efdjson
+
+import (
+	"encoding/json"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+
+	"golang.org/x/xerrors"
+
+	"github.com/mmcloughlin/ec3/efd"
+	"github.com/mmcloughlin/ec3/efd/cost"
+	"github.com/mmcloughlin/ec3/efd/db"
+	"github.com/mmcloughlin/ec3/efd/op3"
+	"github.com/mmcloughlin/ec3/efd/op3/ast"
+	"github.com/mmcloughlin/ec3/internal/errutil"
+)
+
+// Version of the schema. This must be incremented on incompatible changes.
+const Version = 1
+
+// Database is the top-level JSON object.
+type Database struct {
+	Version         int              `json:"version"`
+	Shapes          []Shape          `json:"shapes"`
+	Representations []Representation `json:"representations"`
+	Formulae        []Formula        `json:"formulae"`
+}
+
+// Shape is the JSON encoding of efd.Shape.
+type Shape struct {
+	ID              string    `json:"id"`
+	Collection      string    `json:"collection"`
+	Tag             string    `json:"tag"`
+	Class           string    `json:"class"`
+	Name            string    `json:"name"`
+	Parameters      []string  `json:"parameters"`
+	Coordinates     []string  `json:"coordinates"`
+	A               [7]string `json:"a"`
+	Satisfying      []string  `json:"satisfying"`
+	Addition        []string  `json:"addition"`
+	Doubling        []string  `json:"doubling"`
+	Negation        []string  `json:"negation"`
+	Neutral         []string  `json:"neutral"`
+	FromWeierstrass []string  `json:"fromweierstrass"`
+	ToWeierstrass   []string  `json:"toweierstrass"`
+}
+
+// Representation is the JSON encoding of efd.Representation. The shape is
+// referenced by ID.
+type Representation struct {
+	ID         string   `json:"id"`
+	Collection string   `json:"collection"`
+	Tag        string   `json:"tag"`
+	Class      string   `json:"class"`
+	Shape      string   `json:"shape"`
+	Name       string   `json:"name"`
+	Assume     []string `json:"assume"`
+	Parameters []string `json:"parameters"`
+	Variables  []string `json:"variables"`
+	Satisfying []string `json:"satisfying"`
+}
+
+// Formula is the JSON encoding of efd.Formula. The shape and representation
+// are referenced by ID.
+type Formula struct {
+	ID             string   `json:"id"`
+	Collection     string   `json:"collection"`
+	Tag            string   `json:"tag"`
+	Class          string   `json:"class"`
+	Shape          string   `json:"shape"`
+	Representation string   `json:"representation"`
+	Operation      string   `json:"operation"`
+	URL            string   `json:"url"`
+	Source         string   `json:"source"`
+	AppliesTo      string   `json:"appliesto"`
+	Assume         []string `json:"assume"`
+	Compute        []string `json:"compute"`
+	Parameters     []string `json:"parameters"`
+	Program        *Program `json:"program,omitempty"`
+}
+
+// Program is the JSON encoding of an op3 program. Inputs, outputs and
+// operation counts are derived from the assignments, and are ignored when
+// decoding.
+type Program struct {
+	Declarations *Declarations  `json:"declarations,omitempty"`
+	Assignments  []Assignment   `json:"assignments"`
+	Inputs       []string       `json:"inputs"`
+	Outputs      []string       `json:"outputs"`
+	Operations   map[string]int `json:"operations"`
+	Cost         string         `json:"cost"`
+}
+
+// Declarations are variable declarations made explicitly in an op3 program.
+type Declarations struct {
+	Parameters []string `json:"parameters,omitempty"`
+	Inputs     []string `json:"inputs,omitempty"`
+	Outputs    []string `json:"outputs,omitempty"`
+}
+
+// Assignment is the JSON encoding of an op3 assignment.
+type Assignment struct {
+	LHS  string    `json:"lhs"`
+	Op   string    `json:"op"`
+	Args []Operand `json:"args"`
+	Text string    `json:"text"`
+}
+
+// Operation types used in the encoding of expressions.
+const (
+	OpPow      = "pow"
+	OpInv      = "inv"
+	OpMul      = "mul"
+	OpNeg      = "neg"
+	OpAdd      = "add"
+	OpSub      = "sub"
+	OpCond     = "cond"
+	OpVariable = "var"
+	OpConstant = "const"
+)
+
+// Operand is the JSON encoding of an op3 operand. Exactly one of the fields
+// is set. Constants are decimal strings.
+type Operand struct {
+	Variable string `json:"var,omitempty"`
+	Constant string `json:"const,omitempty"`
+}
+
+// Builtin returns the encoding of the built-in database.
+func Builtin() (*Database, error) {
+	return Encode(efd.Shapes(), efd.Representations(), efd.All)
+}
+
+// Encode builds the JSON representation of the given database entries. Output
+// is sorted by ID.
+func Encode(shapes []*efd.Shape, reprs []*efd.Representation, fs []*efd.Formula) (*Database, error) {
+	d := &Database{Version: Version}
+
+	for _, s := range shapes {
+		d.Shapes = append(d.Shapes, Shape{
+			ID:              s.ID,
+			Collection:      s.Collection,
+			Tag:             s.Tag,
+			Class:           s.Class,
+			Name:            s.Name,
+			Parameters:      s.Parameters,
+			Coordinates:     s.Coordinates,
+			A:               s.A,
+			Satisfying:      s.Satisfying,
+			Addition:        s.Addition,
+			Doubling:        s.Doubling,
+			Negation:        s.Negation,
+			Neutral:         s.Neutral,
+			FromWeierstrass: s.FromWeierstrass,
+			ToWeierstrass:   s.ToWeierstrass,
+		})
+	}
+	sort.Slice(d.Shapes, func(i, j int) bool { return d.Shapes[i].ID < d.Shapes[j].ID })
+
+	for _, r := range reprs {
+		d.Representations = append(d.Representations, Representation{
+			ID:         r.ID,
+			Collection: r.Collection,
+			Tag:        r.Tag,
+			Class:      r.Class,
+			Shape:      r.Shape.ID,
+			Name:       r.Name,
+			Assume:     r.Assume,
+			Parameters: r.Parameters,
+			Variables:  r.Variables,
+			Satisfying: r.Satisfying,
+		})
+	}
+	sort.Slice(d.Representations, func(i, j int) bool { return d.Representations[i].ID < d.Representations[j].ID })
+
+	for _, f := range fs {
+		j := Formula{
+			ID:             f.ID,
+			Collection:     f.Collection,
+			Tag:            f.Tag,
+			Class:          f.Class,
+			Shape:          f.Shape.ID,
+			Representation: f.Representation.ID,
+			Operation:      f.Operation,
+			URL:            f.URL,
+			Source:         f.Source,
+			AppliesTo:      f.AppliesTo,
+			Assume:         f.Assume,
+			Compute:        f.Compute,
+			Parameters:     f.Parameters,
+		}
+
+		if f.Program != nil {
+			p, err := program(f)
+			if err != nil {
+				return nil, xerrors.Errorf("formula %q: %w", f.ID, err)
+			}
+			j.Program = p
+		}
+
+		d.Formulae = append(d.Formulae, j)
+	}
+	sort.Slice(d.Formulae, func(i, j int) bool { return d.Formulae[i].ID < d.Formulae[j].ID })
+
+	return d, nil
+}
+
+// program encodes the program for formula f.
+func program(f *efd.Formula) (*Program, error) {
+	p := &Program{
+		Inputs:     names(op3.SortedVariables(op3.Inputs(f.Program))),
+		Outputs:    names(Outputs(f)),
+		Operations: map[string]int{},
+	}
+
+	if len(f.Program.Parameters)+len(f.Program.Inputs)+len(f.Program.Outputs) > 0 {
+		p.Declarations = &Declarations{
+			Parameters: names(f.Program.Parameters),
+			Inputs:     names(f.Program.Inputs),
+			Outputs:    names(f.Program.Outputs),
+		}
+	}
+
+	for _, a := range f.Program.Assignments {
+		j, err := assignment(a)
+		if err != nil {
+			return nil, err
+		}
+		p.Assignments = append(p.Assignments, j)
+	}
+
+	counts, err := cost.Operations(f)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range counts {
+		p.Operations[c.Op.Code()] = c.N
+	}
+	p.Cost = counts.String()
+
+	return p, nil
+}
+
+func assignment(a ast.Assignment) (Assignment, error) {
+	j := Assignment{
+		LHS:  string(a.LHS),
+		Text: a.String(),
+	}
+
+	var args []ast.Operand
+	switch e := a.RHS.(type) {
+	case ast.Pow:
+		j.Op, args = OpPow, []ast.Operand{e.X, e.N}
+	case ast.Inv:
+		j.Op, args = OpInv, []ast.Operand{e.X}
+	case ast.Mul:
+		j.Op, args = OpMul, []ast.Operand{e.X, e.Y}
+	case ast.Neg:
+		j.Op, args = OpNeg, []ast.Operand{e.X}
+	case ast.Add:
+		j.Op, args = OpAdd, []ast.Operand{e.X, e.Y}
+	case ast.Sub:
+		j.Op, args = OpSub, []ast.Operand{e.X, e.Y}
+	case ast.Cond:
+		j.Op, args = OpCond, []ast.Operand{e.X, e.C}
+	case ast.Variable:
+		j.Op, args = OpVariable, []ast.Operand{e}
+	case ast.Constant, ast.Integer:
+		j.Op, args = OpConstant, []ast.Operand{e}
+	default:
+		return Assignment{}, errutil.UnexpectedType(e)
+	}
+
+	for _, arg := range args {
+		switch op := arg.(type) {
+		case ast.Variable:
+			j.Args = append(j.Args, Operand{Variable: string(op)})
+		case ast.Constant, ast.Integer:
+			j.Args = append(j.Args, Operand{Constant: op.String()})
+		default:
+			return Assignment{}, errutil.UnexpectedType(op)
+		}
+	}
+
+	return j, nil
+}
+
+// Outputs returns the output variables of the formula program. These are
+// assigned variables named after representation variables with a numeric
+// suffix, for example X3 in a representation with variable X.
+func Outputs(f *efd.Formula) []ast.Variable {
+	if f.Program == nil {
+		return nil
+	}
+
+	isvar := map[string]bool{}
+	for _, v := range f.Representation.Variables {
+		isvar[v] = true
+	}
+
+	seen := map[ast.Variable]bool{}
+	outputs := []ast.Variable{}
+	for _, a := range f.Program.Assignments {
+		m := indexed.FindStringSubmatch(string(a.LHS))
+		if m == nil || !isvar[m[1]] || seen[a.LHS] {
+			continue
+		}
+		seen[a.LHS] = true
+		outputs = append(outputs, a.LHS)
+	}
+
+	return op3.SortedVariables(outputs)
+}
+
+// indexed matches variable names with a numeric suffix.
+var indexed = regexp.MustCompile(`^(.*[^0-9])([0-9]+)$`)
+
+func names(vs []ast.Variable) []string {
+	s := make([]string, 0, len(vs))
+	for _, v := range vs {
+		s = append(s, string(v))
+	}
+	return s
+}
+
+func variables(s []string) []ast.Variable {
+	if len(s) == 0 {
+		return nil
+	}
+	vs := make([]ast.Variable, 0, len(s))
+	for _, v := range s {
+		vs = append(vs, ast.Variable(v))
+	}
+	return vs
+}
+
+// Decode reconstructs database entries from the JSON representation.
+func (d *Database) Decode() (*db.Database, error) {
+	if d.Version != Version {
+		return nil, xerrors.Errorf("unsupported schema version %d", d.Version)
+	}
+
+	r := db.New()
+
+	for _, j := range d.Shapes {
+		r.Shapes[j.ID] = &efd.Shape{
+			Collection:      j.Collection,
+			ID:              j.ID,
+			Tag:             j.Tag,
+			Class:           j.Class,
+			Name:            j.Name,
+			Parameters:      j.Parameters,
+			Coordinates:     j.Coordinates,
+			A:               j.A,
+			Satisfying:      j.Satisfying,
+			Addition:        j.Addition,
+			Doubling:        j.Doubling,
+			Negation:        j.Negation,
+			Neutral:         j.Neutral,
+			FromWeierstrass: j.FromWeierstrass,
+			ToWeierstrass:   j.ToWeierstrass,
+		}
+	}
+
+	for _, j := range d.Representations {
+		s, ok := r.Shapes[j.Shape]
+		if !ok {
+			return nil, xerrors.Errorf("representation %q: unknown shape %q", j.ID, j.Shape)
+		}
+		r.Representations[j.ID] = &efd.Representation{
+			Collection: j.Collection,
+			ID:         j.ID,
+			Tag:        j.Tag,
+			Class:      j.Class,
+			Shape:      s,
+			Name:       j.Name,
+			Assume:     j.Assume,
+			Parameters: j.Parameters,
+			Variables:  j.Variables,
+			Satisfying: j.Satisfying,
+		}
+	}
+
+	for _, j := range d.Formulae {
+		s, ok := r.Shapes[j.Shape]
+		if !ok {
+			return nil, xerrors.Errorf("formula %q: unknown shape %q", j.ID, j.Shape)
+		}
+		repr, ok := r.Representations[j.Representation]
+		if !ok {
+			return nil, xerrors.Errorf("formula %q: unknown representation %q", j.ID, j.Representation)
+		}
+
+		f := &efd.Formula{
+			Collection:     j.Collection,
+			ID:             j.ID,
+			Tag:            j.Tag,
+			Class:          j.Class,
+			Shape:          s,
+			Representation: repr,
+			Operation:      j.Operation,
+			URL:            j.URL,
+			Source:         j.Source,
+			AppliesTo:      j.AppliesTo,
+			Assume:         j.Assume,
+			Compute:        j.Compute,
+			Parameters:     j.Parameters,
+		}
+
+		if j.Program != nil {
+			p, err := j.Program.Decode()
+			if err != nil {
+				return nil, xerrors.Errorf("formula %q: %w", j.ID, err)
+			}
+			f.Program = p
+		}
+
+		r.Formulae[j.ID] = f
+	}
+
+	return r, nil
+}
+
+// Decode reconstructs the op3 program.
+func (p *Program) Decode() (*ast.Program, error) {
+	prog := &ast.Program{}
+	if d := p.Declarations; d != nil {
+		prog.Parameters = variables(d.Parameters)
+		prog.Inputs = variables(d.Inputs)
+		prog.Outputs = variables(d.Outputs)
+	}
+	for _, j := range p.Assignments {
+		a, err := j.Decode()
+		if err != nil {
+			return nil, err
+		}
+		prog.Assignments = append(prog.Assignments, a)
+	}
+	return prog, nil
+}
+
+// Decode reconstructs the op3 assignment.
+func (a Assignment) Decode() (ast.Assignment, error) {
+	args := make([]ast.Operand, 0, len(a.Args))
+	for _, arg := range a.Args {
+		op, err := arg.Decode()
+		if err != nil {
+			return ast.Assignment{}, err
+		}
+		args = append(args, op)
+	}
+
+	arity := map[string]int{
+		OpPow: 2, OpInv: 1, OpMul: 2, OpNeg: 1, OpAdd: 2,
+		OpSub: 2, OpCond: 2, OpVariable: 1, OpConstant: 1,
+	}
+	n, ok := arity[a.Op]
+	if !ok {
+		return ast.Assignment{}, xerrors.Errorf("unknown operation %q", a.Op)
+	}
+	if len(args) != n {
+		return ast.Assignment{}, xerrors.Errorf("operation %q expects %d arguments", a.Op, n)
+	}
+
+	var expr ast.Expression
+	var err error
+	switch a.Op {
+	case OpPow:
+		var x ast.Variable
+		var e ast.Constant
+		x, err = variable(args[0])
+		if err == nil {
+			e, err = constant(args[1])
+		}
+		expr = ast.Pow{X: x, N: e}
+	case OpInv:
+		expr = ast.Inv{X: args[0]}
+	case OpMul:
+		expr = ast.Mul{X: args[0], Y: args[1]}
+	case OpNeg:
+		expr = ast.Neg{X: args[0]}
+	case OpAdd:
+		expr = ast.Add{X: args[0], Y: args[1]}
+	case OpSub:
+		expr = ast.Sub{X: args[0], Y: args[1]}
+	case OpCond:
+		var x, c ast.Variable
+		x, err = variable(args[0])
+		if err == nil {
+			c, err = variable(args[1])
+		}
+		expr = ast.Cond{X: x, C: c}
+	case OpVariable:
+		expr, err = variable(args[0])
+	case OpConstant:
+		expr, err = constant(args[0])
+	}
+
+	if err != nil {
+		return ast.Assignment{}, xerrors.Errorf("operation %q: %w", a.Op, err)
+	}
+
+	return ast.Assignment{
+		LHS: ast.Variable(a.LHS),
+		RHS: expr,
+	}, nil
+}
+
+// Decode reconstructs the op3 operand.
+func (o Operand) Decode() (ast.Operand, error) {
+	switch {
+	case o.Variable != "" && o.Constant == "":
+		return ast.Variable(o.Variable), nil
+	case o.Constant != "" && o.Variable == "":
+		x, ok := new(big.Int).SetString(o.Constant, 10)
+		if !ok {
+			return nil, xerrors.Errorf("invalid constant %q", o.Constant)
+		}
+		return ast.NewConstant(x), nil
+	default:
+		return nil, xerrors.New("operand must be exactly one of variable or constant")
+	}
+}
+
+func variable(op ast.Operand) (ast.Variable, error) {
+	v, ok := op.(ast.Variable)
+	if !ok {
+		return "", xerrors.Errorf("expected variable; got %s", op)
+	}
+	return v, nil
+}
+
+func constant(op ast.Operand) (ast.Constant, error) {
+	c, ok := op.(ast.Constant)
+	if !ok {
+		return 0, xerrors.Errorf("expected constant; got %s", op)
+	}
+	return c, nil
+}
+
+// Write d to w in JSON format.
+func Write(w io.Writer, d *Database) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "\t")
+	return e.Encode(d)
+}
+
+// Read a JSON database from r. Errors if the schema version is not supported.
+func Read(r io.Reader) (*Database, error) {
+	d := &Database{}
+	if err := json.NewDecoder(r).Decode(d); err != nil {
+		return nil, err
+	}
+	if d.Version != Version {
+		return nil, xerrors.Errorf("unsupported schema version %d", d.Version)
+	}
+	return d, nil
+}
+
+// Load reads a JSON database from r and reconstructs its entries.
+func Load(r io.Reader) (*db.Database, error) {
+	d, err := Read(r)
+	if err != nil {
+		return nil, err
+	}
+	return d.Decode()
+}
@@ -0,0 +1,52 @@
+package efdjson
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mmcloughlin/ec3/efd"
+	"github.com/mmcloughlin/ec3/internal/assert"
+)
+
+func TestRoundTripBuiltin(t *testing.T) {
+	d, err := Builtin()
+	assert.NoError(t, err)
+
+	buf := bytes.NewBuffer(nil)
+	err = Write(buf, d)
+	assert.NoError(t, err)
+
+	got, err := Load(buf)
+	assert.NoError(t, err)
+
+	if len(got.Formulae) != len(efd.All) {
+		t.Fatalf("got %d formulae; expect %d", len(got.Formulae), len(efd.All))
+	}
+
+	for _, f := range efd.All {
+		if !reflect.DeepEqual(got.Formulae[f.ID], f) {
+			t.Fatalf("mismatch formula %q", f.ID)
+		}
+	}
+}
+
+func TestOutputs(t *testing.T) {
+	f := efd.LookupFormula("g1p/shortw/jacobian-3/addition/add-2007-bl")
+	if f == nil {
+		t.Fatal("formula not found")
+	}
+	got := Outputs(f)
+	expect := []string{"X3", "Y3", "Z3"}
+	if !reflect.DeepEqual(names(got), expect) {
+		t.Fatalf("got outputs %v; expect %v", got, expect)
+	}
+}
+
+func TestReadUnsupportedVersion(t *testing.T) {
+	_, err := Read(strings.NewReader(`{"version": 999}`))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,334 @@
+// Package eqn parses the polynomial equations used in EFD shape and
+// representation definitions, and compiles them to op3 programs.
+//
+// The syntax follows the EFD: sums and differences of terms, where terms are
+// products of factors written by juxtaposition (for example "3 a x" or
+// "a(x+1)^2"), and factors may be raised to constant integer powers.
+package eqn
+
+import (
+	"fmt"
+
+	"github.com/mmcloughlin/ec3/efd/op3"
+	"github.com/mmcloughlin/ec3/efd/op3/ast"
+	"github.com/mmcloughlin/ec3/internal/errutil"
+	"github.com/mmcloughlin/ec3/name"
+)
+
+// Expr is a polynomial expression.
+type Expr interface {
+	fmt.Stringer
+}
+
+// Variable is a named variable.
+type Variable string
+
+func (v Variable) String() string { return string(v) }
+
+// Constant is a non-negative integer constant.
+type Constant uint
+
+func (c Constant) String() string { return fmt.Sprint(uint(c)) }
+
+// Add is the sum X + Y.
+type Add struct{ X, Y Expr }
+
+func (a Add) String() string { return fmt.Sprintf("(%s+%s)", a.X, a.Y) }
+
+// Sub is the difference X - Y.
+type Sub struct{ X, Y Expr }
+
+func (s Sub) String() string { return fmt.Sprintf("(%s-%s)", s.X, s.Y) }
+
+// Mul is the product X Y.
+type Mul struct{ X, Y Expr }
+
+func (m Mul) String() string { return fmt.Sprintf("(%s %s)", m.X, m.Y) }
+
+// Neg is the negation -X.
+type Neg struct{ X Expr }
+
+func (n Neg) String() string { return fmt.Sprintf("(-%s)", n.X) }
+
+// Pow is X raised to the constant power N.
+type Pow struct {
+	X Expr
+	N Constant
+}
+
+func (p Pow) String() string { return fmt.Sprintf("%s^%s", p.X, p.N) }
+
+// Equation is an equality between two expressions.
+type Equation struct {
+	LHS Expr
+	RHS Expr
+}
+
+func (e Equation) String() string { return fmt.Sprintf("%s = %s", e.LHS, e.RHS) }
+
+// Substitute returns the equation with every occurrence of v replaced with x.
+func (e Equation) Substitute(v Variable, x Expr) Equation {
+	return Equation{
+		LHS: Substitute(e.LHS, v, x),
+		RHS: Substitute(e.RHS, v, x),
+	}
+}
+
+// Substitute returns e with every occurrence of v replaced with x.
+func Substitute(e Expr, v Variable, x Expr) Expr {
+	switch e := e.(type) {
+	case Variable:
+		if e == v {
+			return x
+		}
+		return e
+	case Constant:
+		return e
+	case Add:
+		return Add{X: Substitute(e.X, v, x), Y: Substitute(e.Y, v, x)}
+	case Sub:
+		return Sub{X: Substitute(e.X, v, x), Y: Substitute(e.Y, v, x)}
+	case Mul:
+		return Mul{X: Substitute(e.X, v, x), Y: Substitute(e.Y, v, x)}
+	case Neg:
+		return Neg{X: Substitute(e.X, v, x)}
+	case Pow:
+		return Pow{X: Substitute(e.X, v, x), N: e.N}
+	default:
+		panic(errutil.UnexpectedType(e))
+	}
+}
+
+// Simplify applies rewrite rules to reduce the number of operations required
+// to evaluate e. Products and sums with the constants 0 and 1 are folded,
+// negations are pushed out of products, and addition of a negation is replaced
+// with subtraction.
+func Simplify(e Expr) Expr {
+	switch e := e.(type) {
+	case Add:
+		x, y := Simplify(e.X), Simplify(e.Y)
+		if isconst(x, 0) {
+			return y
+		}
+		if isconst(y, 0) {
+			return x
+		}
+		if n, ok := y.(Neg); ok {
+			return Sub{X: x, Y: n.X}
+		}
+		if n, ok := x.(Neg); ok {
+			return Sub{X: y, Y: n.X}
+		}
+		return Add{X: x, Y: y}
+	case Sub:
+		x, y := Simplify(e.X), Simplify(e.Y)
+		if isconst(y, 0) {
+			return x
+		}
+		if isconst(x, 0) {
+			return Simplify(Neg{X: y})
+		}
+		if n, ok := y.(Neg); ok {
+			return Add{X: x, Y: n.X}
+		}
+		return Sub{X: x, Y: y}
+	case Mul:
+		x, y := Simplify(e.X), Simplify(e.Y)
+		if isconst(x, 0) || isconst(y, 0) {
+			return Constant(0)
+		}
+		if isconst(x, 1) {
+			return y
+		}
+		if isconst(y, 1) {
+			return x
+		}
+		if n, ok := x.(Neg); ok {
+			return Simplify(Neg{X: Mul{X: n.X, Y: y}})
+		}
+		if n, ok := y.(Neg); ok {
+			return Simplify(Neg{X: Mul{X: x, Y: n.X}})
+		}
+		return Mul{X: x, Y: y}
+	case Neg:
+		x := Simplify(e.X)
+		if isconst(x, 0) {
+			return x
+		}
+		if n, ok := x.(Neg); ok {
+			return n.X
+		}
+		return Neg{X: x}
+	case Pow:
+		return Pow{X: Simplify(e.X), N: e.N}
+	default:
+		return e
+	}
+}
+
+// isconst reports whether e is the constant k.
+func isconst(e Expr, k Constant) bool {
+	c, ok := e.(Constant)
+	return ok && c == k
+}
+
+// Program compiles the equation into an op3 program that computes the left and
+// right hand sides into the given variables.
+func (e Equation) Program(lhs, rhs ast.Variable) (*ast.Program, error) {
+	c := &compiler{}
+
+	// Temporaries must not collide with any variables in the equation.
+	vars := name.Uniqued(name.Temporaries())
+	for _, v := range Variables(e.LHS, e.RHS) {
+		vars.MarkUsed(string(v))
+	}
+	vars.MarkUsed(string(lhs), string(rhs))
+	c.vars = vars
+
+	if err := c.compile(Simplify(e.LHS), lhs); err != nil {
+		return nil, err
+	}
+	if err := c.compile(Simplify(e.RHS), rhs); err != nil {
+		return nil, err
+	}
+
+	p := &ast.Program{
+		Outputs:     []ast.Variable{lhs, rhs},
+		Assignments: c.assignments,
+	}
+	p.Inputs = op3.Inputs(p)
+
+	return p, nil
+}
+
+// Variables returns the distinct variables in the given expressions, in order
+// of first occurrence.
+func Variables(es ...Expr) []Variable {
+	seen := map[Variable]bool{}
+	vs := []Variable{}
+	var walk func(Expr)
+	walk = func(e Expr) {
+		switch e := e.(type) {
+		case Variable:
+			if !seen[e] {
+				vs = append(vs, e)
+				seen[e] = true
+			}
+		case Add:
+			walk(e.X)
+			walk(e.Y)
+		case Sub:
+			walk(e.X)
+			walk(e.Y)
+		case Mul:
+			walk(e.X)
+			walk(e.Y)
+		case Neg:
+			walk(e.X)
+		case Pow:
+			walk(e.X)
+		}
+	}
+	for _, e := range es {
+		walk(e)
+	}
+	return vs
+}
+
+type compiler struct {
+	vars        name.UniqueSequence
+	assignments []ast.Assignment
+}
+
+// compile emits assignments computing e into dst.
+func (c *compiler) compile(e Expr, dst ast.Variable) error {
+	var rhs ast.Expression
+	switch e := e.(type) {
+	case Variable:
+		rhs = ast.Variable(e)
+	case Constant:
+		rhs = ast.Constant(e)
+	case Add:
+		x, y, err := c.operands(e.X, e.Y)
+		if err != nil {
+			return err
+		}
+		rhs = ast.Add{X: x, Y: y}
+	case Sub:
+		x, y, err := c.operands(e.X, e.Y)
+		if err != nil {
+			return err
+		}
+		rhs = ast.Sub{X: x, Y: y}
+	case Mul:
+		// Multiplication by a constant is left for op3 lowering.
+		k, x := constant(e.X, e.Y)
+		if k != nil {
+			v, err := c.operand(x)
+			if err != nil {
+				return err
+			}
+			rhs = ast.Mul{X: ast.Constant(*k), Y: v}
+			break
+		}
+		x, y, err := c.operands(e.X, e.Y)
+		if err != nil {
+			return err
+		}
+		rhs = ast.Mul{X: x, Y: y}
+	case Neg:
+		x, err := c.operand(e.X)
+		if err != nil {
+			return err
+		}
+		rhs = ast.Neg{X: x}
+	case Pow:
+		x, err := c.operand(e.X)
+		if err != nil {
+			return err
+		}
+		rhs = ast.Pow{X: x, N: ast.Constant(e.N)}
+	default:
+		return errutil.UnexpectedType(e)
+	}
+
+	c.assignments = append(c.assignments, ast.Assignment{LHS: dst, RHS: rhs})
+	return nil
+}
+
+// operand returns a variable holding the value of e, emitting assignments to
+// compute it into a temporary if necessary.
+func (c *compiler) operand(e Expr) (ast.Variable, error) {
+	if v, ok := e.(Variable); ok {
+		return ast.Variable(v), nil
+	}
+	t := ast.Variable(c.vars.New())
+	if err := c.compile(e, t); err != nil {
+		return "", err
+	}
+	return t, nil
+}
+
+func (c *compiler) operands(x, y Expr) (ast.Variable, ast.Variable, error) {
+	vx, err := c.operand(x)
+	if err != nil {
+		return "", "", err
+	}
+	vy, err := c.operand(y)
+	if err != nil {
+		return "", "", err
+	}
+	return vx, vy, nil
+}
+
+// constant checks whether either x or y is a constant. If so, it returns the
+// constant and the other expression.
+func constant(x, y Expr) (*Constant, Expr) {
+	if k, ok := x.(Constant); ok {
+		return &k, y
+	}
+	if k, ok := y.(Constant); ok {
+		return &k, x
+	}
+	return nil, nil
+}
@@ -0,0 +1,132 @@
+package eqn
+
+import (
+	"crypto/elliptic"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/mmcloughlin/ec3/efd"
+	"github.com/mmcloughlin/ec3/efd/op3"
+	"github.com/mmcloughlin/ec3/efd/op3/eval"
+	"github.com/mmcloughlin/ec3/internal/bigint"
+	"github.com/mmcloughlin/ec3/internal/weierstrass"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Expect string
+	}{
+		{"y^2 = x^3 + a x + b", "y^2 = ((x^3+(a x))+b)"},
+		{"y^2 = x^3 + 3 a(x+1)^2", "y^2 = (x^3+((3 a) (x+1)^2))"},
+		{"a x^2+y^2 = 1+d x^2 y^2", "((a x^2)+y^2) = (1+((d x^2) y^2))"},
+		{"x^3+y^3+1=3 d x y", "((x^3+y^3)+1) = (((3 d) x) y)"},
+		{"y^2 + x y = x^3 + a2 x^2 + a6", "(y^2+(x y)) = ((x^3+(a2 x^2))+a6)"},
+		{"-x - -y = 0", "((-x)-(-y)) = 0"},
+	}
+	for _, c := range cases {
+		eq, err := Parse(c.Input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := eq.String(); got != c.Expect {
+			t.Errorf("Parse(%q) = %s; expect %s", c.Input, got, c.Expect)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"x",
+		"x = ",
+		"x = y = z",
+		"x = (y",
+		"x = y^a",
+		"x+y = W/Z",
+	} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q): expected error", s)
+		}
+	}
+}
+
+func TestShapes(t *testing.T) {
+	for _, s := range efd.Shapes() {
+		for _, satisfying := range s.Satisfying {
+			eq, err := Parse(satisfying)
+			if err != nil {
+				t.Fatalf("%s: %s", s.ID, err)
+			}
+			p, err := eq.Program("lhs", "rhs")
+			if err != nil {
+				t.Fatalf("%s: %s", s.ID, err)
+			}
+			if _, err := op3.Lower(p); err != nil {
+				t.Fatalf("%s: %s", s.ID, err)
+			}
+			t.Logf("%s: %s", s.ID, strings.ReplaceAll(p.String(), "\n", "; "))
+		}
+	}
+}
+
+func TestProgramShortWeierstrass(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Params *elliptic.CurveParams
+		A      string
+	}{
+		{Name: "p256", Params: elliptic.P256().Params(), A: "-3"},
+		{Name: "secp256k1", Params: weierstrass.Secp256k1().Params(), A: "0"},
+	}
+	for _, c := range cases {
+		c := c // scopelint
+		t.Run(c.Name, func(t *testing.T) {
+			eq, err := Parse("y^2 = x^3 + a x + b")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Substitute the coefficient a.
+			a, err := ParseExpr(c.A)
+			if err != nil {
+				t.Fatal(err)
+			}
+			eq = eq.Substitute("a", a)
+
+			p, err := eq.Program("lhs", "rhs")
+			if err != nil {
+				t.Fatal(err)
+			}
+			p, err = op3.Lower(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Log(p)
+
+			// Evaluate on the generator and an invalid point.
+			params := c.Params
+			for _, v := range []struct {
+				Y      *big.Int
+				Expect bool
+			}{
+				{Y: params.Gy, Expect: true},
+				{Y: new(big.Int).Add(params.Gy, bigint.One()), Expect: false},
+			} {
+				e := eval.NewEvaluator(params.P)
+				e.Store("x", params.Gx)
+				e.Store("y", v.Y)
+				e.Store("b", params.B)
+				if err := e.Execute(p); err != nil {
+					t.Fatal(err)
+				}
+				lhs, _ := e.Load("lhs")
+				rhs, _ := e.Load("rhs")
+				if got := lhs.Cmp(rhs) == 0; got != v.Expect {
+					t.Fatalf("equation holds: got %v; expect %v", got, v.Expect)
+				}
+			}
+		})
+	}
+}
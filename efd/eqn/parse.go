@@ -0,0 +1,252 @@
+package eqn
+
+import (
+	"strconv"
+	"unicode"
+
+	"golang.org/x/xerrors"
+)
+
+// Parse an equation.
+func Parse(s string) (Equation, error) {
+	p, err := newparser(s)
+	if err != nil {
+		return Equation{}, err
+	}
+
+	lhs, err := p.expr()
+	if err != nil {
+		return Equation{}, err
+	}
+	if err := p.expect("="); err != nil {
+		return Equation{}, err
+	}
+	rhs, err := p.expr()
+	if err != nil {
+		return Equation{}, err
+	}
+	if !p.done() {
+		return Equation{}, p.errorf("unexpected %q", p.peek())
+	}
+
+	return Equation{LHS: lhs, RHS: rhs}, nil
+}
+
+// ParseExpr parses an expression.
+func ParseExpr(s string) (Expr, error) {
+	p, err := newparser(s)
+	if err != nil {
+		return nil, err
+	}
+	e, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, p.errorf("unexpected %q", p.peek())
+	}
+	return e, nil
+}
+
+// parser is a recursive descent parser for the grammar:
+//
+//	equation = expr "=" expr
+//	expr     = term { ("+" | "-") term }
+//	term     = unary { unary }
+//	unary    = "-" unary | power
+//	power    = primary [ "^" number ]
+//	primary  = number | identifier | "(" expr ")"
+type parser struct {
+	src    string
+	tokens []string
+	pos    int
+}
+
+func newparser(s string) (*parser, error) {
+	tokens, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+	return &parser{src: s, tokens: tokens}, nil
+}
+
+func (p *parser) expr() (Expr, error) {
+	x, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch p.peek() {
+		case "+":
+			p.next()
+			y, err := p.term()
+			if err != nil {
+				return nil, err
+			}
+			x = Add{X: x, Y: y}
+		case "-":
+			p.next()
+			y, err := p.term()
+			if err != nil {
+				return nil, err
+			}
+			x = Sub{X: x, Y: y}
+		default:
+			return x, nil
+		}
+	}
+}
+
+func (p *parser) term() (Expr, error) {
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	// Juxtaposition denotes multiplication.
+	for p.factor() {
+		y, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		x = Mul{X: x, Y: y}
+	}
+	return x, nil
+}
+
+// factor reports whether the next token may start a factor of a product.
+func (p *parser) factor() bool {
+	t := p.peek()
+	return t == "(" || isnumber(t) || isidentifier(t)
+}
+
+func (p *parser) unary() (Expr, error) {
+	if p.peek() == "-" {
+		p.next()
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return Neg{X: x}, nil
+	}
+	return p.power()
+}
+
+func (p *parser) power() (Expr, error) {
+	x, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != "^" {
+		return x, nil
+	}
+	p.next()
+	n, err := p.number()
+	if err != nil {
+		return nil, err
+	}
+	return Pow{X: x, N: n}, nil
+}
+
+func (p *parser) primary() (Expr, error) {
+	t := p.peek()
+	switch {
+	case t == "(":
+		p.next()
+		x, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return x, nil
+	case isnumber(t):
+		return p.number()
+	case isidentifier(t):
+		p.next()
+		return Variable(t), nil
+	case t == "":
+		return nil, p.errorf("unexpected end of input")
+	default:
+		return nil, p.errorf("unexpected %q", t)
+	}
+}
+
+func (p *parser) number() (Constant, error) {
+	t := p.next()
+	n, err := strconv.ParseUint(t, 10, 0)
+	if err != nil {
+		return 0, p.errorf("expected number: %w", err)
+	}
+	return Constant(n), nil
+}
+
+func (p *parser) expect(t string) error {
+	if got := p.next(); got != t {
+		return p.errorf("expected %q; got %q", t, got)
+	}
+	return nil
+}
+
+func (p *parser) peek() string {
+	if p.done() {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() string {
+	t := p.peek()
+	if !p.done() {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) done() bool { return p.pos >= len(p.tokens) }
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return xerrors.Errorf("parse %q: "+format, append([]interface{}{p.src}, args...)...)
+}
+
+// lex splits s into tokens: numbers, identifiers and single-character
+// operators.
+func lex(s string) ([]string, error) {
+	var tokens []string
+	rs := []rune(s)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r):
+			j := i
+			for j < len(rs) && unicode.IsDigit(rs[j]) {
+				j++
+			}
+			tokens = append(tokens, string(rs[i:j]))
+			i = j
+		case unicode.IsLetter(r):
+			j := i
+			for j < len(rs) && (unicode.IsLetter(rs[j]) || unicode.IsDigit(rs[j])) {
+				j++
+			}
+			tokens = append(tokens, string(rs[i:j]))
+			i = j
+		case r == '+' || r == '-' || r == '^' || r == '(' || r == ')' || r == '=':
+			tokens = append(tokens, string(r))
+			i++
+		default:
+			return nil, xerrors.Errorf("parse %q: unsupported character %q", s, r)
+		}
+	}
+	return tokens, nil
+}
+
+func isnumber(t string) bool {
+	return t != "" && unicode.IsDigit([]rune(t)[0])
+}
+
+func isidentifier(t string) bool {
+	return t != "" && unicode.IsLetter([]rune(t)[0])
+}
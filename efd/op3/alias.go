@@ -63,6 +63,7 @@ func AliasCorrect(p *ast.Program, aliases [][]ast.Variable, outputs []ast.Variab
 
 	// Build new program.
 	q := RenameVariables(p, replacements)
+	q.Parameters, q.Inputs, q.Outputs = p.Parameters, p.Inputs, p.Outputs
 	q.Assignments = append(pre, q.Assignments...)
 	q.Assignments = append(q.Assignments, post...)
 
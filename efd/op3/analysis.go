@@ -94,7 +94,11 @@ func ReadOnly(p *ast.Program, v ast.Variable) bool {
 
 // RenameVariables applies the given variable replacements to the program p.
 func RenameVariables(p *ast.Program, replacements map[ast.Variable]ast.Variable) *ast.Program {
-	r := &ast.Program{}
+	r := &ast.Program{
+		Parameters: renamevariables(p.Parameters, replacements),
+		Inputs:     renamevariables(p.Inputs, replacements),
+		Outputs:    renamevariables(p.Outputs, replacements),
+	}
 	for _, a := range p.Assignments {
 		var expr ast.Expression
 		switch e := a.RHS.(type) {
@@ -129,7 +133,7 @@ func RenameVariables(p *ast.Program, replacements map[ast.Variable]ast.Variable)
 			}
 		case ast.Variable:
 			expr = renamevariable(e, replacements)
-		case ast.Constant:
+		case ast.Constant, ast.Integer:
 			expr = e
 		default:
 			panic(errutil.UnexpectedType(e))
@@ -150,6 +154,17 @@ func renameoperand(op ast.Operand, replacements map[ast.Variable]ast.Variable) a
 	return renamevariable(v, replacements)
 }
 
+func renamevariables(vs []ast.Variable, replacements map[ast.Variable]ast.Variable) []ast.Variable {
+	if vs == nil {
+		return nil
+	}
+	r := make([]ast.Variable, len(vs))
+	for i, v := range vs {
+		r[i] = renamevariable(v, replacements)
+	}
+	return r
+}
+
 func renamevariable(v ast.Variable, replacements map[ast.Variable]ast.Variable) ast.Variable {
 	if r, ok := replacements[v]; ok {
 		return r
@@ -213,6 +228,9 @@ func Pare(p *ast.Program, outputs []ast.Variable) (*ast.Program, error) {
 	}
 
 	return &ast.Program{
+		Parameters:  p.Parameters,
+		Inputs:      p.Inputs,
+		Outputs:     p.Outputs,
 		Assignments: required,
 	}, nil
 }
@@ -2,21 +2,42 @@ package ast
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
+	"strings"
 )
 
+// Program is a sequence of assignments, with optional declarations of curve
+// parameters, inputs and outputs.
 type Program struct {
+	Parameters  []Variable
+	Inputs      []Variable
+	Outputs     []Variable
 	Assignments []Assignment
 }
 
 func (p Program) String() string {
 	var s string
+	s += declaration("param", p.Parameters)
+	s += declaration("input", p.Inputs)
+	s += declaration("output", p.Outputs)
 	for _, a := range p.Assignments {
 		s += a.String() + "\n"
 	}
 	return s
 }
 
+func declaration(keyword string, vs []Variable) string {
+	if len(vs) == 0 {
+		return ""
+	}
+	names := make([]string, len(vs))
+	for i, v := range vs {
+		names[i] = string(v)
+	}
+	return keyword + " " + strings.Join(names, ", ") + "\n"
+}
+
 type Assignment struct {
 	LHS Variable
 	RHS Expression
@@ -105,3 +126,39 @@ type Constant uint
 func (c Constant) Inputs() []Operand { return []Operand{c} }
 func (c Constant) String() string    { return strconv.FormatUint(uint64(c), 10) }
 func (c Constant) GoString() string  { return fmt.Sprintf("ast.Constant(%d)", c) }
+
+// NewConstant returns an operand representing x. Values representable as
+// Constant are returned as such, otherwise an Integer is returned.
+func NewConstant(x *big.Int) Operand {
+	if x.Sign() >= 0 && x.IsUint64() && uint64(Constant(x.Uint64())) == x.Uint64() {
+		return Constant(x.Uint64())
+	}
+	return Integer{X: new(big.Int).Set(x)}
+}
+
+// ParseConstant parses a signed integer literal, with base implied by the
+// prefix as for big.Int SetString.
+func ParseConstant(s string) (Operand, error) {
+	x, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer literal %q", s)
+	}
+	return NewConstant(x), nil
+}
+
+// Integer is an arbitrary-precision signed integer constant, used for values
+// not representable by Constant.
+type Integer struct{ X *big.Int }
+
+// MustInteger parses the integer literal s. Panics on error.
+func MustInteger(s string) Integer {
+	x, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		panic(fmt.Sprintf("invalid integer literal %q", s))
+	}
+	return Integer{X: x}
+}
+
+func (i Integer) Inputs() []Operand { return []Operand{i} }
+func (i Integer) String() string    { return i.X.String() }
+func (i Integer) GoString() string  { return fmt.Sprintf("ast.MustInteger(%q)", i.X.String()) }
@@ -100,7 +100,7 @@ func (e *Evaluator) assignment(a ast.Assignment) error {
 		if x[1].Sign() != 0 {
 			lhs.Set(x[0])
 		}
-	case ast.Variable, ast.Constant:
+	case ast.Variable, ast.Constant, ast.Integer:
 		x, err := e.operand(expr)
 		if err != nil {
 			return err
@@ -144,6 +144,8 @@ func (e *Evaluator) operand(operand ast.Operand) (*big.Int, error) {
 		return x, nil
 	case ast.Constant:
 		return new(big.Int).SetUint64(uint64(op)), nil
+	case ast.Integer:
+		return new(big.Int).Set(op.X), nil
 	default:
 		return nil, errutil.UnexpectedType(op)
 	}
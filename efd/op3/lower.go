@@ -5,6 +5,7 @@ import (
 
 	"github.com/mmcloughlin/ec3/efd/op3/ast"
 	"github.com/mmcloughlin/ec3/internal/errutil"
+	"github.com/mmcloughlin/ec3/name"
 )
 
 // IsPrimitive reports whether p consists of only primitive operations.
@@ -28,7 +29,7 @@ func IsPrimitive(p *ast.Program) bool {
 // multiplies, they are typically best replaced with additions.
 func IsPrimitiveExpression(expr ast.Expression) bool {
 	switch e := expr.(type) {
-	case ast.Inv, ast.Neg, ast.Add, ast.Sub, ast.Cond, ast.Variable, ast.Constant:
+	case ast.Inv, ast.Neg, ast.Add, ast.Sub, ast.Cond, ast.Variable, ast.Constant, ast.Integer:
 		return true
 	case ast.Pow:
 		return e.N == 2
@@ -43,9 +44,19 @@ func IsPrimitiveExpression(expr ast.Expression) bool {
 
 // Lower p to primitive expressions.
 func Lower(p *ast.Program) (*ast.Program, error) {
-	l := &ast.Program{}
+	// Lowering may require temporaries.
+	vars := name.Uniqued(name.Temporaries())
+	for _, v := range Variables(p) {
+		vars.MarkUsed(string(v))
+	}
+
+	l := &ast.Program{
+		Parameters: p.Parameters,
+		Inputs:     p.Inputs,
+		Outputs:    p.Outputs,
+	}
 	for _, a := range p.Assignments {
-		as, err := lower(a)
+		as, err := lower(a, vars)
 		if err != nil {
 			return nil, err
 		}
@@ -54,11 +65,24 @@ func Lower(p *ast.Program) (*ast.Program, error) {
 	return l, nil
 }
 
-func lower(a ast.Assignment) ([]ast.Assignment, error) {
+func lower(a ast.Assignment, vars name.Sequence) ([]ast.Assignment, error) {
 	if IsPrimitiveExpression(a.RHS) {
 		return []ast.Assignment{a}, nil
 	}
 
+	// Multiplies by integers that are not small constants are executed as
+	// regular multiplies, with the integer first loaded into a temporary.
+	if m, ok := a.RHS.(ast.Mul); ok {
+		if as, ok := materialize(a.LHS, m, vars); ok {
+			n := len(as) - 1
+			mul, err := lower(as[n], vars)
+			if err != nil {
+				return nil, err
+			}
+			return append(as[:n], mul...), nil
+		}
+	}
+
 	// Non-primitive instructions are raising to a constant power xᶜ or
 	// multiplying by a constant c*x. In either case lowering the instructions
 	// reduces to an addition chain for the constant c. In this case the constants
@@ -69,29 +93,56 @@ func lower(a ast.Assignment) ([]ast.Assignment, error) {
 	// the original input x, so we don't have to worry about allocating
 	// temporaries.
 
-	// Depending on power or multiply, the define the interpretation of "add one"
-	// and "double" when building the constant.
+	// Determine the constant c and the input x.
 	var c uint
-	var first, add1, dbl ast.Expression
+	var x ast.Operand
 	switch e := a.RHS.(type) {
 	case ast.Pow:
 		c = uint(e.N)
-		first = ast.Pow{X: e.X, N: 2}
-		add1 = ast.Mul{X: a.LHS, Y: e.X}
-		dbl = ast.Pow{X: a.LHS, N: 2}
+		x = e.X
 	case ast.Mul:
-		x, ok := e.X.(ast.Constant)
+		k, ok := e.X.(ast.Constant)
 		if !ok {
 			return nil, errutil.AssertionFailure("expected constant multiplier")
 		}
-		c = uint(x)
-		first = ast.Add{X: e.Y, Y: e.Y}
-		add1 = ast.Add{X: a.LHS, Y: e.Y}
-		dbl = ast.Add{X: a.LHS, Y: a.LHS}
+		c = uint(k)
+		x = e.Y
 	default:
 		return nil, errutil.UnexpectedType(e)
 	}
 
+	// The binary algorithm below starts from the square or double, so cannot
+	// produce exponents or multipliers 0 and 1. These should have been
+	// simplified away.
+	if c < 2 {
+		return nil, errutil.AssertionFailure("cannot lower constant %d", c)
+	}
+
+	// If the input is also the output, "add one" steps would read the partial
+	// result rather than the original input. In this case take a copy of the
+	// input first.
+	as := []ast.Assignment{}
+	if x == a.LHS && bits.OnesCount(c) > 1 {
+		t := ast.Variable(vars.New())
+		as = append(as, ast.Assignment{LHS: t, RHS: a.LHS})
+		x = t
+	}
+
+	// Depending on power or multiply, the define the interpretation of "add one"
+	// and "double" when building the constant.
+	var first, add1, dbl ast.Expression
+	switch a.RHS.(type) {
+	case ast.Pow:
+		v := x.(ast.Variable)
+		first = ast.Pow{X: v, N: 2}
+		add1 = ast.Mul{X: a.LHS, Y: v}
+		dbl = ast.Pow{X: a.LHS, N: 2}
+	case ast.Mul:
+		first = ast.Add{X: x, Y: x}
+		add1 = ast.Add{X: a.LHS, Y: x}
+		dbl = ast.Add{X: a.LHS, Y: a.LHS}
+	}
+
 	// Left-to-right binary algorithm on the constant c.
 	//
 	// Note that since the first step is special, we start the loop from the second
@@ -107,7 +158,6 @@ func lower(a ast.Assignment) ([]ast.Assignment, error) {
 	}
 
 	// Convert to assignments.
-	as := []ast.Assignment{}
 	for _, expr := range exprs {
 		as = append(as, ast.Assignment{
 			LHS: a.LHS,
@@ -117,3 +167,25 @@ func lower(a ast.Assignment) ([]ast.Assignment, error) {
 
 	return as, nil
 }
+
+// materialize lowers a multiply involving an Integer operand by first loading
+// the integer into a temporary. Returns false if neither operand is an
+// Integer.
+func materialize(lhs ast.Variable, m ast.Mul, vars name.Sequence) ([]ast.Assignment, bool) {
+	as := []ast.Assignment{}
+	operands := []ast.Operand{m.X, m.Y}
+	for i, operand := range operands {
+		if k, ok := operand.(ast.Integer); ok {
+			t := ast.Variable(vars.New())
+			as = append(as, ast.Assignment{LHS: t, RHS: k})
+			operands[i] = t
+		}
+	}
+	if len(as) == 0 {
+		return nil, false
+	}
+	return append(as, ast.Assignment{
+		LHS: lhs,
+		RHS: ast.Mul{X: operands[0], Y: operands[1]},
+	}), true
+}
@@ -95,6 +95,7 @@ func TestIsPrimitiveExpression(t *testing.T) {
 func TestLowerCases(t *testing.T) {
 	a := ast.Variable("a")
 	b := ast.Variable("b")
+	t0 := ast.Variable("t0")
 	cases := []struct {
 		Name       string
 		Assignment ast.Assignment
@@ -149,6 +150,52 @@ func TestLowerCases(t *testing.T) {
 				{LHS: b, RHS: ast.Mul{X: b, Y: a}}, // a^3
 			},
 		},
+		{
+			Name: "mul3_self",
+			Assignment: ast.Assignment{
+				LHS: a,
+				RHS: ast.Mul{X: ast.Constant(3), Y: a},
+			},
+			Expect: []ast.Assignment{
+				{LHS: t0, RHS: a},                    // t0 = a
+				{LHS: a, RHS: ast.Add{X: t0, Y: t0}}, // 2*a
+				{LHS: a, RHS: ast.Add{X: a, Y: t0}},  // 3*a
+			},
+		},
+		{
+			Name: "mul4_self",
+			Assignment: ast.Assignment{
+				LHS: a,
+				RHS: ast.Mul{X: ast.Constant(4), Y: a},
+			},
+			Expect: []ast.Assignment{
+				{LHS: a, RHS: ast.Add{X: a, Y: a}}, // 2*a
+				{LHS: a, RHS: ast.Add{X: a, Y: a}}, // 4*a
+			},
+		},
+		{
+			Name: "cube_self",
+			Assignment: ast.Assignment{
+				LHS: a,
+				RHS: ast.Pow{X: a, N: 3},
+			},
+			Expect: []ast.Assignment{
+				{LHS: t0, RHS: a},                   // t0 = a
+				{LHS: a, RHS: ast.Pow{X: t0, N: 2}}, // a^2
+				{LHS: a, RHS: ast.Mul{X: a, Y: t0}}, // a^3
+			},
+		},
+		{
+			Name: "mul_integer",
+			Assignment: ast.Assignment{
+				LHS: b,
+				RHS: ast.Mul{X: ast.MustInteger("-3"), Y: a},
+			},
+			Expect: []ast.Assignment{
+				{LHS: t0, RHS: ast.MustInteger("-3")}, // t0 = -3
+				{LHS: b, RHS: ast.Mul{X: t0, Y: a}},   // -3*a
+			},
+		},
 	}
 	for _, c := range cases {
 		c := c // scopelint
@@ -173,6 +220,20 @@ func TestLowerCases(t *testing.T) {
 	}
 }
 
+func TestLowerSmallConstantErrors(t *testing.T) {
+	a := ast.Variable("a")
+	for _, c := range []ast.Constant{0, 1} {
+		p := &ast.Program{
+			Assignments: []ast.Assignment{
+				{LHS: "b", RHS: ast.Mul{X: c, Y: a}},
+			},
+		}
+		if _, err := Lower(p); err == nil {
+			t.Errorf("expected error lowering %s", p)
+		}
+	}
+}
+
 func TestLowerCorpus(t *testing.T) {
 	for id, p := range Corpus() {
 		low, err := Lower(p)
@@ -33,152 +33,367 @@ var g = &grammar{
 					exprs: []interface{}{
 						&labeledExpr{
 							pos:   position{line: 9, col: 12, offset: 45},
-							label: "as",
+							label: "ls",
 							expr: &zeroOrMoreExpr{
 								pos: position{line: 9, col: 15, offset: 48},
 								expr: &ruleRefExpr{
 									pos:  position{line: 9, col: 15, offset: 48},
-									name: "Assignment",
+									name: "Line",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 9, col: 27, offset: 60},
+							pos:  position{line: 9, col: 21, offset: 54},
+							name: "_",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 9, col: 23, offset: 56},
+							expr: &ruleRefExpr{
+								pos:  position{line: 9, col: 23, offset: 56},
+								name: "LineComment",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 9, col: 36, offset: 69},
 							name: "EOF",
 						},
 					},
 				},
 			},
 		},
+		{
+			name: "Line",
+			pos:  position{line: 22, col: 1, offset: 342},
+			expr: &actionExpr{
+				pos: position{line: 22, col: 9, offset: 350},
+				run: (*parser).callonLine1,
+				expr: &seqExpr{
+					pos: position{line: 22, col: 9, offset: 350},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 22, col: 9, offset: 350},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 22, col: 11, offset: 352},
+							label: "s",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 22, col: 13, offset: 354},
+								expr: &ruleRefExpr{
+									pos:  position{line: 22, col: 13, offset: 354},
+									name: "Statement",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 22, col: 24, offset: 365},
+							name: "_",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 22, col: 26, offset: 367},
+							expr: &ruleRefExpr{
+								pos:  position{line: 22, col: 26, offset: 367},
+								name: "LineComment",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 22, col: 39, offset: 380},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Statement",
+			pos:  position{line: 26, col: 1, offset: 407},
+			expr: &choiceExpr{
+				pos: position{line: 26, col: 14, offset: 420},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 26, col: 14, offset: 420},
+						name: "Param",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 26, col: 22, offset: 428},
+						name: "Input",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 26, col: 30, offset: 436},
+						name: "Output",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 26, col: 39, offset: 445},
+						name: "Assignment",
+					},
+				},
+			},
+		},
+		{
+			name: "Param",
+			pos:  position{line: 30, col: 1, offset: 474},
+			expr: &actionExpr{
+				pos: position{line: 30, col: 10, offset: 483},
+				run: (*parser).callonParam1,
+				expr: &seqExpr{
+					pos: position{line: 30, col: 10, offset: 483},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 30, col: 10, offset: 483},
+							val:        "param",
+							ignoreCase: false,
+							want:       "\"param\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 30, col: 18, offset: 491},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 30, col: 21, offset: 494},
+							label: "vs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 30, col: 24, offset: 497},
+								name: "Variables",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Input",
+			pos:  position{line: 36, col: 1, offset: 625},
+			expr: &actionExpr{
+				pos: position{line: 36, col: 10, offset: 634},
+				run: (*parser).callonInput1,
+				expr: &seqExpr{
+					pos: position{line: 36, col: 10, offset: 634},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 36, col: 10, offset: 634},
+							val:        "input",
+							ignoreCase: false,
+							want:       "\"input\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 36, col: 18, offset: 642},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 36, col: 21, offset: 645},
+							label: "vs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 36, col: 24, offset: 648},
+								name: "Variables",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Output",
+			pos:  position{line: 42, col: 1, offset: 768},
+			expr: &actionExpr{
+				pos: position{line: 42, col: 11, offset: 778},
+				run: (*parser).callonOutput1,
+				expr: &seqExpr{
+					pos: position{line: 42, col: 11, offset: 778},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 42, col: 11, offset: 778},
+							val:        "output",
+							ignoreCase: false,
+							want:       "\"output\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 42, col: 20, offset: 787},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 42, col: 23, offset: 790},
+							label: "vs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 42, col: 26, offset: 793},
+								name: "Variables",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Variables",
+			pos:  position{line: 48, col: 1, offset: 915},
+			expr: &actionExpr{
+				pos: position{line: 48, col: 14, offset: 928},
+				run: (*parser).callonVariables1,
+				expr: &seqExpr{
+					pos: position{line: 48, col: 14, offset: 928},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 48, col: 14, offset: 928},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 48, col: 20, offset: 934},
+								name: "Variable",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 48, col: 29, offset: 943},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 48, col: 34, offset: 948},
+								expr: &seqExpr{
+									pos: position{line: 48, col: 35, offset: 949},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 48, col: 35, offset: 949},
+											name: "_",
+										},
+										&litMatcher{
+											pos:        position{line: 48, col: 37, offset: 951},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 48, col: 41, offset: 955},
+											name: "_",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 48, col: 43, offset: 957},
+											name: "Variable",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Assignment",
-			pos:  position{line: 19, col: 1, offset: 241},
+			pos:  position{line: 58, col: 1, offset: 1166},
 			expr: &actionExpr{
-				pos: position{line: 19, col: 15, offset: 255},
+				pos: position{line: 58, col: 15, offset: 1180},
 				run: (*parser).callonAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 19, col: 15, offset: 255},
+					pos: position{line: 58, col: 15, offset: 1180},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 19, col: 15, offset: 255},
+							pos:   position{line: 58, col: 15, offset: 1180},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 19, col: 17, offset: 257},
+								pos:  position{line: 58, col: 17, offset: 1182},
 								name: "Variable",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 19, col: 26, offset: 266},
+							pos:  position{line: 58, col: 26, offset: 1191},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 19, col: 28, offset: 268},
+							pos:        position{line: 58, col: 28, offset: 1193},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 19, col: 32, offset: 272},
+							pos:  position{line: 58, col: 32, offset: 1197},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 19, col: 34, offset: 274},
+							pos:   position{line: 58, col: 34, offset: 1199},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 19, col: 36, offset: 276},
+								pos:  position{line: 58, col: 36, offset: 1201},
 								name: "Expression",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 19, col: 47, offset: 287},
-							name: "_",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 19, col: 49, offset: 289},
-							name: "EOL",
-						},
 					},
 				},
 			},
 		},
 		{
 			name: "Expression",
-			pos:  position{line: 28, col: 1, offset: 414},
+			pos:  position{line: 69, col: 1, offset: 1453},
 			expr: &choiceExpr{
-				pos: position{line: 28, col: 15, offset: 428},
+				pos: position{line: 69, col: 15, offset: 1467},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 28, col: 15, offset: 428},
+						pos:  position{line: 69, col: 15, offset: 1467},
 						name: "Pow",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 28, col: 21, offset: 434},
+						pos:  position{line: 69, col: 21, offset: 1473},
 						name: "Inv",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 28, col: 27, offset: 440},
+						pos:  position{line: 69, col: 27, offset: 1479},
 						name: "Mul",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 28, col: 33, offset: 446},
-						name: "Neg",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 28, col: 39, offset: 452},
+						pos:  position{line: 69, col: 33, offset: 1485},
 						name: "Add",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 28, col: 45, offset: 458},
+						pos:  position{line: 69, col: 39, offset: 1491},
 						name: "Sub",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 28, col: 51, offset: 464},
+						pos:  position{line: 69, col: 45, offset: 1497},
 						name: "Cond",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 28, col: 58, offset: 471},
+						pos:  position{line: 69, col: 52, offset: 1504},
 						name: "Operand",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 69, col: 62, offset: 1514},
+						name: "Neg",
+					},
 				},
 			},
 		},
 		{
 			name: "Pow",
-			pos:  position{line: 30, col: 1, offset: 480},
+			pos:  position{line: 71, col: 1, offset: 1519},
 			expr: &actionExpr{
-				pos: position{line: 30, col: 8, offset: 487},
+				pos: position{line: 71, col: 8, offset: 1526},
 				run: (*parser).callonPow1,
 				expr: &seqExpr{
-					pos: position{line: 30, col: 8, offset: 487},
+					pos: position{line: 71, col: 8, offset: 1526},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 30, col: 8, offset: 487},
+							pos:   position{line: 71, col: 8, offset: 1526},
 							label: "x",
 							expr: &ruleRefExpr{
-								pos:  position{line: 30, col: 10, offset: 489},
+								pos:  position{line: 71, col: 10, offset: 1528},
 								name: "Variable",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 30, col: 19, offset: 498},
+							pos:  position{line: 71, col: 19, offset: 1537},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 30, col: 21, offset: 500},
+							pos:        position{line: 71, col: 21, offset: 1539},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 30, col: 25, offset: 504},
+							pos:  position{line: 71, col: 25, offset: 1543},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 30, col: 27, offset: 506},
+							pos:   position{line: 71, col: 27, offset: 1545},
 							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 30, col: 29, offset: 508},
-								name: "Constant",
+								pos:  position{line: 71, col: 29, offset: 1547},
+								name: "Exponent",
 							},
 						},
 					},
@@ -187,38 +402,38 @@ var g = &grammar{
 		},
 		{
 			name: "Inv",
-			pos:  position{line: 37, col: 1, offset: 611},
+			pos:  position{line: 78, col: 1, offset: 1650},
 			expr: &actionExpr{
-				pos: position{line: 37, col: 8, offset: 618},
+				pos: position{line: 78, col: 8, offset: 1657},
 				run: (*parser).callonInv1,
 				expr: &seqExpr{
-					pos: position{line: 37, col: 8, offset: 618},
+					pos: position{line: 78, col: 8, offset: 1657},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 37, col: 8, offset: 618},
+							pos:        position{line: 78, col: 8, offset: 1657},
 							val:        "1",
 							ignoreCase: false,
 							want:       "\"1\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 37, col: 12, offset: 622},
+							pos:  position{line: 78, col: 12, offset: 1661},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 37, col: 14, offset: 624},
+							pos:        position{line: 78, col: 14, offset: 1663},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 37, col: 18, offset: 628},
+							pos:  position{line: 78, col: 18, offset: 1667},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 37, col: 20, offset: 630},
+							pos:   position{line: 78, col: 20, offset: 1669},
 							label: "x",
 							expr: &ruleRefExpr{
-								pos:  position{line: 37, col: 22, offset: 632},
+								pos:  position{line: 78, col: 22, offset: 1671},
 								name: "Variable",
 							},
 						},
@@ -228,40 +443,40 @@ var g = &grammar{
 		},
 		{
 			name: "Mul",
-			pos:  position{line: 43, col: 1, offset: 706},
+			pos:  position{line: 84, col: 1, offset: 1745},
 			expr: &actionExpr{
-				pos: position{line: 43, col: 8, offset: 713},
+				pos: position{line: 84, col: 8, offset: 1752},
 				run: (*parser).callonMul1,
 				expr: &seqExpr{
-					pos: position{line: 43, col: 8, offset: 713},
+					pos: position{line: 84, col: 8, offset: 1752},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 43, col: 8, offset: 713},
+							pos:   position{line: 84, col: 8, offset: 1752},
 							label: "x",
 							expr: &ruleRefExpr{
-								pos:  position{line: 43, col: 10, offset: 715},
+								pos:  position{line: 84, col: 10, offset: 1754},
 								name: "Operand",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 43, col: 18, offset: 723},
+							pos:  position{line: 84, col: 18, offset: 1762},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 43, col: 20, offset: 725},
+							pos:        position{line: 84, col: 20, offset: 1764},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 43, col: 24, offset: 729},
+							pos:  position{line: 84, col: 24, offset: 1768},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 43, col: 26, offset: 731},
+							pos:   position{line: 84, col: 26, offset: 1770},
 							label: "y",
 							expr: &ruleRefExpr{
-								pos:  position{line: 43, col: 28, offset: 733},
+								pos:  position{line: 84, col: 28, offset: 1772},
 								name: "Operand",
 							},
 						},
@@ -271,28 +486,28 @@ var g = &grammar{
 		},
 		{
 			name: "Neg",
-			pos:  position{line: 50, col: 1, offset: 833},
+			pos:  position{line: 91, col: 1, offset: 1872},
 			expr: &actionExpr{
-				pos: position{line: 50, col: 8, offset: 840},
+				pos: position{line: 91, col: 8, offset: 1879},
 				run: (*parser).callonNeg1,
 				expr: &seqExpr{
-					pos: position{line: 50, col: 8, offset: 840},
+					pos: position{line: 91, col: 8, offset: 1879},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 50, col: 8, offset: 840},
+							pos:        position{line: 91, col: 8, offset: 1879},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 50, col: 12, offset: 844},
+							pos:  position{line: 91, col: 12, offset: 1883},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 50, col: 14, offset: 846},
+							pos:   position{line: 91, col: 14, offset: 1885},
 							label: "x",
 							expr: &ruleRefExpr{
-								pos:  position{line: 50, col: 16, offset: 848},
+								pos:  position{line: 91, col: 16, offset: 1887},
 								name: "Variable",
 							},
 						},
@@ -302,40 +517,40 @@ var g = &grammar{
 		},
 		{
 			name: "Add",
-			pos:  position{line: 56, col: 1, offset: 922},
+			pos:  position{line: 97, col: 1, offset: 1961},
 			expr: &actionExpr{
-				pos: position{line: 56, col: 8, offset: 929},
+				pos: position{line: 97, col: 8, offset: 1968},
 				run: (*parser).callonAdd1,
 				expr: &seqExpr{
-					pos: position{line: 56, col: 8, offset: 929},
+					pos: position{line: 97, col: 8, offset: 1968},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 56, col: 8, offset: 929},
+							pos:   position{line: 97, col: 8, offset: 1968},
 							label: "x",
 							expr: &ruleRefExpr{
-								pos:  position{line: 56, col: 10, offset: 931},
+								pos:  position{line: 97, col: 10, offset: 1970},
 								name: "Operand",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 56, col: 18, offset: 939},
+							pos:  position{line: 97, col: 18, offset: 1978},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 56, col: 20, offset: 941},
+							pos:        position{line: 97, col: 20, offset: 1980},
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 56, col: 24, offset: 945},
+							pos:  position{line: 97, col: 24, offset: 1984},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 56, col: 26, offset: 947},
+							pos:   position{line: 97, col: 26, offset: 1986},
 							label: "y",
 							expr: &ruleRefExpr{
-								pos:  position{line: 56, col: 28, offset: 949},
+								pos:  position{line: 97, col: 28, offset: 1988},
 								name: "Operand",
 							},
 						},
@@ -345,40 +560,40 @@ var g = &grammar{
 		},
 		{
 			name: "Sub",
-			pos:  position{line: 63, col: 1, offset: 1049},
+			pos:  position{line: 104, col: 1, offset: 2088},
 			expr: &actionExpr{
-				pos: position{line: 63, col: 8, offset: 1056},
+				pos: position{line: 104, col: 8, offset: 2095},
 				run: (*parser).callonSub1,
 				expr: &seqExpr{
-					pos: position{line: 63, col: 8, offset: 1056},
+					pos: position{line: 104, col: 8, offset: 2095},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 63, col: 8, offset: 1056},
+							pos:   position{line: 104, col: 8, offset: 2095},
 							label: "x",
 							expr: &ruleRefExpr{
-								pos:  position{line: 63, col: 10, offset: 1058},
+								pos:  position{line: 104, col: 10, offset: 2097},
 								name: "Operand",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 63, col: 18, offset: 1066},
+							pos:  position{line: 104, col: 18, offset: 2105},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 63, col: 20, offset: 1068},
+							pos:        position{line: 104, col: 20, offset: 2107},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 63, col: 24, offset: 1072},
+							pos:  position{line: 104, col: 24, offset: 2111},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 63, col: 26, offset: 1074},
+							pos:   position{line: 104, col: 26, offset: 2113},
 							label: "y",
 							expr: &ruleRefExpr{
-								pos:  position{line: 63, col: 28, offset: 1076},
+								pos:  position{line: 104, col: 28, offset: 2115},
 								name: "Operand",
 							},
 						},
@@ -388,40 +603,40 @@ var g = &grammar{
 		},
 		{
 			name: "Cond",
-			pos:  position{line: 70, col: 1, offset: 1176},
+			pos:  position{line: 111, col: 1, offset: 2215},
 			expr: &actionExpr{
-				pos: position{line: 70, col: 9, offset: 1184},
+				pos: position{line: 111, col: 9, offset: 2223},
 				run: (*parser).callonCond1,
 				expr: &seqExpr{
-					pos: position{line: 70, col: 9, offset: 1184},
+					pos: position{line: 111, col: 9, offset: 2223},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 70, col: 9, offset: 1184},
+							pos:   position{line: 111, col: 9, offset: 2223},
 							label: "x",
 							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 11, offset: 1186},
+								pos:  position{line: 111, col: 11, offset: 2225},
 								name: "Variable",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 70, col: 20, offset: 1195},
+							pos:  position{line: 111, col: 20, offset: 2234},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 70, col: 22, offset: 1197},
+							pos:        position{line: 111, col: 22, offset: 2236},
 							val:        "?",
 							ignoreCase: false,
 							want:       "\"?\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 70, col: 26, offset: 1201},
+							pos:  position{line: 111, col: 26, offset: 2240},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 70, col: 28, offset: 1203},
+							pos:   position{line: 111, col: 28, offset: 2242},
 							label: "cond",
 							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 33, offset: 1208},
+								pos:  position{line: 111, col: 33, offset: 2247},
 								name: "Variable",
 							},
 						},
@@ -431,23 +646,23 @@ var g = &grammar{
 		},
 		{
 			name: "Operand",
-			pos:  position{line: 79, col: 1, offset: 1327},
+			pos:  position{line: 120, col: 1, offset: 2366},
 			expr: &actionExpr{
-				pos: position{line: 79, col: 12, offset: 1338},
+				pos: position{line: 120, col: 12, offset: 2377},
 				run: (*parser).callonOperand1,
 				expr: &labeledExpr{
-					pos:   position{line: 79, col: 12, offset: 1338},
+					pos:   position{line: 120, col: 12, offset: 2377},
 					label: "o",
 					expr: &choiceExpr{
-						pos: position{line: 79, col: 15, offset: 1341},
+						pos: position{line: 120, col: 15, offset: 2380},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 79, col: 15, offset: 1341},
-								name: "Variable",
+								pos:  position{line: 120, col: 15, offset: 2380},
+								name: "Constant",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 79, col: 26, offset: 1352},
-								name: "Constant",
+								pos:  position{line: 120, col: 26, offset: 2391},
+								name: "Variable",
 							},
 						},
 					},
@@ -456,17 +671,17 @@ var g = &grammar{
 		},
 		{
 			name: "Variable",
-			pos:  position{line: 83, col: 1, offset: 1399},
+			pos:  position{line: 124, col: 1, offset: 2438},
 			expr: &actionExpr{
-				pos: position{line: 83, col: 13, offset: 1411},
+				pos: position{line: 124, col: 13, offset: 2450},
 				run: (*parser).callonVariable1,
 				expr: &seqExpr{
-					pos: position{line: 83, col: 13, offset: 1411},
+					pos: position{line: 124, col: 13, offset: 2450},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 83, col: 13, offset: 1411},
+							pos: position{line: 124, col: 13, offset: 2450},
 							expr: &charClassMatcher{
-								pos:        position{line: 83, col: 13, offset: 1411},
+								pos:        position{line: 124, col: 13, offset: 2450},
 								val:        "[0-9]",
 								ranges:     []rune{'0', '9'},
 								ignoreCase: false,
@@ -474,16 +689,16 @@ var g = &grammar{
 							},
 						},
 						&charClassMatcher{
-							pos:        position{line: 83, col: 20, offset: 1418},
+							pos:        position{line: 124, col: 20, offset: 2457},
 							val:        "[a-zA-Z]",
 							ranges:     []rune{'a', 'z', 'A', 'Z'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 83, col: 29, offset: 1427},
+							pos: position{line: 124, col: 29, offset: 2466},
 							expr: &charClassMatcher{
-								pos:        position{line: 83, col: 29, offset: 1427},
+								pos:        position{line: 124, col: 29, offset: 2466},
 								val:        "[a-zA-Z0-9]",
 								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
 								ignoreCase: false,
@@ -496,15 +711,64 @@ var g = &grammar{
 		},
 		{
 			name: "Constant",
-			pos:  position{line: 87, col: 1, offset: 1482},
+			pos:  position{line: 128, col: 1, offset: 2521},
 			expr: &actionExpr{
-				pos: position{line: 87, col: 13, offset: 1494},
+				pos: position{line: 128, col: 13, offset: 2533},
 				run: (*parser).callonConstant1,
+				expr: &seqExpr{
+					pos: position{line: 128, col: 13, offset: 2533},
+					exprs: []interface{}{
+						&zeroOrOneExpr{
+							pos: position{line: 128, col: 13, offset: 2533},
+							expr: &litMatcher{
+								pos:        position{line: 128, col: 13, offset: 2533},
+								val:        "-",
+								ignoreCase: false,
+								want:       "\"-\"",
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 128, col: 19, offset: 2539},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 128, col: 19, offset: 2539},
+									name: "HexUintLiteral",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 128, col: 36, offset: 2556},
+									name: "OctalUintLiteral",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 128, col: 55, offset: 2575},
+									name: "DecimalUintLiteral",
+								},
+							},
+						},
+						&notExpr{
+							pos: position{line: 128, col: 75, offset: 2595},
+							expr: &charClassMatcher{
+								pos:        position{line: 128, col: 76, offset: 2596},
+								val:        "[a-zA-Z0-9]",
+								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Exponent",
+			pos:  position{line: 132, col: 1, offset: 2658},
+			expr: &actionExpr{
+				pos: position{line: 132, col: 13, offset: 2670},
+				run: (*parser).callonExponent1,
 				expr: &labeledExpr{
-					pos:   position{line: 87, col: 13, offset: 1494},
+					pos:   position{line: 132, col: 13, offset: 2670},
 					label: "u",
 					expr: &ruleRefExpr{
-						pos:  position{line: 87, col: 15, offset: 1496},
+						pos:  position{line: 132, col: 15, offset: 2672},
 						name: "Uint64Literal",
 					},
 				},
@@ -512,23 +776,23 @@ var g = &grammar{
 		},
 		{
 			name: "Uint64Literal",
-			pos:  position{line: 93, col: 1, offset: 1571},
+			pos:  position{line: 138, col: 1, offset: 2747},
 			expr: &actionExpr{
-				pos: position{line: 93, col: 18, offset: 1588},
+				pos: position{line: 138, col: 18, offset: 2764},
 				run: (*parser).callonUint64Literal1,
 				expr: &choiceExpr{
-					pos: position{line: 93, col: 19, offset: 1589},
+					pos: position{line: 138, col: 19, offset: 2765},
 					alternatives: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 93, col: 19, offset: 1589},
+							pos:  position{line: 138, col: 19, offset: 2765},
 							name: "HexUintLiteral",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 93, col: 36, offset: 1606},
+							pos:  position{line: 138, col: 36, offset: 2782},
 							name: "OctalUintLiteral",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 93, col: 55, offset: 1625},
+							pos:  position{line: 138, col: 55, offset: 2801},
 							name: "DecimalUintLiteral",
 						},
 					},
@@ -537,11 +801,11 @@ var g = &grammar{
 		},
 		{
 			name: "DecimalUintLiteral",
-			pos:  position{line: 97, col: 1, offset: 1702},
+			pos:  position{line: 142, col: 1, offset: 2878},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 97, col: 23, offset: 1724},
+				pos: position{line: 142, col: 23, offset: 2900},
 				expr: &charClassMatcher{
-					pos:        position{line: 97, col: 23, offset: 1724},
+					pos:        position{line: 142, col: 23, offset: 2900},
 					val:        "[0-9]",
 					ranges:     []rune{'0', '9'},
 					ignoreCase: false,
@@ -551,20 +815,20 @@ var g = &grammar{
 		},
 		{
 			name: "HexUintLiteral",
-			pos:  position{line: 99, col: 1, offset: 1732},
+			pos:  position{line: 144, col: 1, offset: 2908},
 			expr: &seqExpr{
-				pos: position{line: 99, col: 19, offset: 1750},
+				pos: position{line: 144, col: 19, offset: 2926},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 99, col: 19, offset: 1750},
+						pos:        position{line: 144, col: 19, offset: 2926},
 						val:        "0x",
 						ignoreCase: false,
 						want:       "\"0x\"",
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 99, col: 24, offset: 1755},
+						pos: position{line: 144, col: 24, offset: 2931},
 						expr: &charClassMatcher{
-							pos:        position{line: 99, col: 24, offset: 1755},
+							pos:        position{line: 144, col: 24, offset: 2931},
 							val:        "[0-9a-fA-F]",
 							ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
 							ignoreCase: false,
@@ -576,20 +840,20 @@ var g = &grammar{
 		},
 		{
 			name: "OctalUintLiteral",
-			pos:  position{line: 101, col: 1, offset: 1769},
+			pos:  position{line: 146, col: 1, offset: 2945},
 			expr: &seqExpr{
-				pos: position{line: 101, col: 21, offset: 1789},
+				pos: position{line: 146, col: 21, offset: 2965},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 101, col: 21, offset: 1789},
+						pos:        position{line: 146, col: 21, offset: 2965},
 						val:        "0",
 						ignoreCase: false,
 						want:       "\"0\"",
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 101, col: 25, offset: 1793},
+						pos: position{line: 146, col: 25, offset: 2969},
 						expr: &charClassMatcher{
-							pos:        position{line: 101, col: 25, offset: 1793},
+							pos:        position{line: 146, col: 25, offset: 2969},
 							val:        "[0-7]",
 							ranges:     []rune{'0', '7'},
 							ignoreCase: false,
@@ -599,44 +863,127 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "LineComment",
+			pos:  position{line: 150, col: 1, offset: 2990},
+			expr: &seqExpr{
+				pos: position{line: 150, col: 16, offset: 3005},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 150, col: 16, offset: 3005},
+						val:        "#",
+						ignoreCase: false,
+						want:       "\"#\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 150, col: 20, offset: 3009},
+						expr: &seqExpr{
+							pos: position{line: 150, col: 21, offset: 3010},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 150, col: 21, offset: 3010},
+									expr: &ruleRefExpr{
+										pos:  position{line: 150, col: 22, offset: 3011},
+										name: "EOL",
+									},
+								},
+								&anyMatcher{
+									line: 150, col: 26, offset: 3015,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BlockComment",
+			pos:  position{line: 152, col: 1, offset: 3020},
+			expr: &seqExpr{
+				pos: position{line: 152, col: 17, offset: 3036},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 152, col: 17, offset: 3036},
+						val:        "/*",
+						ignoreCase: false,
+						want:       "\"/*\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 152, col: 22, offset: 3041},
+						expr: &seqExpr{
+							pos: position{line: 152, col: 23, offset: 3042},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 152, col: 23, offset: 3042},
+									expr: &litMatcher{
+										pos:        position{line: 152, col: 24, offset: 3043},
+										val:        "*/",
+										ignoreCase: false,
+										want:       "\"*/\"",
+									},
+								},
+								&anyMatcher{
+									line: 152, col: 29, offset: 3048,
+								},
+							},
+						},
+					},
+					&litMatcher{
+						pos:        position{line: 152, col: 33, offset: 3052},
+						val:        "*/",
+						ignoreCase: false,
+						want:       "\"*/\"",
+					},
+				},
+			},
+		},
 		{
 			name: "__",
-			pos:  position{line: 105, col: 1, offset: 1823},
+			pos:  position{line: 156, col: 1, offset: 3080},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 105, col: 7, offset: 1829},
+				pos: position{line: 156, col: 7, offset: 3086},
 				expr: &ruleRefExpr{
-					pos:  position{line: 105, col: 7, offset: 1829},
+					pos:  position{line: 156, col: 7, offset: 3086},
 					name: "Whitespace",
 				},
 			},
 		},
 		{
 			name: "_",
-			pos:  position{line: 106, col: 1, offset: 1841},
+			pos:  position{line: 157, col: 1, offset: 3098},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 106, col: 6, offset: 1846},
+				pos: position{line: 157, col: 6, offset: 3103},
 				expr: &ruleRefExpr{
-					pos:  position{line: 106, col: 6, offset: 1846},
+					pos:  position{line: 157, col: 6, offset: 3103},
 					name: "Whitespace",
 				},
 			},
 		},
 		{
 			name: "Whitespace",
-			pos:  position{line: 108, col: 1, offset: 1859},
-			expr: &charClassMatcher{
-				pos:        position{line: 108, col: 15, offset: 1873},
-				val:        "[ \\t\\r]",
-				chars:      []rune{' ', '\t', '\r'},
-				ignoreCase: false,
-				inverted:   false,
+			pos:  position{line: 159, col: 1, offset: 3116},
+			expr: &choiceExpr{
+				pos: position{line: 159, col: 15, offset: 3130},
+				alternatives: []interface{}{
+					&charClassMatcher{
+						pos:        position{line: 159, col: 15, offset: 3130},
+						val:        "[ \\t\\r]",
+						chars:      []rune{' ', '\t', '\r'},
+						ignoreCase: false,
+						inverted:   false,
+					},
+					&ruleRefExpr{
+						pos:  position{line: 159, col: 25, offset: 3140},
+						name: "BlockComment",
+					},
+				},
 			},
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 109, col: 1, offset: 1881},
+			pos:  position{line: 160, col: 1, offset: 3153},
 			expr: &litMatcher{
-				pos:        position{line: 109, col: 8, offset: 1888},
+				pos:        position{line: 160, col: 8, offset: 3160},
 				val:        "\n",
 				ignoreCase: false,
 				want:       "\"\\n\"",
@@ -644,21 +991,26 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 110, col: 1, offset: 1893},
+			pos:  position{line: 161, col: 1, offset: 3165},
 			expr: &notExpr{
-				pos: position{line: 110, col: 8, offset: 1900},
+				pos: position{line: 161, col: 8, offset: 3172},
 				expr: &anyMatcher{
-					line: 110, col: 9, offset: 1901,
+					line: 161, col: 9, offset: 3173,
 				},
 			},
 		},
 	},
 }
 
-func (c *current) onProgram1(as interface{}) (interface{}, error) {
+func (c *current) onProgram1(ls interface{}) (interface{}, error) {
 	p := &ast.Program{}
-	for _, a := range as.([]interface{}) {
-		p.Assignments = append(p.Assignments, a.(ast.Assignment))
+	for _, l := range ls.([]interface{}) {
+		switch s := l.(type) {
+		case ast.Assignment:
+			p.Assignments = append(p.Assignments, s)
+		case func(*ast.Program):
+			s(p)
+		}
 	}
 	return p, nil
 }
@@ -666,7 +1018,67 @@ func (c *current) onProgram1(as interface{}) (interface{}, error) {
 func (p *parser) callonProgram1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onProgram1(stack["as"])
+	return p.cur.onProgram1(stack["ls"])
+}
+
+func (c *current) onLine1(s interface{}) (interface{}, error) {
+	return s, nil
+}
+
+func (p *parser) callonLine1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLine1(stack["s"])
+}
+
+func (c *current) onParam1(vs interface{}) (interface{}, error) {
+	return func(p *ast.Program) {
+		p.Parameters = append(p.Parameters, vs.([]ast.Variable)...)
+	}, nil
+}
+
+func (p *parser) callonParam1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParam1(stack["vs"])
+}
+
+func (c *current) onInput1(vs interface{}) (interface{}, error) {
+	return func(p *ast.Program) {
+		p.Inputs = append(p.Inputs, vs.([]ast.Variable)...)
+	}, nil
+}
+
+func (p *parser) callonInput1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInput1(stack["vs"])
+}
+
+func (c *current) onOutput1(vs interface{}) (interface{}, error) {
+	return func(p *ast.Program) {
+		p.Outputs = append(p.Outputs, vs.([]ast.Variable)...)
+	}, nil
+}
+
+func (p *parser) callonOutput1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOutput1(stack["vs"])
+}
+
+func (c *current) onVariables1(first, rest interface{}) (interface{}, error) {
+	vs := []ast.Variable{first.(ast.Variable)}
+	for _, r := range rest.([]interface{}) {
+		vs = append(vs, r.([]interface{})[3].(ast.Variable))
+	}
+	return vs, nil
+}
+
+func (p *parser) callonVariables1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVariables1(stack["first"], stack["rest"])
 }
 
 func (c *current) onAssignment1(v, e interface{}) (interface{}, error) {
@@ -791,14 +1203,24 @@ func (p *parser) callonVariable1() (interface{}, error) {
 	return p.cur.onVariable1()
 }
 
-func (c *current) onConstant1(u interface{}) (interface{}, error) {
-	return ast.Constant(u.(uint64)), nil
+func (c *current) onConstant1() (interface{}, error) {
+	return ast.ParseConstant(string(c.text))
 }
 
 func (p *parser) callonConstant1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onConstant1(stack["u"])
+	return p.cur.onConstant1()
+}
+
+func (c *current) onExponent1(u interface{}) (interface{}, error) {
+	return ast.Constant(u.(uint64)), nil
+}
+
+func (p *parser) callonExponent1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExponent1(stack["u"])
 }
 
 func (c *current) onUint64Literal1() (interface{}, error) {
@@ -193,6 +193,20 @@ func TestParseCases(t *testing.T) {
 				},
 			},
 		},
+		{
+			Source: "x = -3",
+			Expect: ast.Assignment{
+				LHS: "x",
+				RHS: ast.MustInteger("-3"),
+			},
+		},
+		{
+			Source: "x = -0x10",
+			Expect: ast.Assignment{
+				LHS: "x",
+				RHS: ast.MustInteger("-0x10"),
+			},
+		},
 		{
 			Source: "t14 = 16*t12",
 			Expect: ast.Assignment{
@@ -264,3 +278,60 @@ func TestParseCases(t *testing.T) {
 		t.Fatal("mismatch")
 	}
 }
+
+func TestParseExtensions(t *testing.T) {
+	src := `# Doubling with explicit declarations.
+param a
+input X1, Z1
+output X3 /* block comments
+may span lines */
+t0 = -3*X1
+t1 = 0x10000000000000000*Z1 # trailing comment
+t2 = a*t0
+X3 = t1+t2
+  Z3 = Z1
+`
+	expect := &ast.Program{
+		Parameters: []ast.Variable{"a"},
+		Inputs:     []ast.Variable{"X1", "Z1"},
+		Outputs:    []ast.Variable{"X3"},
+		Assignments: []ast.Assignment{
+			{
+				LHS: "t0",
+				RHS: ast.Mul{X: ast.MustInteger("-3"), Y: ast.Variable("X1")},
+			},
+			{
+				LHS: "t1",
+				RHS: ast.Mul{X: ast.MustInteger("0x10000000000000000"), Y: ast.Variable("Z1")},
+			},
+			{
+				LHS: "t2",
+				RHS: ast.Mul{X: ast.Variable("a"), Y: ast.Variable("t0")},
+			},
+			{
+				LHS: "X3",
+				RHS: ast.Add{X: ast.Variable("t1"), Y: ast.Variable("t2")},
+			},
+			{
+				LHS: "Z3",
+				RHS: ast.Variable("Z1"),
+			},
+		},
+	}
+
+	got, err := String(src)
+	assert.NoError(t, err)
+	if !reflect.DeepEqual(got, expect) {
+		t.Logf("got:\n%#v", got)
+		t.Logf("expect:\n%#v", expect)
+		t.Fatal("mismatch")
+	}
+
+	// Printed form should parse to the same program.
+	again, err := String(got.String())
+	assert.NoError(t, err)
+	if !reflect.DeepEqual(again, got) {
+		t.Logf("printed:\n%s", got)
+		t.Fatal("round trip mismatch")
+	}
+}
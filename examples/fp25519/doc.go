@@ -0,0 +1,4 @@
+// Package fp25519 implements arithmetic modulo 2²⁵⁵ - 19.
+package fp25519
+
+//go:generate go run make.go -output .
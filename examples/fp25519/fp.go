@@ -2,22 +2,41 @@
 
 package fp25519
 
-import "math/big"
+import (
+	"errors"
+	"math/big"
 
-// modulus is the field prime modulus.
-var modulus, _ = new(big.Int).SetString("57896044618658097711785492504343953926634992332820282019728792003956564819949", 10)
+	"golang.org/x/sys/cpu"
+)
 
-// Size of a field element in bytes.
+// Size is the size of a field element in bytes.
 const Size = 32
 
 // Elt is a field element.
 type Elt [32]uint8
 
+// p is the field prime modulus as a big integer.
+var p, _ = new(big.Int).SetString("57896044618658097711785492504343953926634992332820282019728792003956564819949", 10)
+
+// prime is the prime field modulus as a field element.
+var prime = Elt{
+	0xed, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+}
+
+// SetInt64 constructs a field element from an integer.
+func (x *Elt) SetInt64(y int64) *Elt {
+	x.SetInt(big.NewInt(y))
+	return x
+}
+
 // SetInt constructs a field element from a big integer.
-func (x *Elt) SetInt(y *big.Int) {
+func (x *Elt) SetInt(y *big.Int) *Elt {
 	// Reduce if outside range.
-	if y.Sign() < 0 || y.Cmp(modulus) >= 0 {
-		y = new(big.Int).Mod(y, modulus)
+	if y.Sign() < 0 || y.Cmp(p) >= 0 {
+		y = new(big.Int).Mod(y, p)
 	}
 	// Copy bytes into field element.
 	b := y.Bytes()
@@ -28,145 +47,677 @@ func (x *Elt) SetInt(y *big.Int) {
 	for ; i < Size; i++ {
 		x[i] = 0
 	}
+	return x
+}
+
+// SetBytes constructs a field element from bytes in big-endian order.
+func (x *Elt) SetBytes(b []byte) *Elt {
+	x.SetInt(new(big.Int).SetBytes(b))
+	return x
+}
+
+// widelo and widehi are the multipliers for the low and high halves of the input to SetBytesWide.
+var widelo = Elt{0x1}
+var widehi = Elt{0x26}
+
+// SetBytesWide sets x to the big-endian integer b reduced modulo p, in constant
+// time. The slice b must be at most 2*Size bytes long. For uniform sampling,
+// b should be longer than the encoding of p by the number of bits of security.
+func (x *Elt) SetBytesWide(b []byte) *Elt {
+	// Split into little-endian halves.
+	var w [2 * Size]byte
+	for i := range b {
+		w[i] = b[len(b)-1-i]
+	}
+	var lo, hi Elt
+	copy(lo[:], w[:Size])
+	copy(hi[:], w[Size:])
+
+	Mul(&lo, &lo, &widelo)
+	Mul(&hi, &hi, &widehi)
+	Add(x, &lo, &hi)
+	return x
 }
 
 // Int converts to a big integer.
 func (x *Elt) Int() *big.Int {
+	z := *x
 	// Endianness swap.
-	var be Elt
+	for l, r := 0, Size-1; l < r; l, r = l+1, r-1 {
+		z[l], z[r] = z[r], z[l]
+	}
+	// Build big.Int.
+	return new(big.Int).SetBytes(z[:])
+}
+
+// SetCanonicalBytes sets x to the big-endian integer b, which must be at most Size bytes long.
+// Returns 1 if the value is less than p and 0 otherwise, in constant time.
+func (x *Elt) SetCanonicalBytes(b []byte) uint {
+	// Copy bytes into field element.
+	i := 0
+	for ; i < len(b); i++ {
+		x[i] = b[len(b)-1-i]
+	}
+	for ; i < Size; i++ {
+		x[i] = 0
+	}
+	// Compute the borrow of x - p, which is set if and only if x < p.
+	var borrow uint
 	for i := 0; i < Size; i++ {
-		be[Size-1-i] = x[i]
+		borrow = ((uint(x[i]) - uint(prime[i]) - borrow) >> 8) & 1
+	}
+	return borrow
+}
+
+// SetBytesStrict sets x to the big-endian integer b, which must be 32 bytes long and
+// less than p. Otherwise an error is returned and x is unchanged.
+func (x *Elt) SetBytesStrict(b []byte) (*Elt, error) {
+	if len(b) != 32 {
+		return nil, errors.New("invalid field element encoding length")
+	}
+	var t Elt
+	if t.SetCanonicalBytes(b) != 1 {
+		return nil, errors.New("non-canonical field element encoding")
+	}
+	*x = t
+	return x, nil
+}
+
+// FillBytes sets b to the big-endian encoding of x and returns it. The slice b
+// must be at least as long as the encoding of p; any extra leading bytes are zeroed.
+func (x *Elt) FillBytes(b []byte) []byte {
+	z := *x
+	// Write bytes in reverse order.
+	for i := range b {
+		b[len(b)-1-i] = 0
+		if i < Size {
+			b[len(b)-1-i] = z[i]
+		}
+	}
+	return b
+}
+
+// SetInt64Raw constructs a field element from an integer.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *Elt) SetInt64Raw(y int64) *Elt {
+	x.SetIntRaw(big.NewInt(y))
+	return x
+}
+
+// SetIntRaw constructs a field element from a big integer.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *Elt) SetIntRaw(y *big.Int) *Elt {
+	// Reduce if outside range.
+	if y.Sign() < 0 || y.Cmp(p) >= 0 {
+		y = new(big.Int).Mod(y, p)
+	}
+	// Copy bytes into field element.
+	b := y.Bytes()
+	i := 0
+	for ; i < len(b); i++ {
+		x[i] = b[len(b)-1-i]
+	}
+	for ; i < Size; i++ {
+		x[i] = 0
+	}
+	return x
+}
+
+// SetBytesRaw constructs a field element from bytes in big-endian order.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *Elt) SetBytesRaw(b []byte) *Elt {
+	x.SetIntRaw(new(big.Int).SetBytes(b))
+	return x
+}
+
+// wideloRaw and widehiRaw are the multipliers for the low and high halves of the input to SetBytesWideRaw.
+var wideloRaw = Elt{0x1}
+var widehiRaw = Elt{0x26}
+
+// SetBytesWideRaw sets x to the big-endian integer b reduced modulo p, in constant
+// time. The slice b must be at most 2*Size bytes long. For uniform sampling,
+// b should be longer than the encoding of p by the number of bits of security.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *Elt) SetBytesWideRaw(b []byte) *Elt {
+	// Split into little-endian halves.
+	var w [2 * Size]byte
+	for i := range b {
+		w[i] = b[len(b)-1-i]
+	}
+	var lo, hi Elt
+	copy(lo[:], w[:Size])
+	copy(hi[:], w[Size:])
+
+	Mul(&lo, &lo, &wideloRaw)
+	Mul(&hi, &hi, &widehiRaw)
+	Add(x, &lo, &hi)
+	return x
+}
+
+// IntRaw converts to a big integer.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *Elt) IntRaw() *big.Int {
+	z := *x
+	// Endianness swap.
+	for l, r := 0, Size-1; l < r; l, r = l+1, r-1 {
+		z[l], z[r] = z[r], z[l]
 	}
 	// Build big.Int.
-	return new(big.Int).SetBytes(be[:])
+	return new(big.Int).SetBytes(z[:])
+}
+
+// SetCanonicalBytesRaw sets x to the big-endian integer b, which must be at most Size bytes long.
+// Returns 1 if the value is less than p and 0 otherwise, in constant time.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *Elt) SetCanonicalBytesRaw(b []byte) uint {
+	// Copy bytes into field element.
+	i := 0
+	for ; i < len(b); i++ {
+		x[i] = b[len(b)-1-i]
+	}
+	for ; i < Size; i++ {
+		x[i] = 0
+	}
+	// Compute the borrow of x - p, which is set if and only if x < p.
+	var borrow uint
+	for i := 0; i < Size; i++ {
+		borrow = ((uint(x[i]) - uint(prime[i]) - borrow) >> 8) & 1
+	}
+	return borrow
 }
 
-// Sqr computes z = x^2 (mod p).
+// SetBytesStrictRaw sets x to the big-endian integer b, which must be 32 bytes long and
+// less than p. Otherwise an error is returned and x is unchanged.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *Elt) SetBytesStrictRaw(b []byte) (*Elt, error) {
+	if len(b) != 32 {
+		return nil, errors.New("invalid field element encoding length")
+	}
+	var t Elt
+	if t.SetCanonicalBytesRaw(b) != 1 {
+		return nil, errors.New("non-canonical field element encoding")
+	}
+	*x = t
+	return x, nil
+}
+
+// FillBytesRaw sets b to the big-endian encoding of x and returns it. The slice b
+// must be at least as long as the encoding of p; any extra leading bytes are zeroed.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *Elt) FillBytesRaw(b []byte) []byte {
+	z := *x
+	// Write bytes in reverse order.
+	for i := range b {
+		b[len(b)-1-i] = 0
+		if i < Size {
+			b[len(b)-1-i] = z[i]
+		}
+	}
+	return b
+}
+
+// IsCanonical returns 1 if the big-endian integer b, which must be at most Size bytes
+// long, is less than p and 0 otherwise, in constant time.
+func IsCanonical(b []byte) uint {
+	var x Elt
+	return x.SetCanonicalBytesRaw(b)
+}
+
+// hasADX reports whether the processor supports the BMI2 and ADX instructions used by the fastest multiplication code. Otherwise baseline implementations are used.
+var hasADX = cpu.X86.HasBMI2 && cpu.X86.HasADX
+
+// Mul computes z = x*y (mod p).
+func Mul(z *Elt, x *Elt, y *Elt) {
+	if hasADX {
+		mulADX(z, x, y)
+	} else {
+		mulBaseline(z, x, y)
+	}
+}
+
+// Sqr computes z = x² (mod p).
 func Sqr(z *Elt, x *Elt) {
-	Mul(z, x, x)
+	if hasADX {
+		sqrADX(z, x)
+	} else {
+		sqrBaseline(z, x)
+	}
+}
+
+// AddVec computes z[i] = x[i] + y[i] (mod p) for each i.
+// The slices must have the same length. The output may be identical to an
+// input, but must not otherwise overlap it.
+func AddVec(z, x, y []Elt) {
+	if len(x) != len(z) || len(y) != len(z) {
+		panic("AddVec: slice lengths differ")
+	}
+	if len(z) == 0 {
+		return
+	}
+	if hasADX {
+		addvecADX(z, x, y)
+	} else {
+		addvecBaseline(z, x, y)
+	}
+}
+
+// MulVec computes z[i] = x[i]*y[i] (mod p) for each i.
+// The slices must have the same length. The output may be identical to an
+// input, but must not otherwise overlap it.
+func MulVec(z, x, y []Elt) {
+	if len(x) != len(z) || len(y) != len(z) {
+		panic("MulVec: slice lengths differ")
+	}
+	if len(z) == 0 {
+		return
+	}
+	if hasADX {
+		mulvecADX(z, x, y)
+	} else {
+		mulvecBaseline(z, x, y)
+	}
+}
+
+// SqrVec computes z[i] = x[i]² (mod p) for each i.
+// The slices must have the same length. The output may be identical to an
+// input, but must not otherwise overlap it.
+func SqrVec(z, x []Elt) {
+	if len(x) != len(z) {
+		panic("SqrVec: slice lengths differ")
+	}
+	if len(z) == 0 {
+		return
+	}
+	if hasADX {
+		sqrvecADX(z, x)
+	} else {
+		sqrvecBaseline(z, x)
+	}
+}
+
+// Neg computes z = -x (mod p).
+func Neg(z *Elt, x *Elt) {
+	Sub(z, &prime, x)
 }
 
 // Inv computes z = 1/x (mod p).
 func Inv(z *Elt, x *Elt) {
-	// Inversion computation is derived from the addition chain:
+	// Exponentiation is derived from the addition chain:
 	//
 	// _10       = 2*1
 	// _11       = 1 + _10
-	// _1100     = _11 << 2
-	// _1111     = _11 + _1100
-	// _11110000 = _1111 << 4
-	// _11111111 = _1111 + _11110000
-	// x10       = _11111111 << 2 + _11
-	// x20       = x10 << 10 + x10
-	// x30       = x20 << 10 + x10
-	// x60       = x30 << 30 + x30
-	// x120      = x60 << 60 + x60
-	// x240      = x120 << 120 + x120
-	// x250      = x240 << 10 + x10
-	// return      (x250 << 2 + 1) << 3 + _11
+	// _100      = 1 + _11
+	// _1000     = 2*_100
+	// _1011     = _11 + _1000
+	// _1111     = _100 + _1011
+	// _111100   = _1111 << 2
+	// _111111   = _11 + _111100
+	// _11111100 = _111111 << 2
+	// i13       = _11111100 << 2
+	// i20       = i13 << 6 + i13
+	// i33       = i20 << 12 + i20
+	// x58       = (i33 << 4 + _11111100) << 26 + i33 + _1111
+	// x64       = x58 << 6 + _111111
+	// x128      = x64 << 64 + x64
+	// x192      = x128 << 64 + x64
+	// x250      = x192 << 58 + x58
+	// return      x250 << 5 + _1011
 	//
-	// Operations: 254 squares 12 multiplies
+	// Operations: 253 squares 15 multiplies
+
+	// Allocate 5 temporaries.
+	var t [5]Elt
 
-	// Allocate 3 temporaries.
-	var t [3]Elt
+	// Copy the input, since z and x may alias.
+	xc := *x
+	x = &xc
 
 	// Step 1: z = x^0x2.
 	Sqr(z, x)
 
-	// Step 2: z = x^0x3.
-	Mul(z, x, z)
+	// Step 2: &t[1] = x^0x3.
+	Mul(&t[1], x, z)
+
+	// Step 3: &t[0] = x^0x4.
+	Mul(&t[0], x, &t[1])
+
+	// Step 4: z = x^0x8.
+	Sqr(z, &t[0])
 
-	// Step 4: &t[0] = x^0xc.
-	Sqr(&t[0], z)
+	// Step 5: z = x^0xb.
+	Mul(z, &t[1], z)
+
+	// Step 6: &t[0] = x^0xf.
+	Mul(&t[0], &t[0], z)
+
+	// Step 8: &t[2] = x^0x3c.
+	Sqr(&t[2], &t[0])
 	for s := 1; s < 2; s++ {
-		Sqr(&t[0], &t[0])
+		Sqr(&t[2], &t[2])
 	}
 
-	// Step 5: &t[0] = x^0xf.
-	Mul(&t[0], z, &t[0])
+	// Step 9: &t[1] = x^0x3f.
+	Mul(&t[1], &t[1], &t[2])
 
-	// Step 9: &t[1] = x^0xf0.
-	Sqr(&t[1], &t[0])
+	// Step 11: &t[3] = x^0xfc.
+	Sqr(&t[3], &t[1])
+	for s := 1; s < 2; s++ {
+		Sqr(&t[3], &t[3])
+	}
+
+	// Step 13: &t[2] = x^0x3f0.
+	Sqr(&t[2], &t[3])
+	for s := 1; s < 2; s++ {
+		Sqr(&t[2], &t[2])
+	}
+
+	// Step 19: &t[4] = x^0xfc00.
+	Sqr(&t[4], &t[2])
+	for s := 1; s < 6; s++ {
+		Sqr(&t[4], &t[4])
+	}
+
+	// Step 20: &t[2] = x^0xfff0.
+	Mul(&t[2], &t[2], &t[4])
+
+	// Step 32: &t[4] = x^0xfff0000.
+	Sqr(&t[4], &t[2])
+	for s := 1; s < 12; s++ {
+		Sqr(&t[4], &t[4])
+	}
+
+	// Step 33: &t[2] = x^0xffffff0.
+	Mul(&t[2], &t[2], &t[4])
+
+	// Step 37: &t[4] = x^0xffffff00.
+	Sqr(&t[4], &t[2])
 	for s := 1; s < 4; s++ {
+		Sqr(&t[4], &t[4])
+	}
+
+	// Step 38: &t[3] = x^0xfffffffc.
+	Mul(&t[3], &t[3], &t[4])
+
+	// Step 64: &t[3] = x^0x3fffffff0000000.
+	for s := 0; s < 26; s++ {
+		Sqr(&t[3], &t[3])
+	}
+
+	// Step 65: &t[2] = x^0x3fffffffffffff0.
+	Mul(&t[2], &t[2], &t[3])
+
+	// Step 66: &t[0] = x^0x3ffffffffffffff.
+	Mul(&t[0], &t[0], &t[2])
+
+	// Step 72: &t[2] = x^0xffffffffffffffc0.
+	Sqr(&t[2], &t[0])
+	for s := 1; s < 6; s++ {
+		Sqr(&t[2], &t[2])
+	}
+
+	// Step 73: &t[1] = x^0xffffffffffffffff.
+	Mul(&t[1], &t[1], &t[2])
+
+	// Step 137: &t[2] = x^0xffffffffffffffff0000000000000000.
+	Sqr(&t[2], &t[1])
+	for s := 1; s < 64; s++ {
+		Sqr(&t[2], &t[2])
+	}
+
+	// Step 138: &t[2] = x^0xffffffffffffffffffffffffffffffff.
+	Mul(&t[2], &t[1], &t[2])
+
+	// Step 202: &t[2] = x^0xffffffffffffffffffffffffffffffff0000000000000000.
+	for s := 0; s < 64; s++ {
+		Sqr(&t[2], &t[2])
+	}
+
+	// Step 203: &t[1] = x^0xffffffffffffffffffffffffffffffffffffffffffffffff.
+	Mul(&t[1], &t[1], &t[2])
+
+	// Step 261: &t[1] = x^0x3fffffffffffffffffffffffffffffffffffffffffffffffc00000000000000.
+	for s := 0; s < 58; s++ {
 		Sqr(&t[1], &t[1])
 	}
 
-	// Step 10: &t[0] = x^0xff.
+	// Step 262: &t[0] = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff.
 	Mul(&t[0], &t[0], &t[1])
 
-	// Step 12: &t[0] = x^0x3fc.
-	for s := 0; s < 2; s++ {
+	// Step 267: &t[0] = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0.
+	for s := 0; s < 5; s++ {
 		Sqr(&t[0], &t[0])
 	}
 
-	// Step 13: &t[0] = x^0x3ff.
-	Mul(&t[0], z, &t[0])
+	// Step 268: z = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffeb.
+	Mul(z, z, &t[0])
+}
 
-	// Step 23: &t[1] = x^0xffc00.
-	Sqr(&t[1], &t[0])
-	for s := 1; s < 10; s++ {
-		Sqr(&t[1], &t[1])
+// batchone is the field element 1, encoded, for use by BatchInv.
+var batchone = new(Elt).SetInt64(1)
+
+// BatchInv computes z[i] = 1/x[i] (mod p) for each i, using a single inversion.
+// As with Inv, the inverse of zero is zero. The slices must have the same
+// length, and z may be x. A temporary slice of len(x) elements is allocated to
+// hold intermediate products.
+func BatchInv(z, x []Elt) {
+	if len(x) != len(z) {
+		panic("BatchInv: slice lengths differ")
+	}
+	if len(z) == 0 {
+		return
+	}
+	var zero Elt
+
+	// Compute prefix products t[i] = x[0] * ... * x[i-1].
+	t := make([]Elt, len(x))
+	acc := *batchone
+	for i := range x {
+		xi := x[i]
+		CMov(&xi, batchone, IsZero(&xi))
+		t[i] = acc
+		Mul(&acc, &acc, &xi)
 	}
 
-	// Step 24: &t[1] = x^0xfffff.
-	Mul(&t[1], &t[0], &t[1])
+	// Invert the product, then peel off one element at a time.
+	var inv Elt
+	Inv(&inv, &acc)
+	for i := len(x) - 1; i >= 0; i-- {
+		xi := x[i]
+		iszero := IsZero(&xi)
+		CMov(&xi, batchone, iszero)
+		var zi Elt
+		Mul(&zi, &inv, &t[i])
+		Mul(&inv, &inv, &xi)
+		CMov(&zi, &zero, iszero)
+		z[i] = zi
+	}
+}
+
+// IsZero returns 1 if x is zero and 0 otherwise, in constant time.
+func IsZero(x *Elt) uint {
+	var zero Elt
+	return Equal(x, &zero)
+}
 
-	// Step 34: &t[1] = x^0x3ffffc00.
-	for s := 0; s < 10; s++ {
+// sqrtexp computes z = x^e (mod p) for the exponent e required by Sqrt.
+func sqrtexp(z *Elt, x *Elt) {
+	// Exponentiation is derived from the addition chain:
+	//
+	// _10       = 2*1
+	// _11       = 1 + _10
+	// _1100     = _11 << 2
+	// _1111     = _11 + _1100
+	// _111100   = _1111 << 2
+	// _111111   = _11 + _111100
+	// _11111100 = _111111 << 2
+	// i12       = _11111100 << 2
+	// i19       = i12 << 6 + i12
+	// i32       = i19 << 12 + i19
+	// x58       = (i32 << 4 + _11111100) << 26 + i32 + _1111
+	// x64       = x58 << 6 + _111111
+	// x128      = x64 << 64 + x64
+	// x192      = x128 << 64 + x64
+	// x250      = x192 << 58 + x58
+	// return      x250 << 2 + 1
+	//
+	// Operations: 251 squares 13 multiplies
+
+	// Allocate 4 temporaries.
+	var t [4]Elt
+
+	// Copy the input, since z and x may alias.
+	xc := *x
+	x = &xc
+
+	// Step 1: z = x^0x2.
+	Sqr(z, x)
+
+	// Step 2: &t[0] = x^0x3.
+	Mul(&t[0], x, z)
+
+	// Step 4: z = x^0xc.
+	Sqr(z, &t[0])
+	for s := 1; s < 2; s++ {
+		Sqr(z, z)
+	}
+
+	// Step 5: z = x^0xf.
+	Mul(z, &t[0], z)
+
+	// Step 7: &t[1] = x^0x3c.
+	Sqr(&t[1], z)
+	for s := 1; s < 2; s++ {
 		Sqr(&t[1], &t[1])
 	}
 
-	// Step 35: &t[1] = x^0x3fffffff.
-	Mul(&t[1], &t[0], &t[1])
+	// Step 8: &t[0] = x^0x3f.
+	Mul(&t[0], &t[0], &t[1])
 
-	// Step 65: &t[2] = x^0xfffffffc0000000.
-	Sqr(&t[2], &t[1])
-	for s := 1; s < 30; s++ {
+	// Step 10: &t[2] = x^0xfc.
+	Sqr(&t[2], &t[0])
+	for s := 1; s < 2; s++ {
 		Sqr(&t[2], &t[2])
 	}
 
-	// Step 66: &t[1] = x^0xfffffffffffffff.
-	Mul(&t[1], &t[1], &t[2])
+	// Step 12: &t[1] = x^0x3f0.
+	Sqr(&t[1], &t[2])
+	for s := 1; s < 2; s++ {
+		Sqr(&t[1], &t[1])
+	}
 
-	// Step 126: &t[2] = x^0xfffffffffffffff000000000000000.
-	Sqr(&t[2], &t[1])
-	for s := 1; s < 60; s++ {
-		Sqr(&t[2], &t[2])
+	// Step 18: &t[3] = x^0xfc00.
+	Sqr(&t[3], &t[1])
+	for s := 1; s < 6; s++ {
+		Sqr(&t[3], &t[3])
 	}
 
-	// Step 127: &t[1] = x^0xffffffffffffffffffffffffffffff.
-	Mul(&t[1], &t[1], &t[2])
+	// Step 19: &t[1] = x^0xfff0.
+	Mul(&t[1], &t[1], &t[3])
 
-	// Step 247: &t[2] = x^0xffffffffffffffffffffffffffffff000000000000000000000000000000.
-	Sqr(&t[2], &t[1])
-	for s := 1; s < 120; s++ {
+	// Step 31: &t[3] = x^0xfff0000.
+	Sqr(&t[3], &t[1])
+	for s := 1; s < 12; s++ {
+		Sqr(&t[3], &t[3])
+	}
+
+	// Step 32: &t[1] = x^0xffffff0.
+	Mul(&t[1], &t[1], &t[3])
+
+	// Step 36: &t[3] = x^0xffffff00.
+	Sqr(&t[3], &t[1])
+	for s := 1; s < 4; s++ {
+		Sqr(&t[3], &t[3])
+	}
+
+	// Step 37: &t[2] = x^0xfffffffc.
+	Mul(&t[2], &t[2], &t[3])
+
+	// Step 63: &t[2] = x^0x3fffffff0000000.
+	for s := 0; s < 26; s++ {
 		Sqr(&t[2], &t[2])
 	}
 
-	// Step 248: &t[1] = x^0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff.
+	// Step 64: &t[1] = x^0x3fffffffffffff0.
 	Mul(&t[1], &t[1], &t[2])
 
-	// Step 258: &t[1] = x^0x3fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc00.
-	for s := 0; s < 10; s++ {
+	// Step 65: z = x^0x3ffffffffffffff.
+	Mul(z, z, &t[1])
+
+	// Step 71: &t[1] = x^0xffffffffffffffc0.
+	Sqr(&t[1], z)
+	for s := 1; s < 6; s++ {
 		Sqr(&t[1], &t[1])
 	}
 
-	// Step 259: &t[0] = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff.
+	// Step 72: &t[0] = x^0xffffffffffffffff.
 	Mul(&t[0], &t[0], &t[1])
 
-	// Step 261: &t[0] = x^0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc.
-	for s := 0; s < 2; s++ {
-		Sqr(&t[0], &t[0])
+	// Step 136: &t[1] = x^0xffffffffffffffff0000000000000000.
+	Sqr(&t[1], &t[0])
+	for s := 1; s < 64; s++ {
+		Sqr(&t[1], &t[1])
+	}
+
+	// Step 137: &t[1] = x^0xffffffffffffffffffffffffffffffff.
+	Mul(&t[1], &t[0], &t[1])
+
+	// Step 201: &t[1] = x^0xffffffffffffffffffffffffffffffff0000000000000000.
+	for s := 0; s < 64; s++ {
+		Sqr(&t[1], &t[1])
 	}
 
-	// Step 262: &t[0] = x^0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd.
-	Mul(&t[0], x, &t[0])
+	// Step 202: &t[0] = x^0xffffffffffffffffffffffffffffffffffffffffffffffff.
+	Mul(&t[0], &t[0], &t[1])
 
-	// Step 265: &t[0] = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe8.
-	for s := 0; s < 3; s++ {
+	// Step 260: &t[0] = x^0x3fffffffffffffffffffffffffffffffffffffffffffffffc00000000000000.
+	for s := 0; s < 58; s++ {
 		Sqr(&t[0], &t[0])
 	}
 
-	// Step 266: z = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffeb.
+	// Step 261: z = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff.
 	Mul(z, z, &t[0])
+
+	// Step 263: z = x^0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc.
+	for s := 0; s < 2; s++ {
+		Sqr(z, z)
+	}
+
+	// Step 264: z = x^0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd.
+	Mul(z, x, z)
+}
+
+// sqrtone is the field element 1.
+var sqrtone = Elt{0x1}
+
+// Sqrt computes z = √x (mod p), returning 1 if x is a square and 0
+// otherwise. If x is not a square the value of z is undefined.
+func Sqrt(z, x *Elt) uint {
+	var r, r2 Elt
+	// Since p ≡ 5 (mod 8), apply Atkin's algorithm:
+	// t = (2x)^((p-5)/8), i = 2xt², r = xt(i - 1).
+	var x2, t, i Elt
+	Add(&x2, x, x)
+	sqrtexp(&t, &x2)
+	Sqr(&i, &t)
+	Mul(&i, &i, &x2)
+	Sub(&i, &i, &sqrtone)
+	Mul(&r, x, &t)
+	Mul(&r, &r, &i)
+	// Check the candidate root.
+	Sqr(&r2, &r)
+	ok := Equal(&r2, x)
+	*z = r
+	return ok
+}
+
+// IsSquare returns 1 if x is a square (including zero) and 0 otherwise.
+func IsSquare(x *Elt) uint {
+	var z Elt
+	return Sqrt(&z, x)
+}
+
+// Legendre returns the Legendre symbol of x: 0 if x is zero, 1 if x is a
+// non-zero square and -1 otherwise.
+func Legendre(x *Elt) int {
+	return int(2*IsSquare(x)) - 1 - int(IsZero(x))
 }
@@ -2,8 +2,137 @@
 
 #include "textflag.h"
 
+// func CMov(y *Elt, x *Elt, c uint)
+// Requires: CMOV
+TEXT ·CMov(SB), NOSPLIT, $8-24
+	MOVQ    y+0(FP), AX
+	MOVQ    x+8(FP), CX
+	MOVQ    c+16(FP), DX
+	MOVQ    (AX), BX
+	MOVQ    8(AX), BP
+	MOVQ    16(AX), SI
+	MOVQ    24(AX), DI
+	MOVQ    (CX), R8
+	MOVQ    8(CX), R9
+	MOVQ    16(CX), R10
+	MOVQ    24(CX), CX
+	TESTQ   DX, DX
+	CMOVQNE R8, BX
+	CMOVQNE R9, BP
+	CMOVQNE R10, SI
+	CMOVQNE CX, DI
+	MOVQ    BX, (AX)
+	MOVQ    BP, 8(AX)
+	MOVQ    SI, 16(AX)
+	MOVQ    DI, 24(AX)
+	RET
+
+// func Select(z *Elt, x *Elt, y *Elt, c uint)
+// Requires: CMOV
+TEXT ·Select(SB), NOSPLIT, $8-32
+	MOVQ    y+16(FP), AX
+	MOVQ    x+8(FP), CX
+	MOVQ    c+24(FP), DX
+	MOVQ    (AX), BX
+	MOVQ    8(AX), BP
+	MOVQ    16(AX), SI
+	MOVQ    24(AX), AX
+	TESTQ   DX, DX
+	CMOVQNE (CX), BX
+	CMOVQNE 8(CX), BP
+	CMOVQNE 16(CX), SI
+	CMOVQNE 24(CX), AX
+	MOVQ    z+0(FP), CX
+	MOVQ    BX, (CX)
+	MOVQ    BP, 8(CX)
+	MOVQ    SI, 16(CX)
+	MOVQ    AX, 24(CX)
+	RET
+
+// func Equal(x *Elt, y *Elt) uint
+TEXT ·Equal(SB), NOSPLIT, $0-24
+	MOVQ x+0(FP), AX
+	MOVQ y+8(FP), CX
+	MOVQ (AX), DX
+	XORQ (CX), DX
+	MOVQ 8(AX), BX
+	XORQ 8(CX), BX
+	ORQ  BX, DX
+	MOVQ 16(AX), BX
+	XORQ 16(CX), BX
+	ORQ  BX, DX
+	MOVQ 24(AX), BX
+	XORQ 24(CX), BX
+	ORQ  BX, DX
+	NEGQ DX
+	SBBQ AX, AX
+	INCQ AX
+	MOVQ AX, ret+16(FP)
+	RET
+
 // func Add(z *Elt, x *Elt, y *Elt)
-TEXT ·Add(SB), NOSPLIT, $0-24
+// Requires: CMOV
+TEXT ·Add(SB), NOSPLIT, $8-24
+	MOVQ    x+8(FP), AX
+	MOVQ    y+16(FP), CX
+	MOVQ    (AX), DX
+	MOVQ    8(AX), BX
+	MOVQ    16(AX), BP
+	MOVQ    24(AX), AX
+	MOVQ    (CX), SI
+	MOVQ    8(CX), DI
+	MOVQ    16(CX), R8
+	MOVQ    24(CX), CX
+	XORQ    R9, R9
+	MOVQ    $0x00000026, R10
+	ADDQ    SI, DX
+	ADCQ    DI, BX
+	ADCQ    R8, BP
+	ADCQ    CX, AX
+	MOVQ    R9, CX
+	CMOVQCS R10, CX
+	ADDQ    CX, DX
+	ADCQ    R9, BX
+	ADCQ    R9, BP
+	ADCQ    R9, AX
+	MOVQ    R9, CX
+	CMOVQCS R10, CX
+	ADDQ    CX, DX
+	MOVQ    AX, CX
+	SHRQ    $0x3f, CX
+	IMUL3Q  $0x00000013, CX, CX
+	SHLQ    $0x01, AX
+	SHRQ    $0x01, AX
+	ADDQ    CX, DX
+	ADCQ    $0x00000000, BX
+	ADCQ    $0x00000000, BP
+	ADCQ    $0x00000000, AX
+	MOVQ    DX, CX
+	ADDQ    $0x00000013, CX
+	MOVQ    BX, CX
+	ADCQ    $0x00000000, CX
+	MOVQ    BP, CX
+	ADCQ    $0x00000000, CX
+	MOVQ    AX, CX
+	ADCQ    $0x00000000, CX
+	BTQ     $0x3f, CX
+	SBBQ    CX, CX
+	ANDQ    $0x00000013, CX
+	ADDQ    CX, DX
+	ADCQ    $0x00000000, BX
+	ADCQ    $0x00000000, BP
+	ADCQ    $0x00000000, AX
+	BTRQ    $0x3f, AX
+	MOVQ    z+0(FP), CX
+	MOVQ    DX, (CX)
+	MOVQ    BX, 8(CX)
+	MOVQ    BP, 16(CX)
+	MOVQ    AX, 24(CX)
+	RET
+
+// func Sub(z *Elt, x *Elt, y *Elt)
+// Requires: CMOV
+TEXT ·Sub(SB), NOSPLIT, $8-24
 	MOVQ    z+0(FP), AX
 	MOVQ    x+8(FP), CX
 	MOVQ    y+16(FP), DX
@@ -17,161 +146,43 @@ TEXT ·Add(SB), NOSPLIT, $0-24
 	MOVQ    24(DX), DX
 	XORQ    R10, R10
 	MOVQ    $0x00000026, R11
-	ADDQ    DI, BX
-	ADCXQ   R8, BP
-	ADCXQ   R9, SI
-	ADCXQ   DX, CX
+	SUBQ    DI, BX
+	SBBQ    R8, BP
+	SBBQ    R9, SI
+	SBBQ    DX, CX
 	MOVQ    R10, DX
 	CMOVQCS R11, DX
+	SUBQ    DX, BX
+	SBBQ    R10, BP
+	SBBQ    R10, SI
+	SBBQ    R10, CX
+	MOVQ    CX, DX
+	SHRQ    $0x3f, DX
+	IMUL3Q  $0x00000013, DX, DX
+	SHLQ    $0x01, CX
+	SHRQ    $0x01, CX
 	ADDQ    DX, BX
-	ADCXQ   R10, BP
-	ADCXQ   R10, SI
-	ADCXQ   R10, CX
-	MOVQ    R10, DX
-	CMOVQCS R11, DX
+	ADCQ    $0x00000000, BP
+	ADCQ    $0x00000000, SI
+	ADCQ    $0x00000000, CX
+	MOVQ    BX, DX
+	ADDQ    $0x00000013, DX
+	MOVQ    BP, DX
+	ADCQ    $0x00000000, DX
+	MOVQ    SI, DX
+	ADCQ    $0x00000000, DX
+	MOVQ    CX, DX
+	ADCQ    $0x00000000, DX
+	BTQ     $0x3f, DX
+	SBBQ    DX, DX
+	ANDQ    $0x00000013, DX
 	ADDQ    DX, BX
+	ADCQ    $0x00000000, BP
+	ADCQ    $0x00000000, SI
+	ADCQ    $0x00000000, CX
+	BTRQ    $0x3f, CX
 	MOVQ    BX, (AX)
 	MOVQ    BP, 8(AX)
 	MOVQ    SI, 16(AX)
 	MOVQ    CX, 24(AX)
 	RET
-
-// func Mul(z *Elt, x *Elt, y *Elt)
-TEXT ·Mul(SB), NOSPLIT, $64-24
-	MOVQ z+0(FP), AX
-	MOVQ x+8(FP), CX
-	MOVQ y+16(FP), BX
-
-	// y[0]
-	MOVQ (BX), DX
-	XORQ BP, BP
-
-	// x[0] * y[0] -> z[0]
-	MULXQ (CX), SI, DI
-
-	// x[1] * y[0] -> z[1]
-	MULXQ 8(CX), R8, R9
-	ADCXQ R8, DI
-
-	// x[2] * y[0] -> z[2]
-	MULXQ 16(CX), R8, R10
-	ADCXQ R8, R9
-
-	// x[3] * y[0] -> z[3]
-	MULXQ 24(CX), DX, R8
-	ADCXQ DX, R10
-	ADCXQ BP, R8
-	MOVQ  SI, (SP)
-
-	// y[1]
-	MOVQ 8(BX), DX
-	XORQ BP, BP
-
-	// x[0] * y[1] -> z[1]
-	MULXQ (CX), SI, R11
-	ADCXQ SI, DI
-	ADOXQ R11, R9
-
-	// x[1] * y[1] -> z[2]
-	MULXQ 8(CX), SI, R11
-	ADCXQ SI, R9
-	ADOXQ R11, R10
-
-	// x[2] * y[1] -> z[3]
-	MULXQ 16(CX), SI, R11
-	ADCXQ SI, R10
-	ADOXQ R11, R8
-
-	// x[3] * y[1] -> z[4]
-	MULXQ 24(CX), DX, SI
-	ADCXQ DX, R8
-	ADCXQ BP, SI
-	ADOXQ BP, SI
-	MOVQ  DI, 8(SP)
-
-	// y[2]
-	MOVQ 16(BX), DX
-	XORQ BP, BP
-
-	// x[0] * y[2] -> z[2]
-	MULXQ (CX), DI, R11
-	ADCXQ DI, R9
-	ADOXQ R11, R10
-
-	// x[1] * y[2] -> z[3]
-	MULXQ 8(CX), DI, R11
-	ADCXQ DI, R10
-	ADOXQ R11, R8
-
-	// x[2] * y[2] -> z[4]
-	MULXQ 16(CX), DI, R11
-	ADCXQ DI, R8
-	ADOXQ R11, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ 24(CX), DX, DI
-	ADCXQ DX, SI
-	ADCXQ BP, DI
-	ADOXQ BP, DI
-	MOVQ  R9, 16(SP)
-
-	// y[3]
-	MOVQ 24(BX), DX
-	XORQ BP, BP
-
-	// x[0] * y[3] -> z[3]
-	MULXQ (CX), BX, R9
-	ADCXQ BX, R10
-	ADOXQ R9, R8
-
-	// x[1] * y[3] -> z[4]
-	MULXQ 8(CX), BX, R9
-	ADCXQ BX, R8
-	ADOXQ R9, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ 16(CX), BX, R9
-	ADCXQ BX, SI
-	ADOXQ R9, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ 24(CX), CX, DX
-	ADCXQ CX, DI
-	ADCXQ BP, DX
-	ADOXQ BP, DX
-	MOVQ  R10, 24(SP)
-	MOVQ  R8, 32(SP)
-	MOVQ  SI, 40(SP)
-	MOVQ  DI, 48(SP)
-	MOVQ  DX, 56(SP)
-
-	// Reduction.
-	XORQ    CX, CX
-	MOVQ    $0x00000026, BX
-	MOVQ    BX, DX
-	XORQ    BP, BP
-	MULXQ   32(SP), DI, SI
-	ADCXQ   DI, BP
-	MULXQ   40(SP), R8, DI
-	ADCXQ   R8, SI
-	MULXQ   48(SP), R9, R8
-	ADCXQ   R9, DI
-	MULXQ   56(SP), R9, DX
-	ADCXQ   R9, R8
-	ADOXQ   (SP), BP
-	ADOXQ   8(SP), SI
-	ADOXQ   16(SP), DI
-	ADOXQ   24(SP), R8
-	ADOXQ   CX, DX
-	IMULQ   BX, DX
-	ADCXQ   DX, BP
-	ADCXQ   CX, SI
-	ADCXQ   CX, DI
-	ADCXQ   CX, R8
-	CMOVQCS BX, CX
-	ADDQ    CX, BP
-	MOVQ    BP, (AX)
-	MOVQ    SI, 8(AX)
-	MOVQ    DI, 16(AX)
-	MOVQ    R8, 24(AX)
-	RET
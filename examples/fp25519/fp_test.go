@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/cloudflare/circl/math/fp25519"
+	"golang.org/x/sys/cpu"
 )
 
 func NumTrials() int {
@@ -16,6 +17,34 @@ func NumTrials() int {
 	return 1 << 15
 }
 
+// ForEachISA runs f with each implementation of the assembly functions. The
+// ADX implementations are skipped if the processor does not support them.
+func ForEachISA(t *testing.T, f func(t *testing.T)) {
+	defer func(adx bool) { hasADX = adx }(hasADX)
+
+	for _, adx := range []bool{false, true} {
+		name := "Baseline"
+		if adx {
+			name = "ADX"
+		}
+		t.Run(name, func(t *testing.T) {
+			if adx && !(cpu.X86.HasBMI2 && cpu.X86.HasADX) {
+				t.Skip("processor does not support ADX")
+			}
+			hasADX = adx
+			f(t)
+		})
+	}
+}
+
+func TestISAs(t *testing.T) {
+	ForEachISA(t, func(t *testing.T) {
+		t.Run("Add", TestAdd)
+		t.Run("Mul", TestMul)
+		t.Run("Inv", TestInv)
+	})
+}
+
 func TestAdd(t *testing.T) {
 	for trial := 0; trial < NumTrials(); trial++ {
 		var x, y, expect fp25519.Elt
@@ -27,6 +56,7 @@ func TestAdd(t *testing.T) {
 		copy(yb[:], y[:])
 
 		fp25519.Add(&expect, &x, &y)
+		fp25519.Modp(&expect)
 		Add(&got, &xb, &yb)
 
 		if !bytes.Equal(got[:], expect[:]) {
@@ -49,6 +79,7 @@ func TestMul(t *testing.T) {
 		copy(yb[:], y[:])
 
 		fp25519.Mul(&expect, &x, &y)
+		fp25519.Modp(&expect)
 		Mul(&got, &xb, &yb)
 
 		if !bytes.Equal(got[:], expect[:]) {
@@ -71,6 +102,7 @@ func TestInv(t *testing.T) {
 		copy(xb[:], x[:])
 
 		fp25519.Inv(&expect, &x)
+		fp25519.Modp(&expect)
 		Inv(&got, &xb)
 
 		if !bytes.Equal(got[:], expect[:]) {
@@ -0,0 +1,39 @@
+// +build ignore
+
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/mmcloughlin/ec3/gen/fp"
+	"github.com/mmcloughlin/ec3/name"
+	"github.com/mmcloughlin/ec3/prime"
+)
+
+var output = flag.String("output", ".", "output directory")
+
+// Generates arithmetic modulo 2²⁵⁵ - 19 with the field backend selected for the
+// prime, which is the Crandall backend.
+func main() {
+	flag.Parse()
+
+	cfg := fp.Config{
+		Field: fp.SelectField(prime.P25519.Int()),
+		Sqrt:  true,
+
+		PackageName:     "fp25519",
+		ElementTypeName: "Elt",
+		FilenamePrefix:  "fp",
+		Scheme:          name.Plain,
+	}
+
+	fs, err := fp.Package(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := fs.Output(*output); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -1,8 +1,7 @@
 // Code generated by ec3. DO NOT EDIT.
 
-#include "textflag.h"
-
 // func lookup(p *Jacobian, tbl []Jacobian, idx int)
+// Requires: SSE2
 TEXT ·lookup(SB), $0-40
 	MOVQ p+0(FP), AX
 	MOVQ tbl_base+8(FP), CX
@@ -72,10034 +71,3 @@ loop:
 	MOVOU X6, 64(AX)
 	MOVOU X7, 80(AX)
 	RET
-
-// func add(X1_ *Elt, X2_ *Elt, X3_ *Elt, Y1_ *Elt, Y2_ *Elt, Y3_ *Elt, Z1_ *Elt, Z2_ *Elt, Z3_ *Elt)
-TEXT ·add(SB), $1184-72
-	MOVQ X1_+0(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 160(SP)
-	MOVQ CX, 168(SP)
-	MOVQ DX, 176(SP)
-	MOVQ BX, 184(SP)
-	MOVQ X2_+8(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 224(SP)
-	MOVQ CX, 232(SP)
-	MOVQ DX, 240(SP)
-	MOVQ BX, 248(SP)
-	MOVQ Y1_+24(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 320(SP)
-	MOVQ CX, 328(SP)
-	MOVQ DX, 336(SP)
-	MOVQ BX, 344(SP)
-	MOVQ Y2_+32(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 416(SP)
-	MOVQ CX, 424(SP)
-	MOVQ DX, 432(SP)
-	MOVQ BX, 440(SP)
-	MOVQ Z1_+48(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 32(SP)
-	MOVQ CX, 40(SP)
-	MOVQ DX, 48(SP)
-	MOVQ BX, 56(SP)
-	MOVQ Z2_+56(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 96(SP)
-	MOVQ CX, 104(SP)
-	MOVQ DX, 112(SP)
-	MOVQ BX, 120(SP)
-
-	// Step 1: Z1^2
-	MOVQ 32(SP), AX
-	MOVQ 40(SP), CX
-	MOVQ 48(SP), BX
-	MOVQ 56(SP), BP
-
-	// y[0]
-	MOVQ AX, DX
-	XORQ SI, SI
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, DI, R8
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R9, R10
-	ADCXQ R9, R8
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R9, R11
-	ADCXQ R9, R10
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R9
-	ADCXQ DX, R11
-	ADCXQ SI, R9
-	MOVQ  DI, 1120(SP)
-
-	// y[1]
-	MOVQ CX, DX
-	XORQ SI, SI
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, DI, R12
-	ADCXQ DI, R8
-	ADOXQ R12, R10
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, DI, R12
-	ADCXQ DI, R10
-	ADOXQ R12, R11
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, DI, R12
-	ADCXQ DI, R11
-	ADOXQ R12, R9
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, DI
-	ADCXQ DX, R9
-	ADCXQ SI, DI
-	ADOXQ SI, DI
-	MOVQ  R8, 1128(SP)
-
-	// y[2]
-	MOVQ BX, DX
-	XORQ SI, SI
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, R8, R12
-	ADCXQ R8, R10
-	ADOXQ R12, R11
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, R8, R12
-	ADCXQ R8, R11
-	ADOXQ R12, R9
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, R8, R12
-	ADCXQ R8, R9
-	ADOXQ R12, DI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, R8
-	ADCXQ DX, DI
-	ADCXQ SI, R8
-	ADOXQ SI, R8
-	MOVQ  R10, 1136(SP)
-
-	// y[3]
-	MOVQ BP, DX
-	XORQ SI, SI
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R10
-	ADCXQ AX, R11
-	ADOXQ R10, R9
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R9
-	ADOXQ CX, DI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, DI
-	ADOXQ CX, R8
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, R8
-	ADCXQ   SI, CX
-	ADOXQ   SI, CX
-	MOVQ    R11, 1144(SP)
-	MOVQ    R9, 1152(SP)
-	MOVQ    DI, 1160(SP)
-	MOVQ    R8, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, (SP)
-	MOVQ    CX, 8(SP)
-	MOVQ    BX, 16(SP)
-	MOVQ    BP, 24(SP)
-
-	// Step 2: Z2^2
-	MOVQ 96(SP), AX
-	MOVQ 104(SP), CX
-	MOVQ 112(SP), BX
-	MOVQ 120(SP), BP
-
-	// y[0]
-	MOVQ AX, DX
-	XORQ SI, SI
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, DI, R8
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R9, R10
-	ADCXQ R9, R8
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R9, R11
-	ADCXQ R9, R10
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R9
-	ADCXQ DX, R11
-	ADCXQ SI, R9
-	MOVQ  DI, 1120(SP)
-
-	// y[1]
-	MOVQ CX, DX
-	XORQ SI, SI
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, DI, R12
-	ADCXQ DI, R8
-	ADOXQ R12, R10
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, DI, R12
-	ADCXQ DI, R10
-	ADOXQ R12, R11
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, DI, R12
-	ADCXQ DI, R11
-	ADOXQ R12, R9
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, DI
-	ADCXQ DX, R9
-	ADCXQ SI, DI
-	ADOXQ SI, DI
-	MOVQ  R8, 1128(SP)
-
-	// y[2]
-	MOVQ BX, DX
-	XORQ SI, SI
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, R8, R12
-	ADCXQ R8, R10
-	ADOXQ R12, R11
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, R8, R12
-	ADCXQ R8, R11
-	ADOXQ R12, R9
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, R8, R12
-	ADCXQ R8, R9
-	ADOXQ R12, DI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, R8
-	ADCXQ DX, DI
-	ADCXQ SI, R8
-	ADOXQ SI, R8
-	MOVQ  R10, 1136(SP)
-
-	// y[3]
-	MOVQ BP, DX
-	XORQ SI, SI
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R10
-	ADCXQ AX, R11
-	ADOXQ R10, R9
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R9
-	ADOXQ CX, DI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, DI
-	ADOXQ CX, R8
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, R8
-	ADCXQ   SI, CX
-	ADOXQ   SI, CX
-	MOVQ    R11, 1144(SP)
-	MOVQ    R9, 1152(SP)
-	MOVQ    DI, 1160(SP)
-	MOVQ    R8, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 64(SP)
-	MOVQ    CX, 72(SP)
-	MOVQ    BX, 80(SP)
-	MOVQ    BP, 88(SP)
-
-	// Step 3: X1*Z2Z2
-	MOVQ 160(SP), AX
-	MOVQ 168(SP), CX
-	MOVQ 176(SP), BX
-	MOVQ 184(SP), BP
-	MOVQ 64(SP), DX
-	MOVQ 72(SP), SI
-	MOVQ 80(SP), DI
-	MOVQ 88(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 1120(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 1128(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 1136(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 1144(SP)
-	MOVQ    R12, 1152(SP)
-	MOVQ    SI, 1160(SP)
-	MOVQ    DI, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 128(SP)
-	MOVQ    CX, 136(SP)
-	MOVQ    BX, 144(SP)
-	MOVQ    BP, 152(SP)
-
-	// Step 4: X2*Z1Z1
-	MOVQ 224(SP), AX
-	MOVQ 232(SP), CX
-	MOVQ 240(SP), BX
-	MOVQ 248(SP), BP
-	MOVQ (SP), DX
-	MOVQ 8(SP), SI
-	MOVQ 16(SP), DI
-	MOVQ 24(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 1120(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 1128(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 1136(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 1144(SP)
-	MOVQ    R12, 1152(SP)
-	MOVQ    SI, 1160(SP)
-	MOVQ    DI, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 192(SP)
-	MOVQ    CX, 200(SP)
-	MOVQ    BX, 208(SP)
-	MOVQ    BP, 216(SP)
-
-	// Step 5: Z2*Z2Z2
-	MOVQ 96(SP), AX
-	MOVQ 104(SP), CX
-	MOVQ 112(SP), BX
-	MOVQ 120(SP), BP
-	MOVQ 64(SP), DX
-	MOVQ 72(SP), SI
-	MOVQ 80(SP), DI
-	MOVQ 88(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 1120(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 1128(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 1136(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 1144(SP)
-	MOVQ    R12, 1152(SP)
-	MOVQ    SI, 1160(SP)
-	MOVQ    DI, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 256(SP)
-	MOVQ    CX, 264(SP)
-	MOVQ    BX, 272(SP)
-	MOVQ    BP, 280(SP)
-
-	// Step 6: Y1*t0
-	MOVQ 320(SP), AX
-	MOVQ 328(SP), CX
-	MOVQ 336(SP), BX
-	MOVQ 344(SP), BP
-	MOVQ 256(SP), DX
-	MOVQ 264(SP), SI
-	MOVQ 272(SP), DI
-	MOVQ 280(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 1120(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 1128(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 1136(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 1144(SP)
-	MOVQ    R12, 1152(SP)
-	MOVQ    SI, 1160(SP)
-	MOVQ    DI, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 288(SP)
-	MOVQ    CX, 296(SP)
-	MOVQ    BX, 304(SP)
-	MOVQ    BP, 312(SP)
-
-	// Step 7: Z1*Z1Z1
-	MOVQ 32(SP), AX
-	MOVQ 40(SP), CX
-	MOVQ 48(SP), BX
-	MOVQ 56(SP), BP
-	MOVQ (SP), DX
-	MOVQ 8(SP), SI
-	MOVQ 16(SP), DI
-	MOVQ 24(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 1120(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 1128(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 1136(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 1144(SP)
-	MOVQ    R12, 1152(SP)
-	MOVQ    SI, 1160(SP)
-	MOVQ    DI, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    BX, 368(SP)
-	MOVQ    BP, 376(SP)
-
-	// Step 8: Y2*t1
-	MOVQ 416(SP), AX
-	MOVQ 424(SP), CX
-	MOVQ 432(SP), BX
-	MOVQ 440(SP), BP
-	MOVQ 352(SP), DX
-	MOVQ 360(SP), SI
-	MOVQ 368(SP), DI
-	MOVQ 376(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 1120(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 1128(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 1136(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 1144(SP)
-	MOVQ    R12, 1152(SP)
-	MOVQ    SI, 1160(SP)
-	MOVQ    DI, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 384(SP)
-	MOVQ    CX, 392(SP)
-	MOVQ    BX, 400(SP)
-	MOVQ    BP, 408(SP)
-
-	// Step 9: U2-U1
-	MOVQ    192(SP), AX
-	MOVQ    200(SP), CX
-	MOVQ    208(SP), DX
-	MOVQ    216(SP), BX
-	MOVQ    128(SP), BP
-	MOVQ    136(SP), SI
-	MOVQ    144(SP), DI
-	MOVQ    152(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 448(SP)
-	MOVQ    CX, 456(SP)
-	MOVQ    DX, 464(SP)
-	MOVQ    BX, 472(SP)
-
-	// Step 10: H+H
-	MOVQ    448(SP), AX
-	MOVQ    456(SP), CX
-	MOVQ    464(SP), DX
-	MOVQ    472(SP), BX
-	MOVQ    448(SP), BP
-	MOVQ    456(SP), SI
-	MOVQ    464(SP), DI
-	MOVQ    472(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 480(SP)
-	MOVQ    CX, 488(SP)
-	MOVQ    DX, 496(SP)
-	MOVQ    BX, 504(SP)
-
-	// Step 11: t2^2
-	MOVQ 480(SP), AX
-	MOVQ 488(SP), CX
-	MOVQ 496(SP), BX
-	MOVQ 504(SP), BP
-
-	// y[0]
-	MOVQ AX, DX
-	XORQ SI, SI
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, DI, R8
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R9, R10
-	ADCXQ R9, R8
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R9, R11
-	ADCXQ R9, R10
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R9
-	ADCXQ DX, R11
-	ADCXQ SI, R9
-	MOVQ  DI, 1120(SP)
-
-	// y[1]
-	MOVQ CX, DX
-	XORQ SI, SI
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, DI, R12
-	ADCXQ DI, R8
-	ADOXQ R12, R10
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, DI, R12
-	ADCXQ DI, R10
-	ADOXQ R12, R11
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, DI, R12
-	ADCXQ DI, R11
-	ADOXQ R12, R9
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, DI
-	ADCXQ DX, R9
-	ADCXQ SI, DI
-	ADOXQ SI, DI
-	MOVQ  R8, 1128(SP)
-
-	// y[2]
-	MOVQ BX, DX
-	XORQ SI, SI
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, R8, R12
-	ADCXQ R8, R10
-	ADOXQ R12, R11
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, R8, R12
-	ADCXQ R8, R11
-	ADOXQ R12, R9
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, R8, R12
-	ADCXQ R8, R9
-	ADOXQ R12, DI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, R8
-	ADCXQ DX, DI
-	ADCXQ SI, R8
-	ADOXQ SI, R8
-	MOVQ  R10, 1136(SP)
-
-	// y[3]
-	MOVQ BP, DX
-	XORQ SI, SI
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R10
-	ADCXQ AX, R11
-	ADOXQ R10, R9
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R9
-	ADOXQ CX, DI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, DI
-	ADOXQ CX, R8
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, R8
-	ADCXQ   SI, CX
-	ADOXQ   SI, CX
-	MOVQ    R11, 1144(SP)
-	MOVQ    R9, 1152(SP)
-	MOVQ    DI, 1160(SP)
-	MOVQ    R8, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 512(SP)
-	MOVQ    CX, 520(SP)
-	MOVQ    BX, 528(SP)
-	MOVQ    BP, 536(SP)
-
-	// Step 12: H*I
-	MOVQ 448(SP), AX
-	MOVQ 456(SP), CX
-	MOVQ 464(SP), BX
-	MOVQ 472(SP), BP
-	MOVQ 512(SP), DX
-	MOVQ 520(SP), SI
-	MOVQ 528(SP), DI
-	MOVQ 536(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 1120(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 1128(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 1136(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 1144(SP)
-	MOVQ    R12, 1152(SP)
-	MOVQ    SI, 1160(SP)
-	MOVQ    DI, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 544(SP)
-	MOVQ    CX, 552(SP)
-	MOVQ    BX, 560(SP)
-	MOVQ    BP, 568(SP)
-
-	// Step 13: S2-S1
-	MOVQ    384(SP), AX
-	MOVQ    392(SP), CX
-	MOVQ    400(SP), DX
-	MOVQ    408(SP), BX
-	MOVQ    288(SP), BP
-	MOVQ    296(SP), SI
-	MOVQ    304(SP), DI
-	MOVQ    312(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 576(SP)
-	MOVQ    CX, 584(SP)
-	MOVQ    DX, 592(SP)
-	MOVQ    BX, 600(SP)
-
-	// Step 14: t3+t3
-	MOVQ    576(SP), AX
-	MOVQ    584(SP), CX
-	MOVQ    592(SP), DX
-	MOVQ    600(SP), BX
-	MOVQ    576(SP), BP
-	MOVQ    584(SP), SI
-	MOVQ    592(SP), DI
-	MOVQ    600(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 608(SP)
-	MOVQ    CX, 616(SP)
-	MOVQ    DX, 624(SP)
-	MOVQ    BX, 632(SP)
-
-	// Step 15: U1*I
-	MOVQ 128(SP), AX
-	MOVQ 136(SP), CX
-	MOVQ 144(SP), BX
-	MOVQ 152(SP), BP
-	MOVQ 512(SP), DX
-	MOVQ 520(SP), SI
-	MOVQ 528(SP), DI
-	MOVQ 536(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 1120(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 1128(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 1136(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 1144(SP)
-	MOVQ    R12, 1152(SP)
-	MOVQ    SI, 1160(SP)
-	MOVQ    DI, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 640(SP)
-	MOVQ    CX, 648(SP)
-	MOVQ    BX, 656(SP)
-	MOVQ    BP, 664(SP)
-
-	// Step 16: r^2
-	MOVQ 608(SP), AX
-	MOVQ 616(SP), CX
-	MOVQ 624(SP), BX
-	MOVQ 632(SP), BP
-
-	// y[0]
-	MOVQ AX, DX
-	XORQ SI, SI
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, DI, R8
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R9, R10
-	ADCXQ R9, R8
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R9, R11
-	ADCXQ R9, R10
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R9
-	ADCXQ DX, R11
-	ADCXQ SI, R9
-	MOVQ  DI, 1120(SP)
-
-	// y[1]
-	MOVQ CX, DX
-	XORQ SI, SI
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, DI, R12
-	ADCXQ DI, R8
-	ADOXQ R12, R10
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, DI, R12
-	ADCXQ DI, R10
-	ADOXQ R12, R11
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, DI, R12
-	ADCXQ DI, R11
-	ADOXQ R12, R9
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, DI
-	ADCXQ DX, R9
-	ADCXQ SI, DI
-	ADOXQ SI, DI
-	MOVQ  R8, 1128(SP)
-
-	// y[2]
-	MOVQ BX, DX
-	XORQ SI, SI
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, R8, R12
-	ADCXQ R8, R10
-	ADOXQ R12, R11
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, R8, R12
-	ADCXQ R8, R11
-	ADOXQ R12, R9
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, R8, R12
-	ADCXQ R8, R9
-	ADOXQ R12, DI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, R8
-	ADCXQ DX, DI
-	ADCXQ SI, R8
-	ADOXQ SI, R8
-	MOVQ  R10, 1136(SP)
-
-	// y[3]
-	MOVQ BP, DX
-	XORQ SI, SI
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R10
-	ADCXQ AX, R11
-	ADOXQ R10, R9
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R9
-	ADOXQ CX, DI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, DI
-	ADOXQ CX, R8
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, R8
-	ADCXQ   SI, CX
-	ADOXQ   SI, CX
-	MOVQ    R11, 1144(SP)
-	MOVQ    R9, 1152(SP)
-	MOVQ    DI, 1160(SP)
-	MOVQ    R8, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 672(SP)
-	MOVQ    CX, 680(SP)
-	MOVQ    BX, 688(SP)
-	MOVQ    BP, 696(SP)
-
-	// Step 17: V+V
-	MOVQ    640(SP), AX
-	MOVQ    648(SP), CX
-	MOVQ    656(SP), DX
-	MOVQ    664(SP), BX
-	MOVQ    640(SP), BP
-	MOVQ    648(SP), SI
-	MOVQ    656(SP), DI
-	MOVQ    664(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 704(SP)
-	MOVQ    CX, 712(SP)
-	MOVQ    DX, 720(SP)
-	MOVQ    BX, 728(SP)
-
-	// Step 18: t4-J
-	MOVQ    672(SP), AX
-	MOVQ    680(SP), CX
-	MOVQ    688(SP), DX
-	MOVQ    696(SP), BX
-	MOVQ    544(SP), BP
-	MOVQ    552(SP), SI
-	MOVQ    560(SP), DI
-	MOVQ    568(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 736(SP)
-	MOVQ    CX, 744(SP)
-	MOVQ    DX, 752(SP)
-	MOVQ    BX, 760(SP)
-
-	// Step 19: t6-t5
-	MOVQ    736(SP), AX
-	MOVQ    744(SP), CX
-	MOVQ    752(SP), DX
-	MOVQ    760(SP), BX
-	MOVQ    704(SP), BP
-	MOVQ    712(SP), SI
-	MOVQ    720(SP), DI
-	MOVQ    728(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 768(SP)
-	MOVQ    CX, 776(SP)
-	MOVQ    DX, 784(SP)
-	MOVQ    BX, 792(SP)
-
-	// Step 20: V-X3
-	MOVQ    640(SP), AX
-	MOVQ    648(SP), CX
-	MOVQ    656(SP), DX
-	MOVQ    664(SP), BX
-	MOVQ    768(SP), BP
-	MOVQ    776(SP), SI
-	MOVQ    784(SP), DI
-	MOVQ    792(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 800(SP)
-	MOVQ    CX, 808(SP)
-	MOVQ    DX, 816(SP)
-	MOVQ    BX, 824(SP)
-
-	// Step 21: S1*J
-	MOVQ 288(SP), AX
-	MOVQ 296(SP), CX
-	MOVQ 304(SP), BX
-	MOVQ 312(SP), BP
-	MOVQ 544(SP), DX
-	MOVQ 552(SP), SI
-	MOVQ 560(SP), DI
-	MOVQ 568(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 1120(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 1128(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 1136(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 1144(SP)
-	MOVQ    R12, 1152(SP)
-	MOVQ    SI, 1160(SP)
-	MOVQ    DI, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 832(SP)
-	MOVQ    CX, 840(SP)
-	MOVQ    BX, 848(SP)
-	MOVQ    BP, 856(SP)
-
-	// Step 22: t8+t8
-	MOVQ    832(SP), AX
-	MOVQ    840(SP), CX
-	MOVQ    848(SP), DX
-	MOVQ    856(SP), BX
-	MOVQ    832(SP), BP
-	MOVQ    840(SP), SI
-	MOVQ    848(SP), DI
-	MOVQ    856(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 864(SP)
-	MOVQ    CX, 872(SP)
-	MOVQ    DX, 880(SP)
-	MOVQ    BX, 888(SP)
-
-	// Step 23: r*t7
-	MOVQ 608(SP), AX
-	MOVQ 616(SP), CX
-	MOVQ 624(SP), BX
-	MOVQ 632(SP), BP
-	MOVQ 800(SP), DX
-	MOVQ 808(SP), SI
-	MOVQ 816(SP), DI
-	MOVQ 824(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 1120(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 1128(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 1136(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 1144(SP)
-	MOVQ    R12, 1152(SP)
-	MOVQ    SI, 1160(SP)
-	MOVQ    DI, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 896(SP)
-	MOVQ    CX, 904(SP)
-	MOVQ    BX, 912(SP)
-	MOVQ    BP, 920(SP)
-
-	// Step 24: t10-t9
-	MOVQ    896(SP), AX
-	MOVQ    904(SP), CX
-	MOVQ    912(SP), DX
-	MOVQ    920(SP), BX
-	MOVQ    864(SP), BP
-	MOVQ    872(SP), SI
-	MOVQ    880(SP), DI
-	MOVQ    888(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 928(SP)
-	MOVQ    CX, 936(SP)
-	MOVQ    DX, 944(SP)
-	MOVQ    BX, 952(SP)
-
-	// Step 25: Z1+Z2
-	MOVQ    32(SP), AX
-	MOVQ    40(SP), CX
-	MOVQ    48(SP), DX
-	MOVQ    56(SP), BX
-	MOVQ    96(SP), BP
-	MOVQ    104(SP), SI
-	MOVQ    112(SP), DI
-	MOVQ    120(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 960(SP)
-	MOVQ    CX, 968(SP)
-	MOVQ    DX, 976(SP)
-	MOVQ    BX, 984(SP)
-
-	// Step 26: t11^2
-	MOVQ 960(SP), AX
-	MOVQ 968(SP), CX
-	MOVQ 976(SP), BX
-	MOVQ 984(SP), BP
-
-	// y[0]
-	MOVQ AX, DX
-	XORQ SI, SI
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, DI, R8
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R9, R10
-	ADCXQ R9, R8
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R9, R11
-	ADCXQ R9, R10
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R9
-	ADCXQ DX, R11
-	ADCXQ SI, R9
-	MOVQ  DI, 1120(SP)
-
-	// y[1]
-	MOVQ CX, DX
-	XORQ SI, SI
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, DI, R12
-	ADCXQ DI, R8
-	ADOXQ R12, R10
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, DI, R12
-	ADCXQ DI, R10
-	ADOXQ R12, R11
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, DI, R12
-	ADCXQ DI, R11
-	ADOXQ R12, R9
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, DI
-	ADCXQ DX, R9
-	ADCXQ SI, DI
-	ADOXQ SI, DI
-	MOVQ  R8, 1128(SP)
-
-	// y[2]
-	MOVQ BX, DX
-	XORQ SI, SI
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, R8, R12
-	ADCXQ R8, R10
-	ADOXQ R12, R11
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, R8, R12
-	ADCXQ R8, R11
-	ADOXQ R12, R9
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, R8, R12
-	ADCXQ R8, R9
-	ADOXQ R12, DI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, R8
-	ADCXQ DX, DI
-	ADCXQ SI, R8
-	ADOXQ SI, R8
-	MOVQ  R10, 1136(SP)
-
-	// y[3]
-	MOVQ BP, DX
-	XORQ SI, SI
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R10
-	ADCXQ AX, R11
-	ADOXQ R10, R9
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R9
-	ADOXQ CX, DI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, DI
-	ADOXQ CX, R8
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, R8
-	ADCXQ   SI, CX
-	ADOXQ   SI, CX
-	MOVQ    R11, 1144(SP)
-	MOVQ    R9, 1152(SP)
-	MOVQ    DI, 1160(SP)
-	MOVQ    R8, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 992(SP)
-	MOVQ    CX, 1000(SP)
-	MOVQ    BX, 1008(SP)
-	MOVQ    BP, 1016(SP)
-
-	// Step 27: t12-Z1Z1
-	MOVQ    992(SP), AX
-	MOVQ    1000(SP), CX
-	MOVQ    1008(SP), DX
-	MOVQ    1016(SP), BX
-	MOVQ    (SP), BP
-	MOVQ    8(SP), SI
-	MOVQ    16(SP), DI
-	MOVQ    24(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 1024(SP)
-	MOVQ    CX, 1032(SP)
-	MOVQ    DX, 1040(SP)
-	MOVQ    BX, 1048(SP)
-
-	// Step 28: t13-Z2Z2
-	MOVQ    1024(SP), AX
-	MOVQ    1032(SP), CX
-	MOVQ    1040(SP), DX
-	MOVQ    1048(SP), BX
-	MOVQ    64(SP), BP
-	MOVQ    72(SP), SI
-	MOVQ    80(SP), DI
-	MOVQ    88(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 1056(SP)
-	MOVQ    CX, 1064(SP)
-	MOVQ    DX, 1072(SP)
-	MOVQ    BX, 1080(SP)
-
-	// Step 29: t14*H
-	MOVQ 1056(SP), AX
-	MOVQ 1064(SP), CX
-	MOVQ 1072(SP), BX
-	MOVQ 1080(SP), BP
-	MOVQ 448(SP), DX
-	MOVQ 456(SP), SI
-	MOVQ 464(SP), DI
-	MOVQ 472(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 1120(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 1128(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 1136(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 1144(SP)
-	MOVQ    R12, 1152(SP)
-	MOVQ    SI, 1160(SP)
-	MOVQ    DI, 1168(SP)
-	MOVQ    CX, 1176(SP)
-	XORQ    AX, AX
-	MOVQ    1120(SP), CX
-	MOVQ    1128(SP), BX
-	MOVQ    1136(SP), BP
-	MOVQ    1144(SP), SI
-	MOVQ    1152(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1160(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    1168(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    1176(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 1088(SP)
-	MOVQ    CX, 1096(SP)
-	MOVQ    BX, 1104(SP)
-	MOVQ    BP, 1112(SP)
-	MOVQ    X3_+16(FP), BP
-	MOVQ    768(SP), AX
-	MOVQ    776(SP), CX
-	MOVQ    784(SP), DX
-	MOVQ    792(SP), BX
-	MOVQ    AX, (BP)
-	MOVQ    CX, 8(BP)
-	MOVQ    DX, 16(BP)
-	MOVQ    BX, 24(BP)
-	MOVQ    Y3_+40(FP), BP
-	MOVQ    928(SP), AX
-	MOVQ    936(SP), CX
-	MOVQ    944(SP), DX
-	MOVQ    952(SP), BX
-	MOVQ    AX, (BP)
-	MOVQ    CX, 8(BP)
-	MOVQ    DX, 16(BP)
-	MOVQ    BX, 24(BP)
-	MOVQ    Z3_+64(FP), BP
-	MOVQ    1088(SP), AX
-	MOVQ    1096(SP), CX
-	MOVQ    1104(SP), DX
-	MOVQ    1112(SP), BX
-	MOVQ    AX, (BP)
-	MOVQ    CX, 8(BP)
-	MOVQ    DX, 16(BP)
-	MOVQ    BX, 24(BP)
-	RET
-
-DATA p<>+0(SB)/8, $0xffffffffffffffff
-DATA p<>+8(SB)/8, $0x00000000ffffffff
-DATA p<>+16(SB)/8, $0x0000000000000000
-DATA p<>+24(SB)/8, $0xffffffff00000001
-GLOBL p<>(SB), RODATA|NOPTR, $32
-
-// func double(X1_ *Elt, X3_ *Elt, Y1_ *Elt, Y3_ *Elt, Z1_ *Elt, Z3_ *Elt)
-TEXT ·double(SB), $800-48
-	MOVQ X1_+0(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 160(SP)
-	MOVQ CX, 168(SP)
-	MOVQ DX, 176(SP)
-	MOVQ BX, 184(SP)
-	MOVQ Y1_+16(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 96(SP)
-	MOVQ CX, 104(SP)
-	MOVQ DX, 112(SP)
-	MOVQ BX, 120(SP)
-	MOVQ Z1_+32(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 32(SP)
-	MOVQ CX, 40(SP)
-	MOVQ DX, 48(SP)
-	MOVQ BX, 56(SP)
-
-	// Step 1: Z1^2
-	MOVQ 32(SP), AX
-	MOVQ 40(SP), CX
-	MOVQ 48(SP), BX
-	MOVQ 56(SP), BP
-
-	// y[0]
-	MOVQ AX, DX
-	XORQ SI, SI
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, DI, R8
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R9, R10
-	ADCXQ R9, R8
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R9, R11
-	ADCXQ R9, R10
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R9
-	ADCXQ DX, R11
-	ADCXQ SI, R9
-	MOVQ  DI, 736(SP)
-
-	// y[1]
-	MOVQ CX, DX
-	XORQ SI, SI
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, DI, R12
-	ADCXQ DI, R8
-	ADOXQ R12, R10
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, DI, R12
-	ADCXQ DI, R10
-	ADOXQ R12, R11
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, DI, R12
-	ADCXQ DI, R11
-	ADOXQ R12, R9
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, DI
-	ADCXQ DX, R9
-	ADCXQ SI, DI
-	ADOXQ SI, DI
-	MOVQ  R8, 744(SP)
-
-	// y[2]
-	MOVQ BX, DX
-	XORQ SI, SI
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, R8, R12
-	ADCXQ R8, R10
-	ADOXQ R12, R11
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, R8, R12
-	ADCXQ R8, R11
-	ADOXQ R12, R9
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, R8, R12
-	ADCXQ R8, R9
-	ADOXQ R12, DI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, R8
-	ADCXQ DX, DI
-	ADCXQ SI, R8
-	ADOXQ SI, R8
-	MOVQ  R10, 752(SP)
-
-	// y[3]
-	MOVQ BP, DX
-	XORQ SI, SI
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R10
-	ADCXQ AX, R11
-	ADOXQ R10, R9
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R9
-	ADOXQ CX, DI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, DI
-	ADOXQ CX, R8
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, R8
-	ADCXQ   SI, CX
-	ADOXQ   SI, CX
-	MOVQ    R11, 760(SP)
-	MOVQ    R9, 768(SP)
-	MOVQ    DI, 776(SP)
-	MOVQ    R8, 784(SP)
-	MOVQ    CX, 792(SP)
-	XORQ    AX, AX
-	MOVQ    736(SP), CX
-	MOVQ    744(SP), BX
-	MOVQ    752(SP), BP
-	MOVQ    760(SP), SI
-	MOVQ    768(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    776(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    784(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    792(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, (SP)
-	MOVQ    CX, 8(SP)
-	MOVQ    BX, 16(SP)
-	MOVQ    BP, 24(SP)
-
-	// Step 2: Y1^2
-	MOVQ 96(SP), AX
-	MOVQ 104(SP), CX
-	MOVQ 112(SP), BX
-	MOVQ 120(SP), BP
-
-	// y[0]
-	MOVQ AX, DX
-	XORQ SI, SI
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, DI, R8
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R9, R10
-	ADCXQ R9, R8
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R9, R11
-	ADCXQ R9, R10
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R9
-	ADCXQ DX, R11
-	ADCXQ SI, R9
-	MOVQ  DI, 736(SP)
-
-	// y[1]
-	MOVQ CX, DX
-	XORQ SI, SI
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, DI, R12
-	ADCXQ DI, R8
-	ADOXQ R12, R10
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, DI, R12
-	ADCXQ DI, R10
-	ADOXQ R12, R11
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, DI, R12
-	ADCXQ DI, R11
-	ADOXQ R12, R9
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, DI
-	ADCXQ DX, R9
-	ADCXQ SI, DI
-	ADOXQ SI, DI
-	MOVQ  R8, 744(SP)
-
-	// y[2]
-	MOVQ BX, DX
-	XORQ SI, SI
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, R8, R12
-	ADCXQ R8, R10
-	ADOXQ R12, R11
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, R8, R12
-	ADCXQ R8, R11
-	ADOXQ R12, R9
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, R8, R12
-	ADCXQ R8, R9
-	ADOXQ R12, DI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, R8
-	ADCXQ DX, DI
-	ADCXQ SI, R8
-	ADOXQ SI, R8
-	MOVQ  R10, 752(SP)
-
-	// y[3]
-	MOVQ BP, DX
-	XORQ SI, SI
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R10
-	ADCXQ AX, R11
-	ADOXQ R10, R9
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R9
-	ADOXQ CX, DI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, DI
-	ADOXQ CX, R8
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, R8
-	ADCXQ   SI, CX
-	ADOXQ   SI, CX
-	MOVQ    R11, 760(SP)
-	MOVQ    R9, 768(SP)
-	MOVQ    DI, 776(SP)
-	MOVQ    R8, 784(SP)
-	MOVQ    CX, 792(SP)
-	XORQ    AX, AX
-	MOVQ    736(SP), CX
-	MOVQ    744(SP), BX
-	MOVQ    752(SP), BP
-	MOVQ    760(SP), SI
-	MOVQ    768(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    776(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    784(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    792(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 64(SP)
-	MOVQ    CX, 72(SP)
-	MOVQ    BX, 80(SP)
-	MOVQ    BP, 88(SP)
-
-	// Step 3: X1*gamma
-	MOVQ 160(SP), AX
-	MOVQ 168(SP), CX
-	MOVQ 176(SP), BX
-	MOVQ 184(SP), BP
-	MOVQ 64(SP), DX
-	MOVQ 72(SP), SI
-	MOVQ 80(SP), DI
-	MOVQ 88(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 736(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 744(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 752(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 760(SP)
-	MOVQ    R12, 768(SP)
-	MOVQ    SI, 776(SP)
-	MOVQ    DI, 784(SP)
-	MOVQ    CX, 792(SP)
-	XORQ    AX, AX
-	MOVQ    736(SP), CX
-	MOVQ    744(SP), BX
-	MOVQ    752(SP), BP
-	MOVQ    760(SP), SI
-	MOVQ    768(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    776(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    784(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    792(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 128(SP)
-	MOVQ    CX, 136(SP)
-	MOVQ    BX, 144(SP)
-	MOVQ    BP, 152(SP)
-
-	// Step 4: X1-delta
-	MOVQ    160(SP), AX
-	MOVQ    168(SP), CX
-	MOVQ    176(SP), DX
-	MOVQ    184(SP), BX
-	MOVQ    (SP), BP
-	MOVQ    8(SP), SI
-	MOVQ    16(SP), DI
-	MOVQ    24(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 192(SP)
-	MOVQ    CX, 200(SP)
-	MOVQ    DX, 208(SP)
-	MOVQ    BX, 216(SP)
-
-	// Step 5: X1+delta
-	MOVQ    160(SP), AX
-	MOVQ    168(SP), CX
-	MOVQ    176(SP), DX
-	MOVQ    184(SP), BX
-	MOVQ    (SP), BP
-	MOVQ    8(SP), SI
-	MOVQ    16(SP), DI
-	MOVQ    24(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 224(SP)
-	MOVQ    CX, 232(SP)
-	MOVQ    DX, 240(SP)
-	MOVQ    BX, 248(SP)
-
-	// Step 6: t0*t1
-	MOVQ 192(SP), AX
-	MOVQ 200(SP), CX
-	MOVQ 208(SP), BX
-	MOVQ 216(SP), BP
-	MOVQ 224(SP), DX
-	MOVQ 232(SP), SI
-	MOVQ 240(SP), DI
-	MOVQ 248(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 736(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 744(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 752(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 760(SP)
-	MOVQ    R12, 768(SP)
-	MOVQ    SI, 776(SP)
-	MOVQ    DI, 784(SP)
-	MOVQ    CX, 792(SP)
-	XORQ    AX, AX
-	MOVQ    736(SP), CX
-	MOVQ    744(SP), BX
-	MOVQ    752(SP), BP
-	MOVQ    760(SP), SI
-	MOVQ    768(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    776(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    784(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    792(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 256(SP)
-	MOVQ    CX, 264(SP)
-	MOVQ    BX, 272(SP)
-	MOVQ    BP, 280(SP)
-
-	// Step 7: t2+t2
-	MOVQ    256(SP), AX
-	MOVQ    264(SP), CX
-	MOVQ    272(SP), DX
-	MOVQ    280(SP), BX
-	MOVQ    256(SP), BP
-	MOVQ    264(SP), SI
-	MOVQ    272(SP), DI
-	MOVQ    280(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 288(SP)
-	MOVQ    CX, 296(SP)
-	MOVQ    DX, 304(SP)
-	MOVQ    BX, 312(SP)
-
-	// Step 8: alpha+t2
-	MOVQ    288(SP), AX
-	MOVQ    296(SP), CX
-	MOVQ    304(SP), DX
-	MOVQ    312(SP), BX
-	MOVQ    256(SP), BP
-	MOVQ    264(SP), SI
-	MOVQ    272(SP), DI
-	MOVQ    280(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 288(SP)
-	MOVQ    CX, 296(SP)
-	MOVQ    DX, 304(SP)
-	MOVQ    BX, 312(SP)
-
-	// Step 9: alpha^2
-	MOVQ 288(SP), AX
-	MOVQ 296(SP), CX
-	MOVQ 304(SP), BX
-	MOVQ 312(SP), BP
-
-	// y[0]
-	MOVQ AX, DX
-	XORQ SI, SI
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, DI, R8
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R9, R10
-	ADCXQ R9, R8
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R9, R11
-	ADCXQ R9, R10
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R9
-	ADCXQ DX, R11
-	ADCXQ SI, R9
-	MOVQ  DI, 736(SP)
-
-	// y[1]
-	MOVQ CX, DX
-	XORQ SI, SI
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, DI, R12
-	ADCXQ DI, R8
-	ADOXQ R12, R10
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, DI, R12
-	ADCXQ DI, R10
-	ADOXQ R12, R11
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, DI, R12
-	ADCXQ DI, R11
-	ADOXQ R12, R9
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, DI
-	ADCXQ DX, R9
-	ADCXQ SI, DI
-	ADOXQ SI, DI
-	MOVQ  R8, 744(SP)
-
-	// y[2]
-	MOVQ BX, DX
-	XORQ SI, SI
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, R8, R12
-	ADCXQ R8, R10
-	ADOXQ R12, R11
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, R8, R12
-	ADCXQ R8, R11
-	ADOXQ R12, R9
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, R8, R12
-	ADCXQ R8, R9
-	ADOXQ R12, DI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, R8
-	ADCXQ DX, DI
-	ADCXQ SI, R8
-	ADOXQ SI, R8
-	MOVQ  R10, 752(SP)
-
-	// y[3]
-	MOVQ BP, DX
-	XORQ SI, SI
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R10
-	ADCXQ AX, R11
-	ADOXQ R10, R9
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R9
-	ADOXQ CX, DI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, DI
-	ADOXQ CX, R8
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, R8
-	ADCXQ   SI, CX
-	ADOXQ   SI, CX
-	MOVQ    R11, 760(SP)
-	MOVQ    R9, 768(SP)
-	MOVQ    DI, 776(SP)
-	MOVQ    R8, 784(SP)
-	MOVQ    CX, 792(SP)
-	XORQ    AX, AX
-	MOVQ    736(SP), CX
-	MOVQ    744(SP), BX
-	MOVQ    752(SP), BP
-	MOVQ    760(SP), SI
-	MOVQ    768(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    776(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    784(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    792(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 320(SP)
-	MOVQ    CX, 328(SP)
-	MOVQ    BX, 336(SP)
-	MOVQ    BP, 344(SP)
-
-	// Step 10: beta+beta
-	MOVQ    128(SP), AX
-	MOVQ    136(SP), CX
-	MOVQ    144(SP), DX
-	MOVQ    152(SP), BX
-	MOVQ    128(SP), BP
-	MOVQ    136(SP), SI
-	MOVQ    144(SP), DI
-	MOVQ    152(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    DX, 368(SP)
-	MOVQ    BX, 376(SP)
-
-	// Step 11: t4+t4
-	MOVQ    352(SP), AX
-	MOVQ    360(SP), CX
-	MOVQ    368(SP), DX
-	MOVQ    376(SP), BX
-	MOVQ    352(SP), BP
-	MOVQ    360(SP), SI
-	MOVQ    368(SP), DI
-	MOVQ    376(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    DX, 368(SP)
-	MOVQ    BX, 376(SP)
-
-	// Step 12: t4+t4
-	MOVQ    352(SP), AX
-	MOVQ    360(SP), CX
-	MOVQ    368(SP), DX
-	MOVQ    376(SP), BX
-	MOVQ    352(SP), BP
-	MOVQ    360(SP), SI
-	MOVQ    368(SP), DI
-	MOVQ    376(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    DX, 368(SP)
-	MOVQ    BX, 376(SP)
-
-	// Step 13: t3-t4
-	MOVQ    320(SP), AX
-	MOVQ    328(SP), CX
-	MOVQ    336(SP), DX
-	MOVQ    344(SP), BX
-	MOVQ    352(SP), BP
-	MOVQ    360(SP), SI
-	MOVQ    368(SP), DI
-	MOVQ    376(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 384(SP)
-	MOVQ    CX, 392(SP)
-	MOVQ    DX, 400(SP)
-	MOVQ    BX, 408(SP)
-
-	// Step 14: Y1+Z1
-	MOVQ    96(SP), AX
-	MOVQ    104(SP), CX
-	MOVQ    112(SP), DX
-	MOVQ    120(SP), BX
-	MOVQ    32(SP), BP
-	MOVQ    40(SP), SI
-	MOVQ    48(SP), DI
-	MOVQ    56(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 416(SP)
-	MOVQ    CX, 424(SP)
-	MOVQ    DX, 432(SP)
-	MOVQ    BX, 440(SP)
-
-	// Step 15: t5^2
-	MOVQ 416(SP), AX
-	MOVQ 424(SP), CX
-	MOVQ 432(SP), BX
-	MOVQ 440(SP), BP
-
-	// y[0]
-	MOVQ AX, DX
-	XORQ SI, SI
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, DI, R8
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R9, R10
-	ADCXQ R9, R8
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R9, R11
-	ADCXQ R9, R10
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R9
-	ADCXQ DX, R11
-	ADCXQ SI, R9
-	MOVQ  DI, 736(SP)
-
-	// y[1]
-	MOVQ CX, DX
-	XORQ SI, SI
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, DI, R12
-	ADCXQ DI, R8
-	ADOXQ R12, R10
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, DI, R12
-	ADCXQ DI, R10
-	ADOXQ R12, R11
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, DI, R12
-	ADCXQ DI, R11
-	ADOXQ R12, R9
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, DI
-	ADCXQ DX, R9
-	ADCXQ SI, DI
-	ADOXQ SI, DI
-	MOVQ  R8, 744(SP)
-
-	// y[2]
-	MOVQ BX, DX
-	XORQ SI, SI
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, R8, R12
-	ADCXQ R8, R10
-	ADOXQ R12, R11
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, R8, R12
-	ADCXQ R8, R11
-	ADOXQ R12, R9
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, R8, R12
-	ADCXQ R8, R9
-	ADOXQ R12, DI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, R8
-	ADCXQ DX, DI
-	ADCXQ SI, R8
-	ADOXQ SI, R8
-	MOVQ  R10, 752(SP)
-
-	// y[3]
-	MOVQ BP, DX
-	XORQ SI, SI
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R10
-	ADCXQ AX, R11
-	ADOXQ R10, R9
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R9
-	ADOXQ CX, DI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, DI
-	ADOXQ CX, R8
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, R8
-	ADCXQ   SI, CX
-	ADOXQ   SI, CX
-	MOVQ    R11, 760(SP)
-	MOVQ    R9, 768(SP)
-	MOVQ    DI, 776(SP)
-	MOVQ    R8, 784(SP)
-	MOVQ    CX, 792(SP)
-	XORQ    AX, AX
-	MOVQ    736(SP), CX
-	MOVQ    744(SP), BX
-	MOVQ    752(SP), BP
-	MOVQ    760(SP), SI
-	MOVQ    768(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    776(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    784(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    792(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 448(SP)
-	MOVQ    CX, 456(SP)
-	MOVQ    BX, 464(SP)
-	MOVQ    BP, 472(SP)
-
-	// Step 16: t6-gamma
-	MOVQ    448(SP), AX
-	MOVQ    456(SP), CX
-	MOVQ    464(SP), DX
-	MOVQ    472(SP), BX
-	MOVQ    64(SP), BP
-	MOVQ    72(SP), SI
-	MOVQ    80(SP), DI
-	MOVQ    88(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 480(SP)
-	MOVQ    CX, 488(SP)
-	MOVQ    DX, 496(SP)
-	MOVQ    BX, 504(SP)
-
-	// Step 17: t7-delta
-	MOVQ    480(SP), AX
-	MOVQ    488(SP), CX
-	MOVQ    496(SP), DX
-	MOVQ    504(SP), BX
-	MOVQ    (SP), BP
-	MOVQ    8(SP), SI
-	MOVQ    16(SP), DI
-	MOVQ    24(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 512(SP)
-	MOVQ    CX, 520(SP)
-	MOVQ    DX, 528(SP)
-	MOVQ    BX, 536(SP)
-
-	// Step 18: beta+beta
-	MOVQ    128(SP), AX
-	MOVQ    136(SP), CX
-	MOVQ    144(SP), DX
-	MOVQ    152(SP), BX
-	MOVQ    128(SP), BP
-	MOVQ    136(SP), SI
-	MOVQ    144(SP), DI
-	MOVQ    152(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 544(SP)
-	MOVQ    CX, 552(SP)
-	MOVQ    DX, 560(SP)
-	MOVQ    BX, 568(SP)
-
-	// Step 19: t8+t8
-	MOVQ    544(SP), AX
-	MOVQ    552(SP), CX
-	MOVQ    560(SP), DX
-	MOVQ    568(SP), BX
-	MOVQ    544(SP), BP
-	MOVQ    552(SP), SI
-	MOVQ    560(SP), DI
-	MOVQ    568(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 544(SP)
-	MOVQ    CX, 552(SP)
-	MOVQ    DX, 560(SP)
-	MOVQ    BX, 568(SP)
-
-	// Step 20: t8-X3
-	MOVQ    544(SP), AX
-	MOVQ    552(SP), CX
-	MOVQ    560(SP), DX
-	MOVQ    568(SP), BX
-	MOVQ    384(SP), BP
-	MOVQ    392(SP), SI
-	MOVQ    400(SP), DI
-	MOVQ    408(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 576(SP)
-	MOVQ    CX, 584(SP)
-	MOVQ    DX, 592(SP)
-	MOVQ    BX, 600(SP)
-
-	// Step 21: gamma^2
-	MOVQ 64(SP), AX
-	MOVQ 72(SP), CX
-	MOVQ 80(SP), BX
-	MOVQ 88(SP), BP
-
-	// y[0]
-	MOVQ AX, DX
-	XORQ SI, SI
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, DI, R8
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R9, R10
-	ADCXQ R9, R8
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R9, R11
-	ADCXQ R9, R10
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R9
-	ADCXQ DX, R11
-	ADCXQ SI, R9
-	MOVQ  DI, 736(SP)
-
-	// y[1]
-	MOVQ CX, DX
-	XORQ SI, SI
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, DI, R12
-	ADCXQ DI, R8
-	ADOXQ R12, R10
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, DI, R12
-	ADCXQ DI, R10
-	ADOXQ R12, R11
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, DI, R12
-	ADCXQ DI, R11
-	ADOXQ R12, R9
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, DI
-	ADCXQ DX, R9
-	ADCXQ SI, DI
-	ADOXQ SI, DI
-	MOVQ  R8, 744(SP)
-
-	// y[2]
-	MOVQ BX, DX
-	XORQ SI, SI
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, R8, R12
-	ADCXQ R8, R10
-	ADOXQ R12, R11
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, R8, R12
-	ADCXQ R8, R11
-	ADOXQ R12, R9
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, R8, R12
-	ADCXQ R8, R9
-	ADOXQ R12, DI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, R8
-	ADCXQ DX, DI
-	ADCXQ SI, R8
-	ADOXQ SI, R8
-	MOVQ  R10, 752(SP)
-
-	// y[3]
-	MOVQ BP, DX
-	XORQ SI, SI
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R10
-	ADCXQ AX, R11
-	ADOXQ R10, R9
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R9
-	ADOXQ CX, DI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, DI
-	ADOXQ CX, R8
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, R8
-	ADCXQ   SI, CX
-	ADOXQ   SI, CX
-	MOVQ    R11, 760(SP)
-	MOVQ    R9, 768(SP)
-	MOVQ    DI, 776(SP)
-	MOVQ    R8, 784(SP)
-	MOVQ    CX, 792(SP)
-	XORQ    AX, AX
-	MOVQ    736(SP), CX
-	MOVQ    744(SP), BX
-	MOVQ    752(SP), BP
-	MOVQ    760(SP), SI
-	MOVQ    768(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    776(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    784(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    792(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 608(SP)
-	MOVQ    CX, 616(SP)
-	MOVQ    BX, 624(SP)
-	MOVQ    BP, 632(SP)
-
-	// Step 22: t10+t10
-	MOVQ    608(SP), AX
-	MOVQ    616(SP), CX
-	MOVQ    624(SP), DX
-	MOVQ    632(SP), BX
-	MOVQ    608(SP), BP
-	MOVQ    616(SP), SI
-	MOVQ    624(SP), DI
-	MOVQ    632(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 640(SP)
-	MOVQ    CX, 648(SP)
-	MOVQ    DX, 656(SP)
-	MOVQ    BX, 664(SP)
-
-	// Step 23: t11+t11
-	MOVQ    640(SP), AX
-	MOVQ    648(SP), CX
-	MOVQ    656(SP), DX
-	MOVQ    664(SP), BX
-	MOVQ    640(SP), BP
-	MOVQ    648(SP), SI
-	MOVQ    656(SP), DI
-	MOVQ    664(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 640(SP)
-	MOVQ    CX, 648(SP)
-	MOVQ    DX, 656(SP)
-	MOVQ    BX, 664(SP)
-
-	// Step 24: t11+t11
-	MOVQ    640(SP), AX
-	MOVQ    648(SP), CX
-	MOVQ    656(SP), DX
-	MOVQ    664(SP), BX
-	MOVQ    640(SP), BP
-	MOVQ    648(SP), SI
-	MOVQ    656(SP), DI
-	MOVQ    664(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 640(SP)
-	MOVQ    CX, 648(SP)
-	MOVQ    DX, 656(SP)
-	MOVQ    BX, 664(SP)
-
-	// Step 25: alpha*t9
-	MOVQ 288(SP), AX
-	MOVQ 296(SP), CX
-	MOVQ 304(SP), BX
-	MOVQ 312(SP), BP
-	MOVQ 576(SP), DX
-	MOVQ 584(SP), SI
-	MOVQ 592(SP), DI
-	MOVQ 600(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 736(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 744(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 752(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 760(SP)
-	MOVQ    R12, 768(SP)
-	MOVQ    SI, 776(SP)
-	MOVQ    DI, 784(SP)
-	MOVQ    CX, 792(SP)
-	XORQ    AX, AX
-	MOVQ    736(SP), CX
-	MOVQ    744(SP), BX
-	MOVQ    752(SP), BP
-	MOVQ    760(SP), SI
-	MOVQ    768(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    776(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    784(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    792(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 672(SP)
-	MOVQ    CX, 680(SP)
-	MOVQ    BX, 688(SP)
-	MOVQ    BP, 696(SP)
-
-	// Step 26: t12-t11
-	MOVQ    672(SP), AX
-	MOVQ    680(SP), CX
-	MOVQ    688(SP), DX
-	MOVQ    696(SP), BX
-	MOVQ    640(SP), BP
-	MOVQ    648(SP), SI
-	MOVQ    656(SP), DI
-	MOVQ    664(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 704(SP)
-	MOVQ    CX, 712(SP)
-	MOVQ    DX, 720(SP)
-	MOVQ    BX, 728(SP)
-	MOVQ    X3_+8(FP), BP
-	MOVQ    384(SP), AX
-	MOVQ    392(SP), CX
-	MOVQ    400(SP), DX
-	MOVQ    408(SP), BX
-	MOVQ    AX, (BP)
-	MOVQ    CX, 8(BP)
-	MOVQ    DX, 16(BP)
-	MOVQ    BX, 24(BP)
-	MOVQ    Y3_+24(FP), BP
-	MOVQ    704(SP), AX
-	MOVQ    712(SP), CX
-	MOVQ    720(SP), DX
-	MOVQ    728(SP), BX
-	MOVQ    AX, (BP)
-	MOVQ    CX, 8(BP)
-	MOVQ    DX, 16(BP)
-	MOVQ    BX, 24(BP)
-	MOVQ    Z3_+40(FP), BP
-	MOVQ    512(SP), AX
-	MOVQ    520(SP), CX
-	MOVQ    528(SP), DX
-	MOVQ    536(SP), BX
-	MOVQ    AX, (BP)
-	MOVQ    CX, 8(BP)
-	MOVQ    DX, 16(BP)
-	MOVQ    BX, 24(BP)
-	RET
-
-// func completeadd(X1_ *Elt, X2_ *Elt, X3_ *Elt, Y1_ *Elt, Y2_ *Elt, Y3_ *Elt, Z1_ *Elt, Z2_ *Elt, Z3_ *Elt, b *Elt)
-TEXT ·completeadd(SB), $576-80
-	MOVQ X1_+0(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 32(SP)
-	MOVQ CX, 40(SP)
-	MOVQ DX, 48(SP)
-	MOVQ BX, 56(SP)
-	MOVQ X2_+8(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 64(SP)
-	MOVQ CX, 72(SP)
-	MOVQ DX, 80(SP)
-	MOVQ BX, 88(SP)
-	MOVQ Y1_+24(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 128(SP)
-	MOVQ CX, 136(SP)
-	MOVQ DX, 144(SP)
-	MOVQ BX, 152(SP)
-	MOVQ Y2_+32(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 160(SP)
-	MOVQ CX, 168(SP)
-	MOVQ DX, 176(SP)
-	MOVQ BX, 184(SP)
-	MOVQ Z1_+48(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 224(SP)
-	MOVQ CX, 232(SP)
-	MOVQ DX, 240(SP)
-	MOVQ BX, 248(SP)
-	MOVQ Z2_+56(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 256(SP)
-	MOVQ CX, 264(SP)
-	MOVQ DX, 272(SP)
-	MOVQ BX, 280(SP)
-	MOVQ b+72(FP), BX
-	MOVQ (BX), AX
-	MOVQ 8(BX), CX
-	MOVQ 16(BX), DX
-	MOVQ 24(BX), BX
-	MOVQ AX, 448(SP)
-	MOVQ CX, 456(SP)
-	MOVQ DX, 464(SP)
-	MOVQ BX, 472(SP)
-
-	// Step 1: X1*X2
-	MOVQ 32(SP), AX
-	MOVQ 40(SP), CX
-	MOVQ 48(SP), BX
-	MOVQ 56(SP), BP
-	MOVQ 64(SP), DX
-	MOVQ 72(SP), SI
-	MOVQ 80(SP), DI
-	MOVQ 88(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, (SP)
-	MOVQ    CX, 8(SP)
-	MOVQ    BX, 16(SP)
-	MOVQ    BP, 24(SP)
-
-	// Step 2: Y1*Y2
-	MOVQ 128(SP), AX
-	MOVQ 136(SP), CX
-	MOVQ 144(SP), BX
-	MOVQ 152(SP), BP
-	MOVQ 160(SP), DX
-	MOVQ 168(SP), SI
-	MOVQ 176(SP), DI
-	MOVQ 184(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 96(SP)
-	MOVQ    CX, 104(SP)
-	MOVQ    BX, 112(SP)
-	MOVQ    BP, 120(SP)
-
-	// Step 3: Z1*Z2
-	MOVQ 224(SP), AX
-	MOVQ 232(SP), CX
-	MOVQ 240(SP), BX
-	MOVQ 248(SP), BP
-	MOVQ 256(SP), DX
-	MOVQ 264(SP), SI
-	MOVQ 272(SP), DI
-	MOVQ 280(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 192(SP)
-	MOVQ    CX, 200(SP)
-	MOVQ    BX, 208(SP)
-	MOVQ    BP, 216(SP)
-
-	// Step 4: X1+Y1
-	MOVQ    32(SP), AX
-	MOVQ    40(SP), CX
-	MOVQ    48(SP), DX
-	MOVQ    56(SP), BX
-	MOVQ    128(SP), BP
-	MOVQ    136(SP), SI
-	MOVQ    144(SP), DI
-	MOVQ    152(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 288(SP)
-	MOVQ    CX, 296(SP)
-	MOVQ    DX, 304(SP)
-	MOVQ    BX, 312(SP)
-
-	// Step 5: X2+Y2
-	MOVQ    64(SP), AX
-	MOVQ    72(SP), CX
-	MOVQ    80(SP), DX
-	MOVQ    88(SP), BX
-	MOVQ    160(SP), BP
-	MOVQ    168(SP), SI
-	MOVQ    176(SP), DI
-	MOVQ    184(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 320(SP)
-	MOVQ    CX, 328(SP)
-	MOVQ    DX, 336(SP)
-	MOVQ    BX, 344(SP)
-
-	// Step 6: t3*t4
-	MOVQ 288(SP), AX
-	MOVQ 296(SP), CX
-	MOVQ 304(SP), BX
-	MOVQ 312(SP), BP
-	MOVQ 320(SP), DX
-	MOVQ 328(SP), SI
-	MOVQ 336(SP), DI
-	MOVQ 344(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 288(SP)
-	MOVQ    CX, 296(SP)
-	MOVQ    BX, 304(SP)
-	MOVQ    BP, 312(SP)
-
-	// Step 7: t0+t1
-	MOVQ    (SP), AX
-	MOVQ    8(SP), CX
-	MOVQ    16(SP), DX
-	MOVQ    24(SP), BX
-	MOVQ    96(SP), BP
-	MOVQ    104(SP), SI
-	MOVQ    112(SP), DI
-	MOVQ    120(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 320(SP)
-	MOVQ    CX, 328(SP)
-	MOVQ    DX, 336(SP)
-	MOVQ    BX, 344(SP)
-
-	// Step 8: t3-t4
-	MOVQ    288(SP), AX
-	MOVQ    296(SP), CX
-	MOVQ    304(SP), DX
-	MOVQ    312(SP), BX
-	MOVQ    320(SP), BP
-	MOVQ    328(SP), SI
-	MOVQ    336(SP), DI
-	MOVQ    344(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 288(SP)
-	MOVQ    CX, 296(SP)
-	MOVQ    DX, 304(SP)
-	MOVQ    BX, 312(SP)
-
-	// Step 9: Y1+Z1
-	MOVQ    128(SP), AX
-	MOVQ    136(SP), CX
-	MOVQ    144(SP), DX
-	MOVQ    152(SP), BX
-	MOVQ    224(SP), BP
-	MOVQ    232(SP), SI
-	MOVQ    240(SP), DI
-	MOVQ    248(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 320(SP)
-	MOVQ    CX, 328(SP)
-	MOVQ    DX, 336(SP)
-	MOVQ    BX, 344(SP)
-
-	// Step 10: Y2+Z2
-	MOVQ    160(SP), AX
-	MOVQ    168(SP), CX
-	MOVQ    176(SP), DX
-	MOVQ    184(SP), BX
-	MOVQ    256(SP), BP
-	MOVQ    264(SP), SI
-	MOVQ    272(SP), DI
-	MOVQ    280(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    DX, 368(SP)
-	MOVQ    BX, 376(SP)
-
-	// Step 11: t4*t5
-	MOVQ 320(SP), AX
-	MOVQ 328(SP), CX
-	MOVQ 336(SP), BX
-	MOVQ 344(SP), BP
-	MOVQ 352(SP), DX
-	MOVQ 360(SP), SI
-	MOVQ 368(SP), DI
-	MOVQ 376(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 320(SP)
-	MOVQ    CX, 328(SP)
-	MOVQ    BX, 336(SP)
-	MOVQ    BP, 344(SP)
-
-	// Step 12: t1+t2
-	MOVQ    96(SP), AX
-	MOVQ    104(SP), CX
-	MOVQ    112(SP), DX
-	MOVQ    120(SP), BX
-	MOVQ    192(SP), BP
-	MOVQ    200(SP), SI
-	MOVQ    208(SP), DI
-	MOVQ    216(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    DX, 368(SP)
-	MOVQ    BX, 376(SP)
-
-	// Step 13: t4-t5
-	MOVQ    320(SP), AX
-	MOVQ    328(SP), CX
-	MOVQ    336(SP), DX
-	MOVQ    344(SP), BX
-	MOVQ    352(SP), BP
-	MOVQ    360(SP), SI
-	MOVQ    368(SP), DI
-	MOVQ    376(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 320(SP)
-	MOVQ    CX, 328(SP)
-	MOVQ    DX, 336(SP)
-	MOVQ    BX, 344(SP)
-
-	// Step 14: X1+Z1
-	MOVQ    32(SP), AX
-	MOVQ    40(SP), CX
-	MOVQ    48(SP), DX
-	MOVQ    56(SP), BX
-	MOVQ    224(SP), BP
-	MOVQ    232(SP), SI
-	MOVQ    240(SP), DI
-	MOVQ    248(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    DX, 368(SP)
-	MOVQ    BX, 376(SP)
-
-	// Step 15: X2+Z2
-	MOVQ    64(SP), AX
-	MOVQ    72(SP), CX
-	MOVQ    80(SP), DX
-	MOVQ    88(SP), BX
-	MOVQ    256(SP), BP
-	MOVQ    264(SP), SI
-	MOVQ    272(SP), DI
-	MOVQ    280(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 384(SP)
-	MOVQ    CX, 392(SP)
-	MOVQ    DX, 400(SP)
-	MOVQ    BX, 408(SP)
-
-	// Step 16: t5*Y3
-	MOVQ 352(SP), AX
-	MOVQ 360(SP), CX
-	MOVQ 368(SP), BX
-	MOVQ 376(SP), BP
-	MOVQ 384(SP), DX
-	MOVQ 392(SP), SI
-	MOVQ 400(SP), DI
-	MOVQ 408(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    BX, 368(SP)
-	MOVQ    BP, 376(SP)
-
-	// Step 17: t0+t2
-	MOVQ    (SP), AX
-	MOVQ    8(SP), CX
-	MOVQ    16(SP), DX
-	MOVQ    24(SP), BX
-	MOVQ    192(SP), BP
-	MOVQ    200(SP), SI
-	MOVQ    208(SP), DI
-	MOVQ    216(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 384(SP)
-	MOVQ    CX, 392(SP)
-	MOVQ    DX, 400(SP)
-	MOVQ    BX, 408(SP)
-
-	// Step 18: t5-Y3
-	MOVQ    352(SP), AX
-	MOVQ    360(SP), CX
-	MOVQ    368(SP), DX
-	MOVQ    376(SP), BX
-	MOVQ    384(SP), BP
-	MOVQ    392(SP), SI
-	MOVQ    400(SP), DI
-	MOVQ    408(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 384(SP)
-	MOVQ    CX, 392(SP)
-	MOVQ    DX, 400(SP)
-	MOVQ    BX, 408(SP)
-
-	// Step 19: b*t2
-	MOVQ 448(SP), AX
-	MOVQ 456(SP), CX
-	MOVQ 464(SP), BX
-	MOVQ 472(SP), BP
-	MOVQ 192(SP), DX
-	MOVQ 200(SP), SI
-	MOVQ 208(SP), DI
-	MOVQ 216(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 416(SP)
-	MOVQ    CX, 424(SP)
-	MOVQ    BX, 432(SP)
-	MOVQ    BP, 440(SP)
-
-	// Step 20: Y3-Z3
-	MOVQ    384(SP), AX
-	MOVQ    392(SP), CX
-	MOVQ    400(SP), DX
-	MOVQ    408(SP), BX
-	MOVQ    416(SP), BP
-	MOVQ    424(SP), SI
-	MOVQ    432(SP), DI
-	MOVQ    440(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    DX, 368(SP)
-	MOVQ    BX, 376(SP)
-
-	// Step 21: t5+t5
-	MOVQ    352(SP), AX
-	MOVQ    360(SP), CX
-	MOVQ    368(SP), DX
-	MOVQ    376(SP), BX
-	MOVQ    352(SP), BP
-	MOVQ    360(SP), SI
-	MOVQ    368(SP), DI
-	MOVQ    376(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 416(SP)
-	MOVQ    CX, 424(SP)
-	MOVQ    DX, 432(SP)
-	MOVQ    BX, 440(SP)
-
-	// Step 22: t5+Z3
-	MOVQ    352(SP), AX
-	MOVQ    360(SP), CX
-	MOVQ    368(SP), DX
-	MOVQ    376(SP), BX
-	MOVQ    416(SP), BP
-	MOVQ    424(SP), SI
-	MOVQ    432(SP), DI
-	MOVQ    440(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    DX, 368(SP)
-	MOVQ    BX, 376(SP)
-
-	// Step 23: t1-t5
-	MOVQ    96(SP), AX
-	MOVQ    104(SP), CX
-	MOVQ    112(SP), DX
-	MOVQ    120(SP), BX
-	MOVQ    352(SP), BP
-	MOVQ    360(SP), SI
-	MOVQ    368(SP), DI
-	MOVQ    376(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 416(SP)
-	MOVQ    CX, 424(SP)
-	MOVQ    DX, 432(SP)
-	MOVQ    BX, 440(SP)
-
-	// Step 24: t1+t5
-	MOVQ    96(SP), AX
-	MOVQ    104(SP), CX
-	MOVQ    112(SP), DX
-	MOVQ    120(SP), BX
-	MOVQ    352(SP), BP
-	MOVQ    360(SP), SI
-	MOVQ    368(SP), DI
-	MOVQ    376(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    DX, 368(SP)
-	MOVQ    BX, 376(SP)
-
-	// Step 25: b*Y3
-	MOVQ 448(SP), AX
-	MOVQ 456(SP), CX
-	MOVQ 464(SP), BX
-	MOVQ 472(SP), BP
-	MOVQ 384(SP), DX
-	MOVQ 392(SP), SI
-	MOVQ 400(SP), DI
-	MOVQ 408(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 384(SP)
-	MOVQ    CX, 392(SP)
-	MOVQ    BX, 400(SP)
-	MOVQ    BP, 408(SP)
-
-	// Step 26: t2+t2
-	MOVQ    192(SP), AX
-	MOVQ    200(SP), CX
-	MOVQ    208(SP), DX
-	MOVQ    216(SP), BX
-	MOVQ    192(SP), BP
-	MOVQ    200(SP), SI
-	MOVQ    208(SP), DI
-	MOVQ    216(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 96(SP)
-	MOVQ    CX, 104(SP)
-	MOVQ    DX, 112(SP)
-	MOVQ    BX, 120(SP)
-
-	// Step 27: t1+t2
-	MOVQ    96(SP), AX
-	MOVQ    104(SP), CX
-	MOVQ    112(SP), DX
-	MOVQ    120(SP), BX
-	MOVQ    192(SP), BP
-	MOVQ    200(SP), SI
-	MOVQ    208(SP), DI
-	MOVQ    216(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 192(SP)
-	MOVQ    CX, 200(SP)
-	MOVQ    DX, 208(SP)
-	MOVQ    BX, 216(SP)
-
-	// Step 28: Y3-t2
-	MOVQ    384(SP), AX
-	MOVQ    392(SP), CX
-	MOVQ    400(SP), DX
-	MOVQ    408(SP), BX
-	MOVQ    192(SP), BP
-	MOVQ    200(SP), SI
-	MOVQ    208(SP), DI
-	MOVQ    216(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 384(SP)
-	MOVQ    CX, 392(SP)
-	MOVQ    DX, 400(SP)
-	MOVQ    BX, 408(SP)
-
-	// Step 29: Y3-t0
-	MOVQ    384(SP), AX
-	MOVQ    392(SP), CX
-	MOVQ    400(SP), DX
-	MOVQ    408(SP), BX
-	MOVQ    (SP), BP
-	MOVQ    8(SP), SI
-	MOVQ    16(SP), DI
-	MOVQ    24(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 384(SP)
-	MOVQ    CX, 392(SP)
-	MOVQ    DX, 400(SP)
-	MOVQ    BX, 408(SP)
-
-	// Step 30: Y3+Y3
-	MOVQ    384(SP), AX
-	MOVQ    392(SP), CX
-	MOVQ    400(SP), DX
-	MOVQ    408(SP), BX
-	MOVQ    384(SP), BP
-	MOVQ    392(SP), SI
-	MOVQ    400(SP), DI
-	MOVQ    408(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 96(SP)
-	MOVQ    CX, 104(SP)
-	MOVQ    DX, 112(SP)
-	MOVQ    BX, 120(SP)
-
-	// Step 31: t1+Y3
-	MOVQ    96(SP), AX
-	MOVQ    104(SP), CX
-	MOVQ    112(SP), DX
-	MOVQ    120(SP), BX
-	MOVQ    384(SP), BP
-	MOVQ    392(SP), SI
-	MOVQ    400(SP), DI
-	MOVQ    408(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 384(SP)
-	MOVQ    CX, 392(SP)
-	MOVQ    DX, 400(SP)
-	MOVQ    BX, 408(SP)
-
-	// Step 32: t0+t0
-	MOVQ    (SP), AX
-	MOVQ    8(SP), CX
-	MOVQ    16(SP), DX
-	MOVQ    24(SP), BX
-	MOVQ    (SP), BP
-	MOVQ    8(SP), SI
-	MOVQ    16(SP), DI
-	MOVQ    24(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 96(SP)
-	MOVQ    CX, 104(SP)
-	MOVQ    DX, 112(SP)
-	MOVQ    BX, 120(SP)
-
-	// Step 33: t1+t0
-	MOVQ    96(SP), AX
-	MOVQ    104(SP), CX
-	MOVQ    112(SP), DX
-	MOVQ    120(SP), BX
-	MOVQ    (SP), BP
-	MOVQ    8(SP), SI
-	MOVQ    16(SP), DI
-	MOVQ    24(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, (SP)
-	MOVQ    CX, 8(SP)
-	MOVQ    DX, 16(SP)
-	MOVQ    BX, 24(SP)
-
-	// Step 34: t0-t2
-	MOVQ    (SP), AX
-	MOVQ    8(SP), CX
-	MOVQ    16(SP), DX
-	MOVQ    24(SP), BX
-	MOVQ    192(SP), BP
-	MOVQ    200(SP), SI
-	MOVQ    208(SP), DI
-	MOVQ    216(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, (SP)
-	MOVQ    CX, 8(SP)
-	MOVQ    DX, 16(SP)
-	MOVQ    BX, 24(SP)
-
-	// Step 35: t4*Y3
-	MOVQ 320(SP), AX
-	MOVQ 328(SP), CX
-	MOVQ 336(SP), BX
-	MOVQ 344(SP), BP
-	MOVQ 384(SP), DX
-	MOVQ 392(SP), SI
-	MOVQ 400(SP), DI
-	MOVQ 408(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 96(SP)
-	MOVQ    CX, 104(SP)
-	MOVQ    BX, 112(SP)
-	MOVQ    BP, 120(SP)
-
-	// Step 36: t0*Y3
-	MOVQ (SP), AX
-	MOVQ 8(SP), CX
-	MOVQ 16(SP), BX
-	MOVQ 24(SP), BP
-	MOVQ 384(SP), DX
-	MOVQ 392(SP), SI
-	MOVQ 400(SP), DI
-	MOVQ 408(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 192(SP)
-	MOVQ    CX, 200(SP)
-	MOVQ    BX, 208(SP)
-	MOVQ    BP, 216(SP)
-
-	// Step 37: t5*Z3
-	MOVQ 352(SP), AX
-	MOVQ 360(SP), CX
-	MOVQ 368(SP), BX
-	MOVQ 376(SP), BP
-	MOVQ 416(SP), DX
-	MOVQ 424(SP), SI
-	MOVQ 432(SP), DI
-	MOVQ 440(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 384(SP)
-	MOVQ    CX, 392(SP)
-	MOVQ    BX, 400(SP)
-	MOVQ    BP, 408(SP)
-
-	// Step 38: Y3+t2
-	MOVQ    384(SP), AX
-	MOVQ    392(SP), CX
-	MOVQ    400(SP), DX
-	MOVQ    408(SP), BX
-	MOVQ    192(SP), BP
-	MOVQ    200(SP), SI
-	MOVQ    208(SP), DI
-	MOVQ    216(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 384(SP)
-	MOVQ    CX, 392(SP)
-	MOVQ    DX, 400(SP)
-	MOVQ    BX, 408(SP)
-
-	// Step 39: t3*t5
-	MOVQ 288(SP), AX
-	MOVQ 296(SP), CX
-	MOVQ 304(SP), BX
-	MOVQ 312(SP), BP
-	MOVQ 352(SP), DX
-	MOVQ 360(SP), SI
-	MOVQ 368(SP), DI
-	MOVQ 376(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    BX, 368(SP)
-	MOVQ    BP, 376(SP)
-
-	// Step 40: t5-t1
-	MOVQ    352(SP), AX
-	MOVQ    360(SP), CX
-	MOVQ    368(SP), DX
-	MOVQ    376(SP), BX
-	MOVQ    96(SP), BP
-	MOVQ    104(SP), SI
-	MOVQ    112(SP), DI
-	MOVQ    120(SP), R8
-	XORQ    R9, R9
-	SUBQ    BP, AX
-	SBBQ    SI, CX
-	SBBQ    DI, DX
-	SBBQ    R8, BX
-	SBBQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	ADDQ    p<>+0(SB), BP
-	ADCQ    p<>+8(SB), SI
-	ADCQ    p<>+16(SB), DI
-	ADCQ    p<>+24(SB), R8
-	ANDQ    $0x00000001, R9
-	CMOVQNE BP, AX
-	CMOVQNE SI, CX
-	CMOVQNE DI, DX
-	CMOVQNE R8, BX
-	MOVQ    AX, 352(SP)
-	MOVQ    CX, 360(SP)
-	MOVQ    DX, 368(SP)
-	MOVQ    BX, 376(SP)
-
-	// Step 41: t4*Z3
-	MOVQ 320(SP), AX
-	MOVQ 328(SP), CX
-	MOVQ 336(SP), BX
-	MOVQ 344(SP), BP
-	MOVQ 416(SP), DX
-	MOVQ 424(SP), SI
-	MOVQ 432(SP), DI
-	MOVQ 440(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 416(SP)
-	MOVQ    CX, 424(SP)
-	MOVQ    BX, 432(SP)
-	MOVQ    BP, 440(SP)
-
-	// Step 42: t3*t0
-	MOVQ 288(SP), AX
-	MOVQ 296(SP), CX
-	MOVQ 304(SP), BX
-	MOVQ 312(SP), BP
-	MOVQ (SP), DX
-	MOVQ 8(SP), SI
-	MOVQ 16(SP), DI
-	MOVQ 24(SP), R8
-
-	// y[0]
-	XORQ R9, R9
-
-	// x[0] * y[0] -> z[0]
-	MULXQ AX, R10, R11
-
-	// x[1] * y[0] -> z[1]
-	MULXQ CX, R12, R13
-	ADCXQ R12, R11
-
-	// x[2] * y[0] -> z[2]
-	MULXQ BX, R12, R14
-	ADCXQ R12, R13
-
-	// x[3] * y[0] -> z[3]
-	MULXQ BP, DX, R12
-	ADCXQ DX, R14
-	ADCXQ R9, R12
-	MOVQ  R10, 512(SP)
-
-	// y[1]
-	MOVQ SI, DX
-	XORQ R9, R9
-
-	// x[0] * y[1] -> z[1]
-	MULXQ AX, SI, R10
-	ADCXQ SI, R11
-	ADOXQ R10, R13
-
-	// x[1] * y[1] -> z[2]
-	MULXQ CX, SI, R10
-	ADCXQ SI, R13
-	ADOXQ R10, R14
-
-	// x[2] * y[1] -> z[3]
-	MULXQ BX, SI, R10
-	ADCXQ SI, R14
-	ADOXQ R10, R12
-
-	// x[3] * y[1] -> z[4]
-	MULXQ BP, DX, SI
-	ADCXQ DX, R12
-	ADCXQ R9, SI
-	ADOXQ R9, SI
-	MOVQ  R11, 520(SP)
-
-	// y[2]
-	MOVQ DI, DX
-	XORQ R9, R9
-
-	// x[0] * y[2] -> z[2]
-	MULXQ AX, DI, R10
-	ADCXQ DI, R13
-	ADOXQ R10, R14
-
-	// x[1] * y[2] -> z[3]
-	MULXQ CX, DI, R10
-	ADCXQ DI, R14
-	ADOXQ R10, R12
-
-	// x[2] * y[2] -> z[4]
-	MULXQ BX, DI, R10
-	ADCXQ DI, R12
-	ADOXQ R10, SI
-
-	// x[3] * y[2] -> z[5]
-	MULXQ BP, DX, DI
-	ADCXQ DX, SI
-	ADCXQ R9, DI
-	ADOXQ R9, DI
-	MOVQ  R13, 528(SP)
-
-	// y[3]
-	MOVQ R8, DX
-	XORQ R9, R9
-
-	// x[0] * y[3] -> z[3]
-	MULXQ AX, AX, R8
-	ADCXQ AX, R14
-	ADOXQ R8, R12
-
-	// x[1] * y[3] -> z[4]
-	MULXQ CX, AX, CX
-	ADCXQ AX, R12
-	ADOXQ CX, SI
-
-	// x[2] * y[3] -> z[5]
-	MULXQ BX, AX, CX
-	ADCXQ AX, SI
-	ADOXQ CX, DI
-
-	// x[3] * y[3] -> z[6]
-	MULXQ   BP, AX, CX
-	ADCXQ   AX, DI
-	ADCXQ   R9, CX
-	ADOXQ   R9, CX
-	MOVQ    R14, 536(SP)
-	MOVQ    R12, 544(SP)
-	MOVQ    SI, 552(SP)
-	MOVQ    DI, 560(SP)
-	MOVQ    CX, 568(SP)
-	XORQ    AX, AX
-	MOVQ    512(SP), CX
-	MOVQ    520(SP), BX
-	MOVQ    528(SP), BP
-	MOVQ    536(SP), SI
-	MOVQ    544(SP), DI
-	MOVQ    CX, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R9, R10
-	ADCXQ   R9, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+8(SB), CX, R9
-	ADCXQ   CX, BX
-	ADOXQ   R9, BP
-	MULXQ   p<>+16(SB), CX, R9
-	ADCXQ   CX, BP
-	ADOXQ   R9, SI
-	MULXQ   p<>+24(SB), CX, DX
-	ADCXQ   CX, SI
-	ADOXQ   DX, DI
-	ADCXQ   AX, DI
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    552(SP), CX
-	MOVQ    BX, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BX
-	ADOXQ   R11, BP
-	MULXQ   p<>+8(SB), BX, R10
-	ADCXQ   BX, BP
-	ADOXQ   R10, SI
-	MULXQ   p<>+16(SB), BX, R10
-	ADCXQ   BX, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+24(SB), DX, BX
-	ADCXQ   DX, DI
-	ADOXQ   BX, CX
-	ADCXQ   R8, CX
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    560(SP), BX
-	MOVQ    BP, DX
-	XORQ    R8, R8
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, BP
-	ADOXQ   R11, SI
-	MULXQ   p<>+8(SB), BP, R10
-	ADCXQ   BP, SI
-	ADOXQ   R10, DI
-	MULXQ   p<>+16(SB), BP, R10
-	ADCXQ   BP, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+24(SB), DX, BP
-	ADCXQ   DX, CX
-	ADOXQ   BP, BX
-	ADCXQ   R9, BX
-	ADCXQ   AX, R8
-	ADOXQ   AX, R8
-	MOVQ    568(SP), BP
-	MOVQ    SI, DX
-	XORQ    R9, R9
-	MULXQ   p<>+0(SB), R10, R11
-	ADCXQ   R10, SI
-	ADOXQ   R11, DI
-	MULXQ   p<>+8(SB), SI, R10
-	ADCXQ   SI, DI
-	ADOXQ   R10, CX
-	MULXQ   p<>+16(SB), SI, R10
-	ADCXQ   SI, CX
-	ADOXQ   R10, BX
-	MULXQ   p<>+24(SB), DX, SI
-	ADCXQ   DX, BX
-	ADOXQ   SI, BP
-	ADCXQ   R8, BP
-	ADCXQ   AX, R9
-	ADOXQ   AX, R9
-	MOVQ    DI, AX
-	MOVQ    CX, DX
-	MOVQ    BX, SI
-	MOVQ    BP, R8
-	SUBQ    p<>+0(SB), AX
-	SBBQ    p<>+8(SB), DX
-	SBBQ    p<>+16(SB), SI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC AX, DI
-	CMOVQCC DX, CX
-	CMOVQCC SI, BX
-	CMOVQCC R8, BP
-	MOVQ    DI, 96(SP)
-	MOVQ    CX, 104(SP)
-	MOVQ    BX, 112(SP)
-	MOVQ    BP, 120(SP)
-
-	// Step 43: Z3+t1
-	MOVQ    416(SP), AX
-	MOVQ    424(SP), CX
-	MOVQ    432(SP), DX
-	MOVQ    440(SP), BX
-	MOVQ    96(SP), BP
-	MOVQ    104(SP), SI
-	MOVQ    112(SP), DI
-	MOVQ    120(SP), R8
-	XORQ    R9, R9
-	ADDQ    BP, AX
-	ADCQ    SI, CX
-	ADCQ    DI, DX
-	ADCQ    R8, BX
-	ADCQ    $0x00000000, R9
-	MOVQ    AX, BP
-	MOVQ    CX, SI
-	MOVQ    DX, DI
-	MOVQ    BX, R8
-	SUBQ    p<>+0(SB), BP
-	SBBQ    p<>+8(SB), SI
-	SBBQ    p<>+16(SB), DI
-	SBBQ    p<>+24(SB), R8
-	SBBQ    $0x00000000, R9
-	CMOVQCC BP, AX
-	CMOVQCC SI, CX
-	CMOVQCC DI, DX
-	CMOVQCC R8, BX
-	MOVQ    AX, 416(SP)
-	MOVQ    CX, 424(SP)
-	MOVQ    DX, 432(SP)
-	MOVQ    BX, 440(SP)
-
-	// Step 44: t5
-	MOVQ 352(SP), AX
-	MOVQ 360(SP), CX
-	MOVQ 368(SP), DX
-	MOVQ 376(SP), BX
-	MOVQ AX, 480(SP)
-	MOVQ CX, 488(SP)
-	MOVQ DX, 496(SP)
-	MOVQ BX, 504(SP)
-	MOVQ X3_+16(FP), BP
-	MOVQ 480(SP), AX
-	MOVQ 488(SP), CX
-	MOVQ 496(SP), DX
-	MOVQ 504(SP), BX
-	MOVQ AX, (BP)
-	MOVQ CX, 8(BP)
-	MOVQ DX, 16(BP)
-	MOVQ BX, 24(BP)
-	MOVQ Y3_+40(FP), BP
-	MOVQ 384(SP), AX
-	MOVQ 392(SP), CX
-	MOVQ 400(SP), DX
-	MOVQ 408(SP), BX
-	MOVQ AX, (BP)
-	MOVQ CX, 8(BP)
-	MOVQ DX, 16(BP)
-	MOVQ BX, 24(BP)
-	MOVQ Z3_+64(FP), BP
-	MOVQ 416(SP), AX
-	MOVQ 424(SP), CX
-	MOVQ 432(SP), DX
-	MOVQ 440(SP), BX
-	MOVQ AX, (BP)
-	MOVQ CX, 8(BP)
-	MOVQ DX, 16(BP)
-	MOVQ BX, 24(BP)
-	RET
@@ -2,7 +2,12 @@
 
 package p256
 
-import "math/big"
+import (
+	"errors"
+	"math/big"
+
+	"golang.org/x/sys/cpu"
+)
 
 // Size is the size of a field element in bytes.
 const Size = 32
@@ -53,6 +58,39 @@ func (x *Elt) SetBytes(b []byte) *Elt {
 	return x
 }
 
+// widelo and widehi are the multipliers for the low and high halves of the input to SetBytesWide.
+var widelo = Elt{
+	0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xff, 0xff, 0xfb, 0xff, 0xff, 0xff,
+	0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xfd, 0xff, 0xff, 0xff, 0x04,
+}
+var widehi = Elt{
+	0x0a, 0x00, 0x00, 0x00, 0xfd, 0xff, 0xff, 0xff,
+	0xf7, 0xff, 0xff, 0xff, 0xed, 0xff, 0xff, 0xff,
+	0xfc, 0xff, 0xff, 0xff, 0x05, 0x00, 0x00, 0x00,
+	0x01, 0x00, 0x00, 0x00, 0x18,
+}
+
+// SetBytesWide sets x to the big-endian integer b reduced modulo p, in constant
+// time. The slice b must be at most 2*Size bytes long. For uniform sampling,
+// b should be longer than the encoding of p by the number of bits of security.
+func (x *Elt) SetBytesWide(b []byte) *Elt {
+	// Split into little-endian halves.
+	var w [2 * Size]byte
+	for i := range b {
+		w[i] = b[len(b)-1-i]
+	}
+	var lo, hi Elt
+	copy(lo[:], w[:Size])
+	copy(hi[:], w[Size:])
+
+	Mul(&lo, &lo, &widelo)
+	Mul(&hi, &hi, &widehi)
+	Add(x, &lo, &hi)
+	return x
+}
+
 // Int converts to a big integer.
 func (x *Elt) Int() *big.Int {
 	var z Elt
@@ -66,6 +104,57 @@ func (x *Elt) Int() *big.Int {
 	return new(big.Int).SetBytes(z[:])
 }
 
+// SetCanonicalBytes sets x to the big-endian integer b, which must be at most Size bytes long.
+// Returns 1 if the value is less than p and 0 otherwise, in constant time.
+func (x *Elt) SetCanonicalBytes(b []byte) uint {
+	// Copy bytes into field element.
+	i := 0
+	for ; i < len(b); i++ {
+		x[i] = b[len(b)-1-i]
+	}
+	for ; i < Size; i++ {
+		x[i] = 0
+	}
+	// Compute the borrow of x - p, which is set if and only if x < p.
+	var borrow uint
+	for i := 0; i < Size; i++ {
+		borrow = ((uint(x[i]) - uint(prime[i]) - borrow) >> 8) & 1
+	}
+	// Encode into the Montgomery domain.
+	Encode(x, x)
+	return borrow
+}
+
+// SetBytesStrict sets x to the big-endian integer b, which must be 32 bytes long and
+// less than p. Otherwise an error is returned and x is unchanged.
+func (x *Elt) SetBytesStrict(b []byte) (*Elt, error) {
+	if len(b) != 32 {
+		return nil, errors.New("invalid field element encoding length")
+	}
+	var t Elt
+	if t.SetCanonicalBytes(b) != 1 {
+		return nil, errors.New("non-canonical field element encoding")
+	}
+	*x = t
+	return x, nil
+}
+
+// FillBytes sets b to the big-endian encoding of x and returns it. The slice b
+// must be at least as long as the encoding of p; any extra leading bytes are zeroed.
+func (x *Elt) FillBytes(b []byte) []byte {
+	var z Elt
+	// Decode from the Montgomery domain.
+	Decode(&z, x)
+	// Write bytes in reverse order.
+	for i := range b {
+		b[len(b)-1-i] = 0
+		if i < Size {
+			b[len(b)-1-i] = z[i]
+		}
+	}
+	return b
+}
+
 // SetInt64Raw constructs a field element from an integer.
 // This raw variant sets the value directly, bypassing any encoding/decoding steps.
 func (x *Elt) SetInt64Raw(y int64) *Elt {
@@ -99,6 +188,40 @@ func (x *Elt) SetBytesRaw(b []byte) *Elt {
 	return x
 }
 
+// wideloRaw and widehiRaw are the multipliers for the low and high halves of the input to SetBytesWideRaw.
+var wideloRaw = Elt{
+	0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xfe, 0xff, 0xff, 0xff,
+}
+var widehiRaw = Elt{
+	0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xff, 0xff, 0xfb, 0xff, 0xff, 0xff,
+	0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xfd, 0xff, 0xff, 0xff, 0x04,
+}
+
+// SetBytesWideRaw sets x to the big-endian integer b reduced modulo p, in constant
+// time. The slice b must be at most 2*Size bytes long. For uniform sampling,
+// b should be longer than the encoding of p by the number of bits of security.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *Elt) SetBytesWideRaw(b []byte) *Elt {
+	// Split into little-endian halves.
+	var w [2 * Size]byte
+	for i := range b {
+		w[i] = b[len(b)-1-i]
+	}
+	var lo, hi Elt
+	copy(lo[:], w[:Size])
+	copy(hi[:], w[Size:])
+
+	Mul(&lo, &lo, &wideloRaw)
+	Mul(&hi, &hi, &widehiRaw)
+	Add(x, &lo, &hi)
+	return x
+}
+
 // IntRaw converts to a big integer.
 // This raw variant sets the value directly, bypassing any encoding/decoding steps.
 func (x *Elt) IntRaw() *big.Int {
@@ -111,6 +234,63 @@ func (x *Elt) IntRaw() *big.Int {
 	return new(big.Int).SetBytes(z[:])
 }
 
+// SetCanonicalBytesRaw sets x to the big-endian integer b, which must be at most Size bytes long.
+// Returns 1 if the value is less than p and 0 otherwise, in constant time.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *Elt) SetCanonicalBytesRaw(b []byte) uint {
+	// Copy bytes into field element.
+	i := 0
+	for ; i < len(b); i++ {
+		x[i] = b[len(b)-1-i]
+	}
+	for ; i < Size; i++ {
+		x[i] = 0
+	}
+	// Compute the borrow of x - p, which is set if and only if x < p.
+	var borrow uint
+	for i := 0; i < Size; i++ {
+		borrow = ((uint(x[i]) - uint(prime[i]) - borrow) >> 8) & 1
+	}
+	return borrow
+}
+
+// SetBytesStrictRaw sets x to the big-endian integer b, which must be 32 bytes long and
+// less than p. Otherwise an error is returned and x is unchanged.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *Elt) SetBytesStrictRaw(b []byte) (*Elt, error) {
+	if len(b) != 32 {
+		return nil, errors.New("invalid field element encoding length")
+	}
+	var t Elt
+	if t.SetCanonicalBytesRaw(b) != 1 {
+		return nil, errors.New("non-canonical field element encoding")
+	}
+	*x = t
+	return x, nil
+}
+
+// FillBytesRaw sets b to the big-endian encoding of x and returns it. The slice b
+// must be at least as long as the encoding of p; any extra leading bytes are zeroed.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *Elt) FillBytesRaw(b []byte) []byte {
+	z := *x
+	// Write bytes in reverse order.
+	for i := range b {
+		b[len(b)-1-i] = 0
+		if i < Size {
+			b[len(b)-1-i] = z[i]
+		}
+	}
+	return b
+}
+
+// IsCanonical returns 1 if the big-endian integer b, which must be at most Size bytes
+// long, is less than p and 0 otherwise, in constant time.
+func IsCanonical(b []byte) uint {
+	var x Elt
+	return x.SetCanonicalBytesRaw(b)
+}
+
 // one is the field element 1.
 var one = Elt{0x1}
 
@@ -132,6 +312,78 @@ func Encode(z *Elt, x *Elt) {
 	Mul(z, x, &r2)
 }
 
+// hasADX reports whether the processor supports the BMI2 and ADX instructions used by the fastest multiplication code. Otherwise baseline implementations are used.
+var hasADX = cpu.X86.HasBMI2 && cpu.X86.HasADX
+
+// Mul computes z = x*y (mod p).
+func Mul(z *Elt, x *Elt, y *Elt) {
+	if hasADX {
+		mulADX(z, x, y)
+	} else {
+		mulBaseline(z, x, y)
+	}
+}
+
+// Sqr computes z = x² (mod p).
+func Sqr(z *Elt, x *Elt) {
+	if hasADX {
+		sqrADX(z, x)
+	} else {
+		sqrBaseline(z, x)
+	}
+}
+
+// AddVec computes z[i] = x[i] + y[i] (mod p) for each i.
+// The slices must have the same length. The output may be identical to an
+// input, but must not otherwise overlap it.
+func AddVec(z, x, y []Elt) {
+	if len(x) != len(z) || len(y) != len(z) {
+		panic("AddVec: slice lengths differ")
+	}
+	if len(z) == 0 {
+		return
+	}
+	if hasADX {
+		addvecADX(z, x, y)
+	} else {
+		addvecBaseline(z, x, y)
+	}
+}
+
+// MulVec computes z[i] = x[i]*y[i] (mod p) for each i.
+// The slices must have the same length. The output may be identical to an
+// input, but must not otherwise overlap it.
+func MulVec(z, x, y []Elt) {
+	if len(x) != len(z) || len(y) != len(z) {
+		panic("MulVec: slice lengths differ")
+	}
+	if len(z) == 0 {
+		return
+	}
+	if hasADX {
+		mulvecADX(z, x, y)
+	} else {
+		mulvecBaseline(z, x, y)
+	}
+}
+
+// SqrVec computes z[i] = x[i]² (mod p) for each i.
+// The slices must have the same length. The output may be identical to an
+// input, but must not otherwise overlap it.
+func SqrVec(z, x []Elt) {
+	if len(x) != len(z) {
+		panic("SqrVec: slice lengths differ")
+	}
+	if len(z) == 0 {
+		return
+	}
+	if hasADX {
+		sqrvecADX(z, x)
+	} else {
+		sqrvecBaseline(z, x)
+	}
+}
+
 // Neg computes z = -x (mod p).
 func Neg(z *Elt, x *Elt) {
 	Sub(z, &prime, x)
@@ -139,7 +391,7 @@ func Neg(z *Elt, x *Elt) {
 
 // Inv computes z = 1/x (mod p).
 func Inv(z *Elt, x *Elt) {
-	// Inversion computation is derived from the addition chain:
+	// Exponentiation is derived from the addition chain:
 	//
 	// _10     = 2*1
 	// _11     = 1 + _10
@@ -161,6 +413,10 @@ func Inv(z *Elt, x *Elt) {
 	// Allocate 2 temporaries.
 	var t [2]Elt
 
+	// Copy the input, since z and x may alias.
+	xc := *x
+	x = &xc
+
 	// Step 1: z = x^0x2.
 	Sqr(z, x)
 
@@ -254,3 +510,170 @@ func Inv(z *Elt, x *Elt) {
 	// Step 267: z = x^0xffffffff00000001000000000000000000000000fffffffffffffffffffffffd.
 	Mul(z, x, z)
 }
+
+// batchone is the field element 1, encoded, for use by BatchInv.
+var batchone = new(Elt).SetInt64(1)
+
+// BatchInv computes z[i] = 1/x[i] (mod p) for each i, using a single inversion.
+// As with Inv, the inverse of zero is zero. The slices must have the same
+// length, and z may be x. A temporary slice of len(x) elements is allocated to
+// hold intermediate products.
+func BatchInv(z, x []Elt) {
+	if len(x) != len(z) {
+		panic("BatchInv: slice lengths differ")
+	}
+	if len(z) == 0 {
+		return
+	}
+	var zero Elt
+
+	// Compute prefix products t[i] = x[0] * ... * x[i-1].
+	t := make([]Elt, len(x))
+	acc := *batchone
+	for i := range x {
+		xi := x[i]
+		CMov(&xi, batchone, IsZero(&xi))
+		t[i] = acc
+		Mul(&acc, &acc, &xi)
+	}
+
+	// Invert the product, then peel off one element at a time.
+	var inv Elt
+	Inv(&inv, &acc)
+	for i := len(x) - 1; i >= 0; i-- {
+		xi := x[i]
+		iszero := IsZero(&xi)
+		CMov(&xi, batchone, iszero)
+		var zi Elt
+		Mul(&zi, &inv, &t[i])
+		Mul(&inv, &inv, &xi)
+		CMov(&zi, &zero, iszero)
+		z[i] = zi
+	}
+}
+
+// IsZero returns 1 if x is zero and 0 otherwise, in constant time.
+func IsZero(x *Elt) uint {
+	var zero Elt
+	return Equal(x, &zero)
+}
+
+// sqrtexp computes z = x^e (mod p) for the exponent e required by Sqrt.
+func sqrtexp(z *Elt, x *Elt) {
+	// Exponentiation is derived from the addition chain:
+	//
+	// _10       = 2*1
+	// _11       = 1 + _10
+	// _1100     = _11 << 2
+	// _1111     = _11 + _1100
+	// _11110000 = _1111 << 4
+	// _11111111 = _1111 + _11110000
+	// x16       = _11111111 << 8 + _11111111
+	// x32       = x16 << 16 + x16
+	// return      ((x32 << 32 + 1) << 96 + 1) << 94
+	//
+	// Operations: 253 squares 7 multiplies
+
+	// Allocate 1 temporaries.
+	var t [1]Elt
+
+	// Copy the input, since z and x may alias.
+	xc := *x
+	x = &xc
+
+	// Step 1: z = x^0x2.
+	Sqr(z, x)
+
+	// Step 2: z = x^0x3.
+	Mul(z, x, z)
+
+	// Step 4: &t[0] = x^0xc.
+	Sqr(&t[0], z)
+	for s := 1; s < 2; s++ {
+		Sqr(&t[0], &t[0])
+	}
+
+	// Step 5: z = x^0xf.
+	Mul(z, z, &t[0])
+
+	// Step 9: &t[0] = x^0xf0.
+	Sqr(&t[0], z)
+	for s := 1; s < 4; s++ {
+		Sqr(&t[0], &t[0])
+	}
+
+	// Step 10: z = x^0xff.
+	Mul(z, z, &t[0])
+
+	// Step 18: &t[0] = x^0xff00.
+	Sqr(&t[0], z)
+	for s := 1; s < 8; s++ {
+		Sqr(&t[0], &t[0])
+	}
+
+	// Step 19: z = x^0xffff.
+	Mul(z, z, &t[0])
+
+	// Step 35: &t[0] = x^0xffff0000.
+	Sqr(&t[0], z)
+	for s := 1; s < 16; s++ {
+		Sqr(&t[0], &t[0])
+	}
+
+	// Step 36: z = x^0xffffffff.
+	Mul(z, z, &t[0])
+
+	// Step 68: z = x^0xffffffff00000000.
+	for s := 0; s < 32; s++ {
+		Sqr(z, z)
+	}
+
+	// Step 69: z = x^0xffffffff00000001.
+	Mul(z, x, z)
+
+	// Step 165: z = x^0xffffffff00000001000000000000000000000000.
+	for s := 0; s < 96; s++ {
+		Sqr(z, z)
+	}
+
+	// Step 166: z = x^0xffffffff00000001000000000000000000000001.
+	Mul(z, x, z)
+
+	// Step 260: z = x^0x3fffffffc0000000400000000000000000000000400000000000000000000000.
+	for s := 0; s < 94; s++ {
+		Sqr(z, z)
+	}
+}
+
+// sqrtone is the field element 1.
+var sqrtone = Elt{
+	0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xfe, 0xff, 0xff, 0xff,
+}
+
+// Sqrt computes z = √x (mod p), returning 1 if x is a square and 0
+// otherwise. If x is not a square the value of z is undefined.
+func Sqrt(z, x *Elt) uint {
+	var r, r2 Elt
+	// Since p ≡ 3 (mod 4), r = x^((p+1)/4) is a root if one exists.
+	sqrtexp(&r, x)
+	// Check the candidate root.
+	Sqr(&r2, &r)
+	ok := Equal(&r2, x)
+	*z = r
+	return ok
+}
+
+// IsSquare returns 1 if x is a square (including zero) and 0 otherwise.
+func IsSquare(x *Elt) uint {
+	var z Elt
+	return Sqrt(&z, x)
+}
+
+// Legendre returns the Legendre symbol of x: 0 if x is zero, 1 if x is a
+// non-zero square and -1 otherwise.
+func Legendre(x *Elt) int {
+	return int(2*IsSquare(x)) - 1 - int(IsZero(x))
+}
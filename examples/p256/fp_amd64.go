@@ -5,14 +5,17 @@ package p256
 //go:noescape
 func CMov(y *Elt, x *Elt, c uint)
 
+// Select sets z to x if c is 1 and y if c is 0, in constant time. The output
+// may alias either input.
 //go:noescape
-func Add(z *Elt, x *Elt, y *Elt)
+func Select(z *Elt, x *Elt, y *Elt, c uint)
 
+// Equal returns 1 if x and y are equal and 0 otherwise, in constant time.
 //go:noescape
-func Sub(z *Elt, x *Elt, y *Elt)
+func Equal(x *Elt, y *Elt) uint
 
 //go:noescape
-func Mul(z *Elt, x *Elt, y *Elt)
+func Add(z *Elt, x *Elt, y *Elt)
 
 //go:noescape
-func Sqr(z *Elt, x *Elt)
+func Sub(z *Elt, x *Elt, y *Elt)
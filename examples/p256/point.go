@@ -47,6 +47,26 @@ func (a *Affine) Projective() (p *Projective) {
 	return
 }
 
+func (a *Affine) IsOnCurve() (ok uint) {
+	var (
+		lhs Elt
+		rhs Elt
+		t0  Elt
+		t1  Elt
+		t2  Elt
+	)
+
+	Sqr(&lhs, &a.Y)
+	Sqr(&t1, &a.X)
+	Mul(&t1, &t1, &a.X)
+	Add(&t2, &a.X, &a.X)
+	Add(&t2, &t2, &a.X)
+	Sub(&t0, &t1, &t2)
+	Add(&rhs, &t0, b)
+	ok = Equal(&lhs, &rhs)
+	return
+}
+
 type Jacobian struct {
 	X Elt
 	Y Elt
@@ -110,11 +130,19 @@ func (p *Jacobian) CNeg(c uint) {
 }
 
 func (p *Jacobian) Add(q *Jacobian, r *Jacobian) {
-	add(&q.X, &r.X, &p.X, &q.Y, &r.Y, &p.Y, &q.Z, &r.Z, &p.Z)
+	if hasADX {
+		addADX(&q.X, &r.X, &p.X, &q.Y, &r.Y, &p.Y, &q.Z, &r.Z, &p.Z)
+	} else {
+		addBaseline(&q.X, &r.X, &p.X, &q.Y, &r.Y, &p.Y, &q.Z, &r.Z, &p.Z)
+	}
 }
 
 func (p *Jacobian) Double(q *Jacobian) {
-	double(&q.X, &p.X, &q.Y, &p.Y, &q.Z, &p.Z)
+	if hasADX {
+		doubleADX(&q.X, &p.X, &q.Y, &p.Y, &q.Z, &p.Z)
+	} else {
+		doubleBaseline(&q.X, &p.X, &q.Y, &p.Y, &q.Z, &p.Z)
+	}
 }
 
 type Projective struct {
@@ -158,5 +186,9 @@ func (p *Projective) CNeg(c uint) {
 }
 
 func (p *Projective) CompleteAdd(q *Projective, r *Projective) {
-	completeadd(&q.X, &r.X, &p.X, &q.Y, &r.Y, &p.Y, &q.Z, &r.Z, &p.Z, b)
+	if hasADX {
+		completeaddADX(&q.X, &r.X, &p.X, &q.Y, &r.Y, &p.Y, &q.Z, &r.Z, &p.Z, b)
+	} else {
+		completeaddBaseline(&q.X, &r.X, &p.X, &q.Y, &r.Y, &p.Y, &q.Z, &r.Z, &p.Z, b)
+	}
 }
@@ -2,7 +2,12 @@
 
 package p256
 
-import "math/big"
+import (
+	"errors"
+	"math/big"
+
+	"golang.org/x/sys/cpu"
+)
 
 // scalarsize is the size of a field element in bytes.
 const scalarsize = 32
@@ -53,6 +58,39 @@ func (x *scalar) SetBytes(b []byte) *scalar {
 	return x
 }
 
+// scalarwidelo and scalarwidehi are the multipliers for the low and high halves of the input to SetBytesWide.
+var scalarwidelo = scalar{
+	0xa2, 0xee, 0x79, 0xbe, 0x95, 0x4c, 0x24, 0x83,
+	0xa6, 0x6f, 0xbd, 0x49, 0x9c, 0x79, 0x99, 0x46,
+	0x59, 0xec, 0x6b, 0x2b, 0x39, 0xb2, 0x45, 0x28,
+	0x20, 0x56, 0xd9, 0xf3, 0x94, 0x2d, 0xe1, 0x66,
+}
+var scalarwidehi = scalar{
+	0x24, 0xa6, 0x65, 0x0b, 0xc9, 0xbe, 0x8e, 0xac,
+	0xc9, 0x55, 0x05, 0x0c, 0xae, 0x28, 0x1f, 0x11,
+	0x3f, 0xe9, 0xa5, 0x6b, 0x24, 0xb9, 0x43, 0x25,
+	0x65, 0xbe, 0x07, 0x64, 0xe7, 0x54, 0x3a, 0x50,
+}
+
+// SetBytesWide sets x to the big-endian integer b reduced modulo p, in constant
+// time. The slice b must be at most 2*scalarsize bytes long. For uniform sampling,
+// b should be longer than the encoding of p by the number of bits of security.
+func (x *scalar) SetBytesWide(b []byte) *scalar {
+	// Split into little-endian halves.
+	var w [2 * scalarsize]byte
+	for i := range b {
+		w[i] = b[len(b)-1-i]
+	}
+	var lo, hi scalar
+	copy(lo[:], w[:scalarsize])
+	copy(hi[:], w[scalarsize:])
+
+	scalarmul(&lo, &lo, &scalarwidelo)
+	scalarmul(&hi, &hi, &scalarwidehi)
+	scalaradd(x, &lo, &hi)
+	return x
+}
+
 // Int converts to a big integer.
 func (x *scalar) Int() *big.Int {
 	var z scalar
@@ -66,6 +104,57 @@ func (x *scalar) Int() *big.Int {
 	return new(big.Int).SetBytes(z[:])
 }
 
+// SetCanonicalBytes sets x to the big-endian integer b, which must be at most scalarsize bytes long.
+// Returns 1 if the value is less than p and 0 otherwise, in constant time.
+func (x *scalar) SetCanonicalBytes(b []byte) uint {
+	// Copy bytes into field element.
+	i := 0
+	for ; i < len(b); i++ {
+		x[i] = b[len(b)-1-i]
+	}
+	for ; i < scalarsize; i++ {
+		x[i] = 0
+	}
+	// Compute the borrow of x - p, which is set if and only if x < p.
+	var borrow uint
+	for i := 0; i < scalarsize; i++ {
+		borrow = ((uint(x[i]) - uint(scalarprime[i]) - borrow) >> 8) & 1
+	}
+	// Encode into the Montgomery domain.
+	scalarencode(x, x)
+	return borrow
+}
+
+// SetBytesStrict sets x to the big-endian integer b, which must be 32 bytes long and
+// less than p. Otherwise an error is returned and x is unchanged.
+func (x *scalar) SetBytesStrict(b []byte) (*scalar, error) {
+	if len(b) != 32 {
+		return nil, errors.New("invalid field element encoding length")
+	}
+	var t scalar
+	if t.SetCanonicalBytes(b) != 1 {
+		return nil, errors.New("non-canonical field element encoding")
+	}
+	*x = t
+	return x, nil
+}
+
+// FillBytes sets b to the big-endian encoding of x and returns it. The slice b
+// must be at least as long as the encoding of p; any extra leading bytes are zeroed.
+func (x *scalar) FillBytes(b []byte) []byte {
+	var z scalar
+	// Decode from the Montgomery domain.
+	scalardecode(&z, x)
+	// Write bytes in reverse order.
+	for i := range b {
+		b[len(b)-1-i] = 0
+		if i < scalarsize {
+			b[len(b)-1-i] = z[i]
+		}
+	}
+	return b
+}
+
 // SetInt64Raw constructs a field element from an integer.
 // This raw variant sets the value directly, bypassing any encoding/decoding steps.
 func (x *scalar) SetInt64Raw(y int64) *scalar {
@@ -99,6 +188,40 @@ func (x *scalar) SetBytesRaw(b []byte) *scalar {
 	return x
 }
 
+// scalarwideloraw and scalarwidehiraw are the multipliers for the low and high halves of the input to SetBytesWideRaw.
+var scalarwideloraw = scalar{
+	0xaf, 0xda, 0x9c, 0x03, 0x3d, 0x35, 0x46, 0x0c,
+	0x7b, 0x61, 0xe8, 0x58, 0x52, 0x05, 0x19, 0x43,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xff, 0xff,
+}
+var scalarwidehiraw = scalar{
+	0xa2, 0xee, 0x79, 0xbe, 0x95, 0x4c, 0x24, 0x83,
+	0xa6, 0x6f, 0xbd, 0x49, 0x9c, 0x79, 0x99, 0x46,
+	0x59, 0xec, 0x6b, 0x2b, 0x39, 0xb2, 0x45, 0x28,
+	0x20, 0x56, 0xd9, 0xf3, 0x94, 0x2d, 0xe1, 0x66,
+}
+
+// SetBytesWideRaw sets x to the big-endian integer b reduced modulo p, in constant
+// time. The slice b must be at most 2*scalarsize bytes long. For uniform sampling,
+// b should be longer than the encoding of p by the number of bits of security.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *scalar) SetBytesWideRaw(b []byte) *scalar {
+	// Split into little-endian halves.
+	var w [2 * scalarsize]byte
+	for i := range b {
+		w[i] = b[len(b)-1-i]
+	}
+	var lo, hi scalar
+	copy(lo[:], w[:scalarsize])
+	copy(hi[:], w[scalarsize:])
+
+	scalarmul(&lo, &lo, &scalarwideloraw)
+	scalarmul(&hi, &hi, &scalarwidehiraw)
+	scalaradd(x, &lo, &hi)
+	return x
+}
+
 // IntRaw converts to a big integer.
 // This raw variant sets the value directly, bypassing any encoding/decoding steps.
 func (x *scalar) IntRaw() *big.Int {
@@ -111,6 +234,63 @@ func (x *scalar) IntRaw() *big.Int {
 	return new(big.Int).SetBytes(z[:])
 }
 
+// SetCanonicalBytesRaw sets x to the big-endian integer b, which must be at most scalarsize bytes long.
+// Returns 1 if the value is less than p and 0 otherwise, in constant time.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *scalar) SetCanonicalBytesRaw(b []byte) uint {
+	// Copy bytes into field element.
+	i := 0
+	for ; i < len(b); i++ {
+		x[i] = b[len(b)-1-i]
+	}
+	for ; i < scalarsize; i++ {
+		x[i] = 0
+	}
+	// Compute the borrow of x - p, which is set if and only if x < p.
+	var borrow uint
+	for i := 0; i < scalarsize; i++ {
+		borrow = ((uint(x[i]) - uint(scalarprime[i]) - borrow) >> 8) & 1
+	}
+	return borrow
+}
+
+// SetBytesStrictRaw sets x to the big-endian integer b, which must be 32 bytes long and
+// less than p. Otherwise an error is returned and x is unchanged.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *scalar) SetBytesStrictRaw(b []byte) (*scalar, error) {
+	if len(b) != 32 {
+		return nil, errors.New("invalid field element encoding length")
+	}
+	var t scalar
+	if t.SetCanonicalBytesRaw(b) != 1 {
+		return nil, errors.New("non-canonical field element encoding")
+	}
+	*x = t
+	return x, nil
+}
+
+// FillBytesRaw sets b to the big-endian encoding of x and returns it. The slice b
+// must be at least as long as the encoding of p; any extra leading bytes are zeroed.
+// This raw variant sets the value directly, bypassing any encoding/decoding steps.
+func (x *scalar) FillBytesRaw(b []byte) []byte {
+	z := *x
+	// Write bytes in reverse order.
+	for i := range b {
+		b[len(b)-1-i] = 0
+		if i < scalarsize {
+			b[len(b)-1-i] = z[i]
+		}
+	}
+	return b
+}
+
+// scalariscanonical returns 1 if the big-endian integer b, which must be at most scalarsize bytes
+// long, is less than p and 0 otherwise, in constant time.
+func scalariscanonical(b []byte) uint {
+	var x scalar
+	return x.SetCanonicalBytesRaw(b)
+}
+
 // scalarone is the field element 1.
 var scalarone = scalar{0x1}
 
@@ -132,6 +312,78 @@ func scalarencode(z *scalar, x *scalar) {
 	scalarmul(z, x, &scalarr2)
 }
 
+// scalarhasadx reports whether the processor supports the BMI2 and ADX instructions used by the fastest multiplication code. Otherwise baseline implementations are used.
+var scalarhasadx = cpu.X86.HasBMI2 && cpu.X86.HasADX
+
+// scalarmul computes z = x*y (mod p).
+func scalarmul(z *scalar, x *scalar, y *scalar) {
+	if scalarhasadx {
+		scalarmuladx(z, x, y)
+	} else {
+		scalarmulbaseline(z, x, y)
+	}
+}
+
+// scalarsqr computes z = x² (mod p).
+func scalarsqr(z *scalar, x *scalar) {
+	if scalarhasadx {
+		scalarsqradx(z, x)
+	} else {
+		scalarsqrbaseline(z, x)
+	}
+}
+
+// scalaraddvec computes z[i] = x[i] + y[i] (mod p) for each i.
+// The slices must have the same length. The output may be identical to an
+// input, but must not otherwise overlap it.
+func scalaraddvec(z, x, y []scalar) {
+	if len(x) != len(z) || len(y) != len(z) {
+		panic("scalaraddvec: slice lengths differ")
+	}
+	if len(z) == 0 {
+		return
+	}
+	if scalarhasadx {
+		scalaraddvecadx(z, x, y)
+	} else {
+		scalaraddvecbaseline(z, x, y)
+	}
+}
+
+// scalarmulvec computes z[i] = x[i]*y[i] (mod p) for each i.
+// The slices must have the same length. The output may be identical to an
+// input, but must not otherwise overlap it.
+func scalarmulvec(z, x, y []scalar) {
+	if len(x) != len(z) || len(y) != len(z) {
+		panic("scalarmulvec: slice lengths differ")
+	}
+	if len(z) == 0 {
+		return
+	}
+	if scalarhasadx {
+		scalarmulvecadx(z, x, y)
+	} else {
+		scalarmulvecbaseline(z, x, y)
+	}
+}
+
+// scalarsqrvec computes z[i] = x[i]² (mod p) for each i.
+// The slices must have the same length. The output may be identical to an
+// input, but must not otherwise overlap it.
+func scalarsqrvec(z, x []scalar) {
+	if len(x) != len(z) {
+		panic("scalarsqrvec: slice lengths differ")
+	}
+	if len(z) == 0 {
+		return
+	}
+	if scalarhasadx {
+		scalarsqrvecadx(z, x)
+	} else {
+		scalarsqrvecbaseline(z, x)
+	}
+}
+
 // scalarneg computes z = -x (mod p).
 func scalarneg(z *scalar, x *scalar) {
 	scalarsub(z, &scalarprime, x)
@@ -139,7 +391,7 @@ func scalarneg(z *scalar, x *scalar) {
 
 // scalarinv computes z = 1/x (mod p).
 func scalarinv(z *scalar, x *scalar) {
-	// Inversion computation is derived from the addition chain:
+	// Exponentiation is derived from the addition chain:
 	//
 	// _10       = 2*1
 	// _100      = 2*_10
@@ -182,6 +434,10 @@ func scalarinv(z *scalar, x *scalar) {
 	// Allocate 15 temporaries.
 	var t [15]scalar
 
+	// Copy the input, since z and x may alias.
+	xc := *x
+	x = &xc
+
 	// Step 1: &t[1] = x^0x2.
 	scalarsqr(&t[1], x)
 
@@ -427,3 +683,50 @@ func scalarinv(z *scalar, x *scalar) {
 	// Step 294: z = x^0xffffffff00000000ffffffffffffffffbce6faada7179e84f3b9cac2fc63254f.
 	scalarmul(z, z, &t[0])
 }
+
+// scalarbatchone is the field element 1, encoded, for use by scalarbatchinv.
+var scalarbatchone = new(scalar).SetInt64(1)
+
+// scalarbatchinv computes z[i] = 1/x[i] (mod p) for each i, using a single inversion.
+// As with scalarinv, the inverse of zero is zero. The slices must have the same
+// length, and z may be x. A temporary slice of len(x) elements is allocated to
+// hold intermediate products.
+func scalarbatchinv(z, x []scalar) {
+	if len(x) != len(z) {
+		panic("scalarbatchinv: slice lengths differ")
+	}
+	if len(z) == 0 {
+		return
+	}
+	var zero scalar
+
+	// Compute prefix products t[i] = x[0] * ... * x[i-1].
+	t := make([]scalar, len(x))
+	acc := *scalarbatchone
+	for i := range x {
+		xi := x[i]
+		scalarcmov(&xi, scalarbatchone, scalariszero(&xi))
+		t[i] = acc
+		scalarmul(&acc, &acc, &xi)
+	}
+
+	// Invert the product, then peel off one element at a time.
+	var inv scalar
+	scalarinv(&inv, &acc)
+	for i := len(x) - 1; i >= 0; i-- {
+		xi := x[i]
+		iszero := scalariszero(&xi)
+		scalarcmov(&xi, scalarbatchone, iszero)
+		var zi scalar
+		scalarmul(&zi, &inv, &t[i])
+		scalarmul(&inv, &inv, &xi)
+		scalarcmov(&zi, &zero, iszero)
+		z[i] = zi
+	}
+}
+
+// scalariszero returns 1 if x is zero and 0 otherwise, in constant time.
+func scalariszero(x *scalar) uint {
+	var zero scalar
+	return scalarequal(x, &zero)
+}
@@ -5,14 +5,17 @@ package p256
 //go:noescape
 func scalarcmov(y *scalar, x *scalar, c uint)
 
+// scalarselect sets z to x if c is 1 and y if c is 0, in constant time. The output
+// may alias either input.
 //go:noescape
-func scalaradd(z *scalar, x *scalar, y *scalar)
+func scalarselect(z *scalar, x *scalar, y *scalar, c uint)
 
+// scalarequal returns 1 if x and y are equal and 0 otherwise, in constant time.
 //go:noescape
-func scalarsub(z *scalar, x *scalar, y *scalar)
+func scalarequal(x *scalar, y *scalar) uint
 
 //go:noescape
-func scalarmul(z *scalar, x *scalar, y *scalar)
+func scalaradd(z *scalar, x *scalar, y *scalar)
 
 //go:noescape
-func scalarsqr(z *scalar, x *scalar)
+func scalarsub(z *scalar, x *scalar, y *scalar)
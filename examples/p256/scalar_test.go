@@ -125,3 +125,25 @@ func TestScalarMulEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestScalarSetBytesWide(t *testing.T) {
+	for _, b := range WideInputs() {
+		var x scalar
+		x.SetBytesWide(b)
+
+		expect := new(big.Int).SetBytes(b)
+		expect.Mod(expect, scalarp)
+
+		if got := x.Int(); got.Cmp(expect) != 0 {
+			t.Fatalf("SetBytesWide(%x) = %x; expect %x", b, got, expect)
+		}
+	}
+}
+
+func TestScalarISAs(t *testing.T) {
+	ForEachISA(t, func(t *testing.T) {
+		t.Run("Mul", TestScalarMul)
+		t.Run("MulEdgeCases", TestScalarMulEdgeCases)
+		t.Run("SetBytesWide", TestScalarSetBytesWide)
+	})
+}
@@ -3,11 +3,136 @@
 package p256
 
 import (
+	"bytes"
+	"crypto/elliptic"
 	"crypto/rand"
+	"encoding/hex"
 	"math/big"
 	"testing"
 )
 
+// ref is a reference implementation of the curve.
+var ref = refcurve{p256.CurveParams}
+
+// refa is the curve parameter a.
+var refa = big.NewInt(-3)
+
+// refcurve is a math/big implementation of the curve, independent of the
+// generated code. Curves with a = -3 use the generic implementation in
+// crypto/elliptic, and others affine formulae.
+type refcurve struct {
+	*elliptic.CurveParams
+}
+
+// generic reports whether the crypto/elliptic implementation applies.
+func (refcurve) generic() bool {
+	return refa.Cmp(big.NewInt(-3)) == 0
+}
+
+func (c refcurve) Params() *elliptic.CurveParams {
+	return c.CurveParams
+}
+
+// polynomial returns x³ + ax + b modulo p.
+func (c refcurve) polynomial(x *big.Int) *big.Int {
+	y2 := new(big.Int).Mul(x, x)
+	y2.Add(y2, refa)
+	y2.Mul(y2, x)
+	y2.Add(y2, c.B)
+	return y2.Mod(y2, c.P)
+}
+
+func (c refcurve) IsOnCurve(x, y *big.Int) bool {
+	if c.generic() {
+		return c.CurveParams.IsOnCurve(x, y)
+	}
+	if x.Sign() < 0 || x.Cmp(c.P) >= 0 || y.Sign() < 0 || y.Cmp(c.P) >= 0 {
+		return false
+	}
+	y2 := new(big.Int).Mul(y, y)
+	return y2.Mod(y2, c.P).Cmp(c.polynomial(x)) == 0
+}
+
+func (c refcurve) Add(x1, y1, x2, y2 *big.Int) (x, y *big.Int) {
+	if c.generic() {
+		return c.CurveParams.Add(x1, y1, x2, y2)
+	}
+	switch {
+	case x1.Sign() == 0 && y1.Sign() == 0:
+		return new(big.Int).Set(x2), new(big.Int).Set(y2)
+	case x2.Sign() == 0 && y2.Sign() == 0:
+		return new(big.Int).Set(x1), new(big.Int).Set(y1)
+	case x1.Cmp(x2) == 0 && y1.Cmp(y2) == 0:
+		return c.Double(x1, y1)
+	case x1.Cmp(x2) == 0:
+		return new(big.Int), new(big.Int)
+	}
+
+	// λ = (y₂ - y₁) / (x₂ - x₁)
+	l := new(big.Int).Sub(x2, x1)
+	l.ModInverse(l.Mod(l, c.P), c.P)
+	l.Mul(l, new(big.Int).Sub(y2, y1))
+
+	return c.line(l, x1, y1, x2)
+}
+
+func (c refcurve) Double(x1, y1 *big.Int) (x, y *big.Int) {
+	if c.generic() {
+		return c.CurveParams.Double(x1, y1)
+	}
+	if y1.Sign() == 0 {
+		return new(big.Int), new(big.Int)
+	}
+
+	// λ = (3x₁² + a) / 2y₁
+	l := new(big.Int).Lsh(y1, 1)
+	l.ModInverse(l.Mod(l, c.P), c.P)
+	t := new(big.Int).Mul(x1, x1)
+	t.Mul(t, big.NewInt(3))
+	t.Add(t, refa)
+	l.Mul(l, t)
+
+	return c.line(l, x1, y1, x1)
+}
+
+// line returns the negated third intersection of the curve with the line of
+// slope l through (x1,y1) and a point with x-coordinate x2.
+func (c refcurve) line(l, x1, y1, x2 *big.Int) (x, y *big.Int) {
+	l.Mod(l, c.P)
+
+	x = new(big.Int).Mul(l, l)
+	x.Sub(x, x1)
+	x.Sub(x, x2)
+	x.Mod(x, c.P)
+
+	y = new(big.Int).Sub(x1, x)
+	y.Mul(y, l)
+	y.Sub(y, y1)
+	y.Mod(y, c.P)
+
+	return x, y
+}
+
+func (c refcurve) ScalarMult(x1, y1 *big.Int, k []byte) (x, y *big.Int) {
+	if c.generic() {
+		return c.CurveParams.ScalarMult(x1, y1, k)
+	}
+	x, y = new(big.Int), new(big.Int)
+	for _, b := range k {
+		for i := 7; i >= 0; i-- {
+			x, y = c.Double(x, y)
+			if (b>>uint(i))&1 == 1 {
+				x, y = c.Add(x, y, x1, y1)
+			}
+		}
+	}
+	return x, y
+}
+
+func (c refcurve) ScalarBaseMult(k []byte) (x, y *big.Int) {
+	return c.ScalarMult(c.Gx, c.Gy, k)
+}
+
 func RandScalarNonZero(tb testing.TB) *big.Int {
 	tb.Helper()
 	N := p256.Params().N
@@ -36,7 +161,7 @@ func RandOddScalar(tb testing.TB) *big.Int {
 func RandPoint(tb testing.TB) (x, y *big.Int) {
 	tb.Helper()
 	k := RandScalarNonZero(tb)
-	return p256.Params().ScalarBaseMult(k.Bytes())
+	return ref.ScalarBaseMult(k.Bytes())
 }
 
 func EqualInt(t *testing.T, name string, expect, got *big.Int) {
@@ -47,3 +172,134 @@ func EqualInt(t *testing.T, name string, expect, got *big.Int) {
 		t.Fatalf("%s: not equal", name)
 	}
 }
+
+func ScalarBytes(k *big.Int) []byte {
+	return k.FillBytes(make([]byte, ScalarSize))
+}
+
+func MarshalPoint(tb testing.TB, x, y *big.Int) *Point {
+	tb.Helper()
+	p, err := new(Point).SetBytes(elliptic.Marshal(ref, x, y))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return p
+}
+
+func EqualPoint(t *testing.T, ex, ey *big.Int, p *Point) {
+	t.Helper()
+	expect := []byte{0}
+	if ex.Sign() != 0 || ey.Sign() != 0 {
+		expect = elliptic.Marshal(ref, ex, ey)
+	}
+	if got := p.Bytes(); !bytes.Equal(got, expect) {
+		t.Logf("   got %x", got)
+		t.Logf("expect %x", expect)
+		t.Fatal("points not equal")
+	}
+}
+
+// NonSquareCompressed returns a compressed encoding with an x-coordinate that
+// does not correspond to any point on the curve.
+func NonSquareCompressed(tb testing.TB) []byte {
+	tb.Helper()
+	P := p256.Params().P
+	for {
+		x, err := rand.Int(rand.Reader, P)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		if new(big.Int).ModSqrt(ref.polynomial(x), P) == nil {
+			return append([]byte{2}, x.FillBytes(make([]byte, fieldsize))...)
+		}
+	}
+}
+
+// RandInvalidPoint returns random coordinates that do not lie on the curve.
+func RandInvalidPoint(tb testing.TB) (x, y *big.Int) {
+	tb.Helper()
+	P := p256.Params().P
+	for {
+		x, err := rand.Int(rand.Reader, P)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		y, err := rand.Int(rand.Reader, P)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		if !ref.IsOnCurve(x, y) {
+			return x, y
+		}
+	}
+}
+
+// SmallPoint returns a point with the smallest possible x-coordinate, such that
+// x + p fits in an encoded field element.
+func SmallPoint(tb testing.TB) (x, y *big.Int) {
+	tb.Helper()
+	P := ref.P
+	for x := big.NewInt(0); ; x.Add(x, big.NewInt(1)) {
+		if y := new(big.Int).ModSqrt(ref.polynomial(x), P); y != nil {
+			if new(big.Int).Add(x, P).BitLen() > 8*fieldsize {
+				tb.Fatal("no small point")
+			}
+			return x, y
+		}
+	}
+}
+
+// UncompressedEncoding builds an uncompressed point encoding without
+// validation. Coordinates larger than the field size are truncated.
+func UncompressedEncoding(x, y *big.Int) []byte {
+	b := make([]byte, UncompressedSize)
+	b[0] = 4
+	copy(b[1:1+fieldsize], pad(x))
+	copy(b[1+fieldsize:], pad(y))
+	return b
+}
+
+func pad(x *big.Int) []byte {
+	b := x.Bytes()
+	if len(b) > fieldsize {
+		return b[len(b)-fieldsize:]
+	}
+	return append(make([]byte, fieldsize-len(b)), b...)
+}
+
+func RandDigest(tb testing.TB) []byte {
+	tb.Helper()
+	digest := make([]byte, 32)
+	if _, err := rand.Read(digest); err != nil {
+		tb.Fatal(err)
+	}
+	return digest
+}
+
+func DecodeHex(tb testing.TB, s string) []byte {
+	tb.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return b
+}
+
+func RandFieldInt(tb testing.TB) *big.Int {
+	tb.Helper()
+	x, err := rand.Int(rand.Reader, p256.Params().P)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return x
+}
+
+// ParseHexInt parses a hex integer with 0x prefix.
+func ParseHexInt(tb testing.TB, s string) *big.Int {
+	tb.Helper()
+	x, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		tb.Fatalf("invalid integer %q", s)
+	}
+	return x
+}
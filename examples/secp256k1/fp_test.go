@@ -0,0 +1,925 @@
+package secp256k1
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/mmcloughlin/ec3/internal/bigint"
+)
+
+func NumTrials() int {
+	if testing.Short() {
+		return 1 << 8
+	}
+	return 1 << 16
+}
+
+func R() *big.Int {
+	r := bigint.Pow2(256)
+	r.Mod(r, p)
+	return r
+}
+
+func RandElt() Elt {
+	var r Elt
+	rand.Read(r[:])
+
+	x := IntFromBytesLittleEndian(r[:])
+	x.Mul(x, R())
+	x.Mod(x, p)
+
+	BytesFromIntLittleEndian(r[:], x)
+	return r
+}
+
+func RInv() *big.Int {
+	rinv := R()
+	rinv.ModInverse(rinv, p)
+	return rinv
+}
+
+func ExpectAdd(x, y Elt) Elt {
+	xi := IntFromBytesLittleEndian(x[:])
+	yi := IntFromBytesLittleEndian(y[:])
+	zi := new(big.Int).Add(xi, yi)
+	zi.Mod(zi, p)
+	var z Elt
+	BytesFromIntLittleEndian(z[:], zi)
+	return z
+}
+
+func ExpectSub(x, y Elt) Elt {
+	xi := IntFromBytesLittleEndian(x[:])
+	yi := IntFromBytesLittleEndian(y[:])
+	zi := new(big.Int).Sub(xi, yi)
+	zi.Mod(zi, p)
+	var z Elt
+	BytesFromIntLittleEndian(z[:], zi)
+	return z
+}
+
+func ExpectNeg(x Elt) Elt {
+	xi := IntFromBytesLittleEndian(x[:])
+	zi := new(big.Int).Neg(xi)
+	zi.Mod(zi, p)
+	var z Elt
+	BytesFromIntLittleEndian(z[:], zi)
+	return z
+}
+
+func ExpectMul(x, y Elt) Elt {
+	xi := IntFromBytesLittleEndian(x[:])
+	yi := IntFromBytesLittleEndian(y[:])
+
+	zi := new(big.Int).Mul(xi, yi)
+	zi.Mul(zi, RInv())
+	zi.Mod(zi, p)
+
+	var z Elt
+	BytesFromIntLittleEndian(z[:], zi)
+	return z
+}
+
+func ExpectEncode(x Elt) Elt {
+	xi := IntFromBytesLittleEndian(x[:])
+
+	zi := new(big.Int).Mul(xi, R())
+	zi.Mod(zi, p)
+
+	var z Elt
+	BytesFromIntLittleEndian(z[:], zi)
+	return z
+}
+
+func ExpectDecode(x Elt) Elt {
+	xi := IntFromBytesLittleEndian(x[:])
+
+	zi := new(big.Int).Mul(xi, RInv())
+	zi.Mod(zi, p)
+
+	var z Elt
+	BytesFromIntLittleEndian(z[:], zi)
+	return z
+}
+
+func TestCMov(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		var x, y Elt
+		rand.Read(x[:])
+		rand.Read(y[:])
+
+		// c ≡ 0: expect no move.
+		expect := y
+		CMov(&y, &x, 0)
+		if y != expect {
+			t.FailNow()
+		}
+
+		// c ≡ 1: expect no move.
+		expect = x
+		CMov(&y, &x, 1)
+		if y != expect {
+			t.FailNow()
+		}
+	}
+}
+
+func TestSelect(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		x, y := RandElt(), RandElt()
+
+		var z Elt
+		Select(&z, &x, &y, 1)
+		if z != x {
+			t.Fatal("c = 1: expected x")
+		}
+
+		Select(&z, &x, &y, 0)
+		if z != y {
+			t.Fatal("c = 0: expected y")
+		}
+
+		// Output aliased with inputs.
+		z = x
+		Select(&z, &z, &y, 0)
+		if z != y {
+			t.Fatal("z = x: expected y")
+		}
+
+		z = y
+		Select(&z, &x, &z, 1)
+		if z != x {
+			t.Fatal("z = y: expected x")
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		x, y := RandElt(), RandElt()
+
+		// Differ in a single random byte.
+		z := x
+		z[rand.Intn(Size)] ^= byte(1 + rand.Intn(255))
+
+		for _, w := range []Elt{x, y, z} {
+			expect := uint(0)
+			if IntFromBytesLittleEndian(x[:]).Cmp(IntFromBytesLittleEndian(w[:])) == 0 {
+				expect = 1
+			}
+			if got := Equal(&x, &w); got != expect {
+				t.Fatalf("Equal(%x, %x) = %d; expect %d", x, w, got, expect)
+			}
+		}
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	var zero Elt
+	if IsZero(&zero) != 1 {
+		t.Fatal("IsZero(0) != 1")
+	}
+
+	for i := 0; i < Size; i++ {
+		for bit := 0; bit < 8; bit++ {
+			var x Elt
+			x[i] = 1 << bit
+			if IsZero(&x) != 0 {
+				t.Fatalf("IsZero(%x) != 0", x)
+			}
+		}
+	}
+
+	for trial := 0; trial < NumTrials(); trial++ {
+		x := RandElt()
+		expect := uint(0)
+		if IntFromBytesLittleEndian(x[:]).Sign() == 0 {
+			expect = 1
+		}
+		if got := IsZero(&x); got != expect {
+			t.Fatalf("IsZero(%x) = %d; expect %d", x, got, expect)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		var x, got Elt
+		rand.Read(x[:])
+
+		Encode(&got, &x)
+		expect := ExpectEncode(x)
+
+		if got != expect {
+			t.Logf("     x = %x", x)
+			t.Logf("   got = %x", got)
+			t.Logf("expect = %x", expect)
+			t.FailNow()
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		var x, got Elt
+		rand.Read(x[:])
+
+		Decode(&got, &x)
+		expect := ExpectDecode(x)
+
+		if got != expect {
+			t.Logf("     x = %x", x)
+			t.Logf("   got = %x", got)
+			t.Logf("expect = %x", expect)
+			t.FailNow()
+		}
+	}
+}
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		var x, m, got Elt
+		rand.Read(x[:])
+		Encode(&m, &x)
+		Decode(&got, &m)
+		if got != x {
+			t.Fatal("failed roundtrip")
+		}
+	}
+}
+
+func TestAdd(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		var x, y, got Elt
+		rand.Read(x[:])
+		rand.Read(y[:])
+
+		px, py := x, y
+		Add(&got, &x, &y)
+		expect := ExpectAdd(x, y)
+
+		if px != x || py != y {
+			t.Fatal("changed inputs")
+		}
+
+		if got != expect {
+			t.Logf("     x = %x", x)
+			t.Logf("     y = %x", y)
+			t.Logf("   got = %x", got)
+			t.Logf("expect = %x", expect)
+			t.FailNow()
+		}
+	}
+}
+
+func TestSub(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		var x, y, got Elt
+		rand.Read(x[:])
+		rand.Read(y[:])
+
+		px, py := x, y
+		Sub(&got, &x, &y)
+		expect := ExpectSub(x, y)
+
+		if px != x || py != y {
+			t.Fatal("changed inputs")
+		}
+
+		if got != expect {
+			t.Logf("     x = %x", x)
+			t.Logf("     y = %x", y)
+			t.Logf("   got = %x", got)
+			t.Logf("expect = %x", expect)
+			t.FailNow()
+		}
+	}
+}
+
+func TestNeg(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		var x, got Elt
+		rand.Read(x[:])
+
+		Neg(&got, &x)
+		expect := ExpectNeg(x)
+
+		if got != expect {
+			t.Logf("     x = %x", x)
+			t.Logf("   got = %x", got)
+			t.Logf("expect = %x", expect)
+			t.FailNow()
+		}
+	}
+}
+
+func TestMul(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		x := RandElt()
+		y := RandElt()
+		px, py := x, y
+
+		var got Elt
+		Mul(&got, &x, &y)
+
+		expect := ExpectMul(x, y)
+
+		if px != x || py != y {
+			t.Fatal("changed inputs")
+		}
+
+		if got != expect {
+			t.Logf("     x = %x", x)
+			t.Logf("     y = %x", y)
+			t.Logf("   got = %x", got)
+			t.Logf("expect = %x", expect)
+			t.FailNow()
+		}
+	}
+}
+
+func TestSqr(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		x := RandElt()
+		px := x
+
+		var got Elt
+		Sqr(&got, &x)
+
+		expect := ExpectMul(x, x)
+
+		if px != x {
+			t.Fatal("changed input")
+		}
+
+		if got != expect {
+			t.Logf("     x = %x", x)
+			t.Logf("   got = %x", got)
+			t.Logf("expect = %x", expect)
+			t.FailNow()
+		}
+	}
+}
+
+func TestSqrEdgeCases(t *testing.T) {
+	// Values with long runs of ones, which exercise the carry chains when
+	// doubling cross products.
+	interesting := map[string]*big.Int{
+		"0":       big.NewInt(0),
+		"1":       big.NewInt(1),
+		"(p-1)":   new(big.Int).Sub(p, big.NewInt(1)),
+		"(p-1)/2": new(big.Int).Rsh(p, 1),
+		"2^255":   bigint.Pow2(255),
+		"2^192-1": bigint.Ones(192),
+	}
+
+	for name, v := range interesting {
+		v := v
+		t.Run(name, func(t *testing.T) {
+			var x, got, expect Elt
+			BytesFromIntLittleEndian(x[:], v)
+			Sqr(&got, &x)
+			Mul(&expect, &x, &x)
+
+			if got != expect {
+				t.Logf("     x = %x", x)
+				t.Logf("   got = %x", got)
+				t.Logf("expect = %x", expect)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestMulEdgeCases(t *testing.T) {
+	// Values that might exercise edge cases.
+	interesting := map[string]*big.Int{
+		"0":     big.NewInt(0),
+		"1":     big.NewInt(1),
+		"R":     R(),
+		"(1/R)": RInv(),
+		"(p-1)": new(big.Int).Sub(p, big.NewInt(1)),
+	}
+
+	// Test cases for every pair of interesting numbers.
+	type testcase struct {
+		Name string
+		X, Y *big.Int
+	}
+	cases := []testcase{}
+	for nx, x := range interesting {
+		for ny, y := range interesting {
+			cases = append(cases, testcase{
+				Name: fmt.Sprintf("%s*%s", nx, ny),
+				X:    new(big.Int).Set(x),
+				Y:    new(big.Int).Set(y),
+			})
+		}
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			// Montgomery encode.
+			c.X.Mul(c.X, R())
+			c.X.Mod(c.X, p)
+
+			c.Y.Mul(c.Y, R())
+			c.Y.Mod(c.Y, p)
+
+			// Compute expectation.
+			expect := new(big.Int).Mul(c.X, c.Y)
+			expect.Mul(expect, RInv())
+			expect.Mod(expect, p)
+
+			// What do we get?
+			var x, y, z Elt
+			BytesFromIntLittleEndian(x[:], c.X)
+			BytesFromIntLittleEndian(y[:], c.Y)
+			Mul(&z, &x, &y)
+			got := IntFromBytesLittleEndian(z[:])
+
+			if expect.Cmp(got) != 0 {
+				t.Logf("     x = %x", c.X)
+				t.Logf("     y = %x", c.Y)
+				t.Logf("   got = %x", got)
+				t.Logf("expect = %x", expect)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestInv(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		x := RandElt()
+
+		var m, minv, got Elt
+		Encode(&m, &x)
+		Inv(&minv, &m)
+		Decode(&got, &minv)
+
+		// Expect.
+		xi := IntFromBytesLittleEndian(x[:])
+		xi.ModInverse(xi, p)
+		var expect Elt
+		BytesFromIntLittleEndian(expect[:], xi)
+
+		if got != expect {
+			t.Logf("     x = %x", x)
+			t.Logf("   got = %x", got)
+			t.Logf("expect = %x", expect)
+			t.FailNow()
+		}
+	}
+}
+
+func TestInvProperty(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		x := RandElt()
+
+		var xm, xminv, p, got Elt
+		Encode(&xm, &x)
+		Inv(&xminv, &xm)
+		Mul(&p, &xm, &xminv)
+		Decode(&got, &p)
+
+		one := Elt{1}
+		if one != got {
+			t.Logf("     x = %x", x)
+			t.Logf("   got = %x", got)
+			t.Logf("expect = %x", one)
+			t.Fatal("invalid inverse")
+		}
+	}
+}
+
+func TestInvAlias(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		x := RandElt()
+
+		var expect, got Elt
+		Inv(&expect, &x)
+		got = x
+		Inv(&got, &got)
+
+		if got != expect {
+			t.Logf("     x = %x", x)
+			t.Logf("   got = %x", got)
+			t.Logf("expect = %x", expect)
+			t.FailNow()
+		}
+	}
+}
+
+func RandVec(n int) []Elt {
+	x := make([]Elt, n)
+	for i := range x {
+		x[i] = RandElt()
+	}
+	return x
+}
+
+// CheckVec checks the vector operation vec against the single element operation
+// op, for random inputs of varying lengths. The output is computed in place of
+// the first input as well as into a separate slice.
+func CheckVec(t *testing.T, args int, vec func(z []Elt, xs ...[]Elt), op func(z *Elt, xs ...*Elt)) {
+	for trial := 0; trial < NumTrials()/16; trial++ {
+		n := rand.Intn(17)
+		xs := make([][]Elt, args)
+		for i := range xs {
+			xs[i] = RandVec(n)
+		}
+
+		// Expect.
+		expect := make([]Elt, n)
+		for i := range expect {
+			ptrs := make([]*Elt, args)
+			for j := range xs {
+				ptrs[j] = &xs[j][i]
+			}
+			op(&expect[i], ptrs...)
+		}
+
+		// Separate output.
+		got := make([]Elt, n)
+		vec(got, xs...)
+		for i := range got {
+			if got[i] != expect[i] {
+				t.Fatalf("n=%d: element %d: got %x expect %x", n, i, got[i], expect[i])
+			}
+		}
+
+		// In place.
+		vec(xs[0], xs...)
+		for i := range xs[0] {
+			if xs[0][i] != expect[i] {
+				t.Fatalf("n=%d: in place: element %d: got %x expect %x", n, i, xs[0][i], expect[i])
+			}
+		}
+	}
+}
+
+func TestAddVec(t *testing.T) {
+	CheckVec(t, 2,
+		func(z []Elt, xs ...[]Elt) { AddVec(z, xs[0], xs[1]) },
+		func(z *Elt, xs ...*Elt) { Add(z, xs[0], xs[1]) },
+	)
+}
+
+func TestMulVec(t *testing.T) {
+	CheckVec(t, 2,
+		func(z []Elt, xs ...[]Elt) { MulVec(z, xs[0], xs[1]) },
+		func(z *Elt, xs ...*Elt) { Mul(z, xs[0], xs[1]) },
+	)
+}
+
+func TestSqrVec(t *testing.T) {
+	CheckVec(t, 1,
+		func(z []Elt, xs ...[]Elt) { SqrVec(z, xs[0]) },
+		func(z *Elt, xs ...*Elt) { Sqr(z, xs[0]) },
+	)
+}
+
+func TestVecLengthMismatch(t *testing.T) {
+	cases := map[string]func(){
+		"AddVec":   func() { AddVec(make([]Elt, 2), make([]Elt, 2), make([]Elt, 1)) },
+		"MulVec":   func() { MulVec(make([]Elt, 1), make([]Elt, 2), make([]Elt, 2)) },
+		"SqrVec":   func() { SqrVec(make([]Elt, 2), make([]Elt, 3)) },
+		"BatchInv": func() { BatchInv(make([]Elt, 0), make([]Elt, 1)) },
+	}
+	for name, f := range cases {
+		f := f
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if r == nil {
+					t.Fatal("expected panic")
+				}
+				if expect := name + ": slice lengths differ"; r != expect {
+					t.Fatalf("panic %q; expect %q", r, expect)
+				}
+			}()
+			f()
+		})
+	}
+}
+
+func TestBatchInv(t *testing.T) {
+	for trial := 0; trial < NumTrials()/16; trial++ {
+		n := rand.Intn(17)
+		x := RandVec(n)
+
+		// Include some zeros.
+		for i := range x {
+			if rand.Intn(4) == 0 {
+				x[i] = Elt{}
+			}
+		}
+
+		expect := make([]Elt, n)
+		for i := range x {
+			Inv(&expect[i], &x[i])
+		}
+
+		got := make([]Elt, n)
+		BatchInv(got, x)
+		for i := range got {
+			if got[i] != expect[i] {
+				t.Fatalf("n=%d: element %d: got %x expect %x", n, i, got[i], expect[i])
+			}
+		}
+
+		// In place.
+		BatchInv(x, x)
+		for i := range x {
+			if x[i] != expect[i] {
+				t.Fatalf("n=%d: in place: element %d: got %x expect %x", n, i, x[i], expect[i])
+			}
+		}
+	}
+}
+
+func TestSetCanonicalBytes(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		b := make([]byte, Size)
+		rand.Read(b)
+
+		var x Elt
+		got := x.SetCanonicalBytes(b)
+
+		v := new(big.Int).SetBytes(b)
+		expect := uint(0)
+		if v.Cmp(p) < 0 {
+			expect = 1
+			if x.Int().Cmp(v) != 0 {
+				t.Fatalf("SetCanonicalBytes(%x) = %x", b, x.Int())
+			}
+		}
+
+		if got != expect {
+			t.Fatalf("SetCanonicalBytes(%x) returned %d; expect %d", b, got, expect)
+		}
+	}
+}
+
+func TestSetCanonicalBytesEdgeCases(t *testing.T) {
+	cases := []struct {
+		Name   string
+		X      *big.Int
+		Expect uint
+	}{
+		{"zero", big.NewInt(0), 1},
+		{"p-1", new(big.Int).Sub(p, big.NewInt(1)), 1},
+		{"p", p, 0},
+		{"p+1", new(big.Int).Add(p, big.NewInt(1)), 0},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			var x Elt
+			if got := x.SetCanonicalBytes(c.X.FillBytes(make([]byte, Size))); got != c.Expect {
+				t.Fatalf("got %d; expect %d", got, c.Expect)
+			}
+		})
+	}
+}
+
+func TestIsCanonical(t *testing.T) {
+	max := new(big.Int).Sub(bigint.Pow2(8*Size), big.NewInt(1))
+	xs := []*big.Int{
+		big.NewInt(0),
+		new(big.Int).Sub(p, big.NewInt(1)),
+		p,
+		new(big.Int).Add(p, big.NewInt(1)),
+		max,
+	}
+	for trial := 0; trial < NumTrials(); trial++ {
+		// Random values close to p, either side.
+		d := new(big.Int).Rand(rand.New(rand.NewSource(int64(trial))), bigint.Pow2(64))
+		xs = append(xs, new(big.Int).Sub(p, d), new(big.Int).Add(p, d))
+	}
+
+	for _, x := range xs {
+		if x.Cmp(max) > 0 {
+			continue
+		}
+		expect := uint(0)
+		if x.Cmp(p) < 0 {
+			expect = 1
+		}
+		b := x.FillBytes(make([]byte, Size))
+		if got := IsCanonical(b); got != expect {
+			t.Fatalf("IsCanonical(%x) = %d; expect %d", b, got, expect)
+		}
+	}
+}
+
+func TestSetBytesStrict(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		b := make([]byte, Size)
+		rand.Read(b)
+
+		var x Elt
+		got, err := x.SetBytesStrict(b)
+
+		v := new(big.Int).SetBytes(b)
+		if v.Cmp(p) >= 0 {
+			if err == nil {
+				t.Fatalf("SetBytesStrict(%x): expected error", b)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != &x {
+			t.Fatal("expected receiver to be returned")
+		}
+		if x.Int().Cmp(v) != 0 {
+			t.Fatalf("SetBytesStrict(%x) = %x", b, x.Int())
+		}
+	}
+}
+
+func TestSetBytesStrictErrors(t *testing.T) {
+	cases := map[string][]byte{
+		"empty": {},
+		"short": make([]byte, Size-1),
+		"long":  make([]byte, Size+1),
+		"p":     p.FillBytes(make([]byte, Size)),
+		"p+1":   new(big.Int).Add(p, big.NewInt(1)).FillBytes(make([]byte, Size)),
+	}
+	for name, b := range cases {
+		b := b
+		t.Run(name, func(t *testing.T) {
+			x := RandElt()
+			expect := x
+			if _, err := x.SetBytesStrict(b); err == nil {
+				t.Fatal("expected error")
+			}
+			if x != expect {
+				t.Fatal("value changed on error")
+			}
+		})
+	}
+}
+
+// WideInputs returns big-endian byte strings of every length up to 2*Size, with
+// random and extreme values.
+func WideInputs() [][]byte {
+	bs := [][]byte{}
+	for n := 0; n <= 2*Size; n++ {
+		for trial := 0; trial < NumTrials()/64; trial++ {
+			b := make([]byte, n)
+			rand.Read(b)
+			bs = append(bs, b)
+		}
+		bs = append(bs, bytes.Repeat([]byte{0xff}, n))
+	}
+	bs = append(bs, p.FillBytes(make([]byte, 2*Size)))
+	return bs
+}
+
+func TestSetBytesWide(t *testing.T) {
+	for _, b := range WideInputs() {
+		var x Elt
+		x.SetBytesWide(b)
+
+		expect := new(big.Int).SetBytes(b)
+		expect.Mod(expect, p)
+
+		if got := x.Int(); got.Cmp(expect) != 0 {
+			t.Fatalf("SetBytesWide(%x) = %x; expect %x", b, got, expect)
+		}
+	}
+}
+
+func TestSetBytesWideRaw(t *testing.T) {
+	for _, b := range WideInputs() {
+		var x Elt
+		x.SetBytesWideRaw(b)
+
+		expect := new(big.Int).SetBytes(b)
+		expect.Mod(expect, p)
+
+		if got := x.IntRaw(); got.Cmp(expect) != 0 {
+			t.Fatalf("SetBytesWideRaw(%x) = %x; expect %x", b, got, expect)
+		}
+	}
+}
+
+func TestFillBytes(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		x := RandElt()
+
+		var m Elt
+		Encode(&m, &x)
+		got := m.FillBytes(make([]byte, Size))
+
+		expect := IntFromBytesLittleEndian(x[:]).FillBytes(make([]byte, Size))
+		if !bytes.Equal(got, expect) {
+			t.Logf("   got = %x", got)
+			t.Logf("expect = %x", expect)
+			t.FailNow()
+		}
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	for trial := 0; trial < NumTrials(); trial++ {
+		x := RandElt()
+
+		var m, r Elt
+		Encode(&m, &x)
+		got := Sqrt(&r, &m)
+
+		xi := IntFromBytesLittleEndian(x[:])
+		expect := new(big.Int).ModSqrt(xi, p)
+
+		if (expect != nil) != (got == 1) {
+			t.Fatalf("Sqrt(%x) returned %d", x, got)
+		}
+
+		if got == 1 {
+			ri := r.Int()
+			ri.Mul(ri, ri).Mod(ri, p)
+			if ri.Cmp(xi) != 0 {
+				t.Fatalf("Sqrt(%x) = %x is not a square root", x, r.Int())
+			}
+		}
+	}
+}
+
+func TestLegendre(t *testing.T) {
+	var zero Elt
+	if Legendre(&zero) != 0 {
+		t.Fatal("expected zero Legendre symbol for zero")
+	}
+
+	for trial := 0; trial < NumTrials(); trial++ {
+		x := RandElt()
+
+		var m Elt
+		Encode(&m, &x)
+
+		expect := big.Jacobi(IntFromBytesLittleEndian(x[:]), p)
+		if got := Legendre(&m); got != expect {
+			t.Fatalf("Legendre(%x) = %d; expect %d", x, got, expect)
+		}
+	}
+}
+
+func TestISAs(t *testing.T) {
+	ForEachISA(t, func(t *testing.T) {
+		t.Run("Mul", TestMul)
+		t.Run("Sqr", TestSqr)
+		t.Run("MulEdgeCases", TestMulEdgeCases)
+		t.Run("SqrEdgeCases", TestSqrEdgeCases)
+		t.Run("Inv", TestInv)
+		t.Run("Sqrt", TestSqrt)
+		t.Run("AddVec", TestAddVec)
+		t.Run("MulVec", TestMulVec)
+		t.Run("SqrVec", TestSqrVec)
+		t.Run("BatchInv", TestBatchInv)
+		t.Run("SetBytesWide", TestSetBytesWide)
+	})
+}
+
+func BenchmarkMulVec(b *testing.B) {
+	const n = 64
+	x, y, z := RandVec(n), RandVec(n), make([]Elt, n)
+	for i := 0; i < b.N; i++ {
+		MulVec(z, x, y)
+	}
+}
+
+func BenchmarkBatchInv(b *testing.B) {
+	const n = 64
+	x, z := RandVec(n), make([]Elt, n)
+	for i := 0; i < b.N; i++ {
+		BatchInv(z, x)
+	}
+}
+
+func IntFromBytesLittleEndian(b []byte) *big.Int {
+	bigendian := append([]byte{}, b...)
+	ReverseBytes(bigendian)
+	return new(big.Int).SetBytes(bigendian)
+}
+
+func BytesFromIntLittleEndian(b []byte, x *big.Int) []byte {
+	xb := x.Bytes()
+	ReverseBytes(xb)
+	copy(b, xb)
+	return b
+}
+
+func ReverseBytes(b []byte) {
+	for l, r := 0, len(b)-1; l < r; l, r = l+1, r-1 {
+		b[l], b[r] = b[r], b[l]
+	}
+}
@@ -1,34 +1,327 @@
 package curve
 
 import (
+	"crypto"
 	"crypto/elliptic"
+	_ "crypto/sha256" // register hash functions
+	_ "crypto/sha512"
+	"encoding/json"
+	"math/big"
+	"math/rand"
 	"strings"
 
+	"github.com/mmcloughlin/addchain/acc/ir"
+	"golang.org/x/xerrors"
+
+	asmfp "github.com/mmcloughlin/ec3/asm/fp"
 	"github.com/mmcloughlin/ec3/gen"
+	"github.com/mmcloughlin/ec3/gen/fp"
+	"github.com/mmcloughlin/ec3/internal/ecdsavectors"
+	"github.com/mmcloughlin/ec3/internal/h2cvectors"
 	"github.com/mmcloughlin/ec3/internal/tmpl"
+	"github.com/mmcloughlin/ec3/internal/weierstrass"
+	"github.com/mmcloughlin/ec3/name"
 )
 
+//go:generate go run make.go -output tmpl/shortw
 //go:generate assets -pkg curve -func loadtemplate -output ztemplates.go tmpl/shortw/*.go
 
 var templates = tmpl.Environment{
 	Loader: tmpl.NewBasePath(tmpl.LoaderFunc(loadtemplate), "tmpl/shortw"),
 }
 
+// ShortWeierstrass generates an implementation of a short Weierstrass curve
+// y² = x³ + ax + b with a = -3 or a = 0, described by the standard library
+// curve parameters and the coefficient a.
+//
+// The point types and formulae are generated separately, and must match a.
+// With an Endomorphism, the Jacobian type must also have an Endomorphism method
+// computing (βX, Y, Z).
 type ShortWeierstrass struct {
 	PackageName string
 	Params      *elliptic.CurveParams
 	ShortName   string
+
+	// A is the curve coefficient a, which must be -3 or 0. If nil, a = -3, as
+	// assumed by crypto/elliptic.
+	A *big.Int
+
+	// Endomorphism enables scalar multiplication with the GLV method, if
+	// non-nil. Requires a = 0.
+	Endomorphism *Endomorphism
+
+	// ScalarInverseChain is an optional addition chain for inversion in the
+	// scalar field, computing N-2. If nil, a chain is found with ChainSearch.
+	ScalarInverseChain *ir.Program
+
+	// ScalarInversion selects the inversion algorithm for the scalar field.
+	ScalarInversion fp.Inversion
+
+	// ScalarField is the backend for the scalar field modulo the group order.
+	// If nil, it is chosen by fp.SelectField.
+	ScalarField asmfp.Field
+
+	// ChainSearch configures how addition chains are found when not provided.
+	ChainSearch fp.ChainSearch
+
+	// ECDSA enables generation of ECDSA signing and verification. Test vectors
+	// are generated with hash function ECDSAHash.
+	ECDSA bool
+
+	// ECDH enables generation of ECDH key types.
+	ECDH bool
+
+	// HashToCurve enables generation of hashing to the curve, if non-nil.
+	HashToCurve *HashToCurve
+}
+
+// HashToCurve configures hashing to a curve with expand_message_xmd and the
+// Simplified SWU map, as specified in RFC 9380.
+//
+// Only the Simplified SWU map for curves with a = -3 and non-zero b is
+// supported, which covers the NIST curves. Curves with b = 0 would require the
+// map to an isogenous curve, and Montgomery-form curves Elligator 2, neither of
+// which is implemented. Configurations for such curves are rejected.
+type HashToCurve struct {
+	// Hash is the hash function used by expand_message_xmd.
+	Hash crypto.Hash
+
+	// SecurityLevel is the target security level in bits.
+	SecurityLevel int
+}
+
+// hashexprs maps supported hash functions to Go expressions.
+var hashexprs = map[crypto.Hash]string{
+	crypto.SHA224: "crypto.SHA224",
+	crypto.SHA256: "crypto.SHA256",
+	crypto.SHA384: "crypto.SHA384",
+	crypto.SHA512: "crypto.SHA512",
+}
+
+// transforms returns template transforms defining hash to curve parameters.
+func (h HashToCurve) transforms(params *elliptic.CurveParams) ([]tmpl.Transform, error) {
+	expr, ok := hashexprs[h.Hash]
+	if !ok {
+		return nil, xerrors.Errorf("unsupported hash function %s", h.Hash)
+	}
+
+	p := params.P
+	z, err := sswuz(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Represent Z as a small signed integer.
+	z = new(big.Int).Set(z)
+	if z.Cmp(new(big.Int).Rsh(p, 1)) > 0 {
+		z.Sub(z, p)
+	}
+	if !z.IsInt64() {
+		return nil, xerrors.New("simplified swu parameter z out of range")
+	}
+
+	return []tmpl.Transform{
+		tmpl.DefineExpr("ConstHashToCurveHash", expr),
+		tmpl.DefineIntDecimal("ConstHashToFieldSize", (p.BitLen()+h.SecurityLevel+7)/8),
+		tmpl.DefineIntDecimal("ConstSSWUZ", int(z.Int64())),
+	}, nil
+}
+
+// hashnames maps supported hash functions to the names used in test vectors.
+var hashnames = map[crypto.Hash]string{
+	crypto.SHA224: "SHA-224",
+	crypto.SHA256: "SHA-256",
+	crypto.SHA384: "SHA-384",
+	crypto.SHA512: "SHA-512",
+}
+
+// CurveA returns the curve coefficient a.
+func (c ShortWeierstrass) CurveA() *big.Int {
+	if c.A == nil {
+		return big.NewInt(-3)
+	}
+	return c.A
+}
+
+// Reference returns a math/big implementation of the curve, for generating
+// test vectors. Curves with a = -3 use the generic crypto/elliptic
+// implementation.
+func (c ShortWeierstrass) Reference() elliptic.Curve {
+	if c.CurveA().Cmp(big.NewInt(-3)) == 0 {
+		return c.Params
+	}
+	return weierstrass.New(c.Params, c.CurveA())
+}
+
+// Validate checks that the configuration is supported.
+func (c ShortWeierstrass) Validate() error {
+	a := c.CurveA()
+	if !a.IsInt64() || (a.Int64() != -3 && a.Int64() != 0) {
+		return xerrors.Errorf("unsupported curve coefficient a = %d", a)
+	}
+
+	if c.HashToCurve != nil && a.Sign() == 0 {
+		return xerrors.New("hash to curve requires a = -3")
+	}
+
+	if c.Endomorphism != nil {
+		if err := c.Endomorphism.Validate(weierstrass.New(c.Params, a)); err != nil {
+			return xerrors.Errorf("endomorphism: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ECDSAHash returns the hash function used in ECDSA test vectors: the smallest
+// SHA-2 function with output at least the size of the group order.
+func (c ShortWeierstrass) ECDSAHash() crypto.Hash {
+	for _, h := range []crypto.Hash{crypto.SHA256, crypto.SHA384} {
+		if 8*h.Size() >= c.Params.N.BitLen() {
+			return h
+		}
+	}
+	return crypto.SHA512
+}
+
+// TestData generates test vector files for the enabled features.
+func (c ShortWeierstrass) TestData() (gen.Files, error) {
+	fs := gen.Files{}
+
+	if c.ECDSA {
+		h := c.ECDSAHash()
+		g := &ecdsavectors.Generator{
+			Curve:    c.Reference(),
+			HashName: hashnames[h],
+			Hash:     h,
+			Rand:     rand.New(rand.NewSource(1)),
+		}
+		b, err := json.MarshalIndent(g.File(2, 4), "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		fs.Add("testdata/ecdsa.json", append(b, '\n'))
+	}
+
+	if c.HashToCurve != nil {
+		h2c, err := c.HashToCurve.vectors(c.Params)
+		if err != nil {
+			return nil, err
+		}
+		fs = gen.Merge(fs, h2c)
+	}
+
+	return fs, nil
+}
+
+// sswuz returns the Simplified SWU parameter Z for the curve, as a field
+// element. The template assumes a = -3, so the map applies directly provided b
+// is non-zero. Otherwise an isogeny would be required.
+func sswuz(params *elliptic.CurveParams) (*big.Int, error) {
+	if params.B.Sign() == 0 {
+		return nil, xerrors.New("hash to curve requires non-zero b")
+	}
+	a := new(big.Int).Sub(params.P, big.NewInt(3))
+	return SSWUZ(params.P, a, params.B), nil
+}
+
+// vectors generates test vector files for the random oracle and nonuniform
+// encoding suites.
+func (h HashToCurve) vectors(params *elliptic.CurveParams) (gen.Files, error) {
+	name, ok := hashnames[h.Hash]
+	if !ok {
+		return nil, xerrors.Errorf("unsupported hash function %s", h.Hash)
+	}
+
+	z, err := sswuz(params)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := gen.Files{}
+	for _, ro := range []bool{true, false} {
+		s := h2cvectors.Suite{
+			Curve:         params,
+			Hash:          h.Hash,
+			HashName:      name,
+			SecurityLevel: h.SecurityLevel,
+			RandomOracle:  ro,
+			Z:             z,
+		}
+		b, err := json.MarshalIndent(s.File(), "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		filename := "testdata/hashtocurve_nu.json"
+		if ro {
+			filename = "testdata/hashtocurve_ro.json"
+		}
+		fs.Add(filename, append(b, '\n'))
+	}
+
+	return fs, nil
+}
+
+// ScalarConfig returns the configuration for the scalar field modulo the group
+// order.
+func (c ShortWeierstrass) ScalarConfig() fp.Config {
+	field := c.ScalarField
+	if field == nil {
+		field = fp.SelectField(c.Params.N)
+	}
+	return fp.Config{
+		Field:        field,
+		Inversion:    c.ScalarInversion,
+		InverseChain: c.ScalarInverseChain,
+		ChainSearch:  c.ChainSearch,
+
+		PackageName:     c.PackageName,
+		ElementTypeName: "scalar",
+		FilenamePrefix:  "scalar",
+		Scheme: name.CompositeScheme(
+			name.Prefixed("scalar"),
+			name.LowerCase,
+		),
+	}
 }
 
 func (c ShortWeierstrass) Generate() (gen.Files, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Scalar field.
+	scalarfiles, err := fp.Package(c.ScalarConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	// Curve operations.
 	filenames := []string{
 		"curve.go",
 		"curve_test.go",
+		"isa_test.go",
 		"recode.go",
 		"recode_test.go",
 		"util_test.go",
 	}
 
+	if c.ECDSA {
+		filenames = append(filenames, "ecdsa.go", "ecdsa_test.go")
+	}
+
+	if c.ECDH {
+		filenames = append(filenames, "ecdh.go", "ecdh_test.go")
+	}
+
+	if c.HashToCurve != nil {
+		filenames = append(filenames, "hashtocurve.go", "hashtocurve_test.go")
+	}
+
+	if c.Endomorphism != nil {
+		filenames = append(filenames, "glv.go", "glv_test.go")
+	}
+
 	typename := strings.ToUpper(c.ShortName)
 	varname := strings.ToLower(c.ShortName)
 
@@ -48,17 +341,41 @@ func (c ShortWeierstrass) Generate() (gen.Files, error) {
 		tmpl.DefineString("ConstGxHex", c.Params.Gx.Text(16)),
 		tmpl.DefineString("ConstGyHex", c.Params.Gy.Text(16)),
 		tmpl.DefineIntDecimal("ConstBitSize", c.Params.BitSize),
+		tmpl.DefineIntDecimal("ConstFieldSize", (c.Params.P.BitLen()+7)/8),
+		tmpl.DefineIntDecimal("ConstScalarSize", (c.Params.N.BitLen()+7)/8),
+		tmpl.DefineIntDecimal("ConstA", int(c.CurveA().Int64())),
 
 		tmpl.DefineIntDecimal("ConstW", 6),
+		tmpl.DefineBool("ConstGLV", c.Endomorphism != nil),
 
 		tmpl.DefineIntDecimal("ConstNumTrials", 128),
 	}
 
+	if c.HashToCurve != nil {
+		h2c, err := c.HashToCurve.transforms(c.Params)
+		if err != nil {
+			return nil, err
+		}
+		transforms = append(transforms, h2c...)
+	}
+
+	if c.Endomorphism != nil {
+		width := c.ScalarConfig().Field.ElementBits()
+		transforms = append(transforms, c.Endomorphism.transforms(c.Params.N, width)...)
+	}
+
+	transforms = append(transforms, tmpl.Preprocessor())
+
 	fs := gen.Files{}
-	err := fs.AddTemplates(templates, filenames, transforms)
+	if err := fs.AddTemplates(templates, filenames, transforms); err != nil {
+		return nil, err
+	}
+
+	// Test vectors.
+	testdata, err := c.TestData()
 	if err != nil {
 		return nil, err
 	}
 
-	return fs, nil
+	return gen.Merge(scalarfiles, fs, testdata), nil
 }
@@ -0,0 +1,223 @@
+package curve
+
+import (
+	"math/big"
+
+	"golang.org/x/xerrors"
+
+	"github.com/mmcloughlin/ec3/internal/bigint"
+	"github.com/mmcloughlin/ec3/internal/tmpl"
+	"github.com/mmcloughlin/ec3/internal/weierstrass"
+)
+
+// References:
+//
+//	[glv]    Robert P. Gallant, Robert J. Lambert and Scott A. Vanstone. Faster Point
+//	         Multiplication on Elliptic Curves with Efficient Endomorphisms. In
+//	         Advances in Cryptology - CRYPTO 2001.
+//	[guide]  Darrel Hankerson, Alfred Menezes and Scott Vanstone. Guide to Elliptic
+//	         Curve Cryptography. Springer. 2004.
+
+// Endomorphism specifies the endomorphism φ(x, y) = (βx, y) of a curve with
+// a = 0, which acts on the group as multiplication by λ. Scalar multiplication
+// splits the scalar k into half-length scalars k₁ and k₂ with k = k₁ + k₂λ (mod
+// N), and computes k₁P + k₂φ(P) with a joint ladder [glv].
+type Endomorphism struct {
+	// Beta is a non-trivial cube root of unity modulo p.
+	Beta *big.Int
+
+	// Lambda is a non-trivial cube root of unity modulo the order N, such that
+	// φ(P) = λP.
+	Lambda *big.Int
+
+	// A1, B1, A2, B2 are the short basis vectors (a₁, b₁) and (a₂, b₂) of the
+	// lattice of (x, y) with x + yλ ≡ 0 (mod N), as returned by LatticeBasis.
+	A1, B1, A2, B2 *big.Int
+}
+
+// FindEndomorphism returns the endomorphism of the curve c. Requires a = 0,
+// and both p and N congruent to 1 modulo 3.
+func FindEndomorphism(c *weierstrass.Curve) (*Endomorphism, error) {
+	if c.A.Sign() != 0 {
+		return nil, xerrors.New("endomorphism requires a = 0")
+	}
+
+	beta, err := cuberoot(c.P)
+	if err != nil {
+		return nil, err
+	}
+
+	lambda, err := cuberoot(c.N)
+	if err != nil {
+		return nil, err
+	}
+
+	// Of the two non-trivial roots λ and λ², choose the one matching β.
+	if !actsas(c, beta, lambda) {
+		lambda.Mul(lambda, lambda).Mod(lambda, c.N)
+	}
+
+	e := &Endomorphism{Beta: beta, Lambda: lambda}
+	e.A1, e.B1, e.A2, e.B2 = LatticeBasis(lambda, c.N)
+
+	if err := e.Validate(c); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// cuberoot returns a non-trivial cube root of unity modulo the prime q.
+func cuberoot(q *big.Int) (*big.Int, error) {
+	three := big.NewInt(3)
+	e, r := new(big.Int).DivMod(new(big.Int).Sub(q, big.NewInt(1)), three, new(big.Int))
+	if r.Sign() != 0 {
+		return nil, xerrors.Errorf("no non-trivial cube root of unity modulo %#x", q)
+	}
+	for g := int64(2); ; g++ {
+		x := new(big.Int).Exp(big.NewInt(g), e, q)
+		if x.Cmp(big.NewInt(1)) != 0 {
+			return x, nil
+		}
+	}
+}
+
+// actsas reports whether the map (x, y) -> (βx, y) acts on the generator as
+// multiplication by λ.
+func actsas(c *weierstrass.Curve, beta, lambda *big.Int) bool {
+	x, y := c.ScalarBaseMult(lambda.Bytes())
+	bx := new(big.Int).Mul(beta, c.Gx)
+	bx.Mod(bx, c.P)
+	return x.Cmp(bx) == 0 && y.Cmp(c.Gy) == 0
+}
+
+// LatticeBasis returns short vectors (a₁, b₁) and (a₂, b₂) spanning the lattice
+// of (x, y) with x + yλ ≡ 0 (mod n), computed with the extended Euclidean
+// algorithm as in [guide] Algorithm 3.74. The basis is ordered and signed such
+// that b₁ < 0 < b₂ and a₁b₂ - a₂b₁ = n, as required by scalar decomposition.
+func LatticeBasis(lambda, n *big.Int) (a1, b1, a2, b2 *big.Int) {
+	// Run the extended Euclidean algorithm on n and λ, maintaining the
+	// remainders rᵢ and coefficients tᵢ such that rᵢ ≡ tᵢλ (mod n). Stop at
+	// the first remainder below √n, recording the two preceding.
+	sqrtn := new(big.Int).Sqrt(n)
+	r0, r1 := new(big.Int).Set(n), new(big.Int).Set(lambda)
+	t0, t1 := new(big.Int), big.NewInt(1)
+	rl, tl := r0, t0
+	for r1.Cmp(sqrtn) >= 0 {
+		q, r := new(big.Int).DivMod(r0, r1, new(big.Int))
+		t := new(big.Int).Sub(t0, new(big.Int).Mul(q, t1))
+		rl, tl = r1, t1
+		r0, r1 = r1, r
+		t0, t1 = t1, t
+	}
+
+	// (a₁, b₁) = (r_{l+1}, -t_{l+1})
+	a1, b1 = r1, new(big.Int).Neg(t1)
+
+	// (a₂, b₂) is the shorter of (r_l, -t_l) and (r_{l+2}, -t_{l+2}).
+	q := new(big.Int).Div(r0, r1)
+	r2 := new(big.Int).Sub(r0, new(big.Int).Mul(q, r1))
+	t2 := new(big.Int).Sub(t0, new(big.Int).Mul(q, t1))
+	a2, b2 = rl, new(big.Int).Neg(tl)
+	if norm(r2, t2).Cmp(norm(rl, tl)) < 0 {
+		a2, b2 = r2, new(big.Int).Neg(t2)
+	}
+
+	// Normalize signs: negating a basis vector preserves the lattice but flips
+	// the sign of the determinant, which swapping the vectors restores.
+	if b1.Sign() > 0 {
+		a1.Neg(a1)
+		b1.Neg(b1)
+	}
+	if b2.Sign() < 0 {
+		a2.Neg(a2)
+		b2.Neg(b2)
+	}
+	if det(a1, b1, a2, b2).Sign() < 0 {
+		a1, b1, a2, b2 = a2.Neg(a2), b2.Neg(b2), a1.Neg(a1), b1.Neg(b1)
+	}
+
+	return a1, b1, a2, b2
+}
+
+// norm returns the squared length of the vector (x, y).
+func norm(x, y *big.Int) *big.Int {
+	n := new(big.Int).Mul(x, x)
+	return n.Add(n, new(big.Int).Mul(y, y))
+}
+
+// det returns the determinant a₁b₂ - a₂b₁.
+func det(a1, b1, a2, b2 *big.Int) *big.Int {
+	d := new(big.Int).Mul(a1, b2)
+	return d.Sub(d, new(big.Int).Mul(a2, b1))
+}
+
+// Validate checks that e is an endomorphism of the curve c with a valid
+// lattice basis.
+func (e *Endomorphism) Validate(c *weierstrass.Curve) error {
+	if c.A.Sign() != 0 {
+		return xerrors.New("endomorphism requires a = 0")
+	}
+
+	one := big.NewInt(1)
+	three := big.NewInt(3)
+	if e.Beta.Cmp(one) == 0 || new(big.Int).Exp(e.Beta, three, c.P).Cmp(one) != 0 {
+		return xerrors.New("beta is not a non-trivial cube root of unity")
+	}
+	if e.Lambda.Cmp(one) == 0 || new(big.Int).Exp(e.Lambda, three, c.N).Cmp(one) != 0 {
+		return xerrors.New("lambda is not a non-trivial cube root of unity")
+	}
+	if !actsas(c, e.Beta, e.Lambda) {
+		return xerrors.New("endomorphism does not act as multiplication by lambda")
+	}
+
+	for _, v := range [][2]*big.Int{{e.A1, e.B1}, {e.A2, e.B2}} {
+		x := new(big.Int).Mul(v[1], e.Lambda)
+		x.Add(x, v[0])
+		if x.Mod(x, c.N).Sign() != 0 {
+			return xerrors.New("basis vector not in lattice")
+		}
+	}
+
+	if e.B1.Sign() >= 0 || e.B2.Sign() <= 0 || det(e.A1, e.B1, e.A2, e.B2).Cmp(c.N) != 0 {
+		return xerrors.New("basis is not normalized")
+	}
+
+	return nil
+}
+
+// transforms returns template transforms defining the scalar decomposition
+// constants, for scalars of the given bit width.
+func (e *Endomorphism) transforms(n *big.Int, width int) []tmpl.Transform {
+	// The decomposition approximates c₁ = round(b₂k/N) and c₂ = round(-b₁k/N)
+	// by multiplying k with g₁ = round(2ʷb₂/N) and g₂ = round(-2ʷb₁/N) and
+	// rounding away the low w bits [guide] Section 3.5.
+	g1 := rounddiv(new(big.Int).Lsh(e.B2, uint(width)), n)
+	g2 := rounddiv(new(big.Int).Lsh(new(big.Int).Neg(e.B1), uint(width)), n)
+
+	// Each rounding is within one half of the true value, so k₁ and k₂ are
+	// bounded by |a₁| + |a₂| and |b₁| + |b₂| respectively. Conversion to odd
+	// adds at most one.
+	bound := bigint.Max(
+		new(big.Int).Add(new(big.Int).Abs(e.A1), new(big.Int).Abs(e.A2)),
+		new(big.Int).Add(new(big.Int).Abs(e.B1), new(big.Int).Abs(e.B2)),
+	)
+
+	return []tmpl.Transform{
+		tmpl.DefineString("ConstGLVLambdaHex", e.Lambda.Text(16)),
+		tmpl.DefineString("ConstGLVA1Hex", e.A1.Text(16)),
+		tmpl.DefineString("ConstGLVB1Hex", e.B1.Text(16)),
+		tmpl.DefineString("ConstGLVA2Hex", e.A2.Text(16)),
+		tmpl.DefineString("ConstGLVB2Hex", e.B2.Text(16)),
+		tmpl.DefineString("ConstGLVG1Hex", g1.Text(16)),
+		tmpl.DefineString("ConstGLVG2Hex", g2.Text(16)),
+		tmpl.DefineIntDecimal("ConstGLVBits", bound.BitLen()),
+	}
+}
+
+// rounddiv returns x/y rounded to the nearest integer, for positive x and y.
+func rounddiv(x, y *big.Int) *big.Int {
+	q := new(big.Int).Lsh(x, 1)
+	q.Add(q, y)
+	return q.Div(q, new(big.Int).Lsh(y, 1))
+}
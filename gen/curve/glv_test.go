@@ -0,0 +1,76 @@
+package curve
+
+import (
+	"crypto/elliptic"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/mmcloughlin/ec3/internal/bigint"
+	"github.com/mmcloughlin/ec3/internal/weierstrass"
+)
+
+func TestFindEndomorphismSecp256k1(t *testing.T) {
+	e, err := FindEndomorphism(weierstrass.Secp256k1())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Constants from libsecp256k1.
+	expect := &Endomorphism{
+		Beta:   bigint.MustHex("7ae96a2b657c07106e64479eac3434e99cf0497512f58995c1396c28719501ee"),
+		Lambda: bigint.MustHex("5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd72"),
+		A1:     bigint.MustHex("3086d221a7d46bcde86c90e49284eb15"),
+		B1:     bigint.MustHex("-e4437ed6010e88286f547fa90abfe4c3"),
+		A2:     bigint.MustHex("114ca50f7a8e2f3f657c1108d9d44cfd8"),
+		B2:     bigint.MustHex("3086d221a7d46bcde86c90e49284eb15"),
+	}
+
+	for _, c := range []struct {
+		Name        string
+		Got, Expect *big.Int
+	}{
+		{"beta", e.Beta, expect.Beta},
+		{"lambda", e.Lambda, expect.Lambda},
+		{"a1", e.A1, expect.A1},
+		{"b1", e.B1, expect.B1},
+		{"a2", e.A2, expect.A2},
+		{"b2", e.B2, expect.B2},
+	} {
+		if !bigint.Equal(c.Got, c.Expect) {
+			t.Errorf("%s = %#x; expect %#x", c.Name, c.Got, c.Expect)
+		}
+	}
+}
+
+func TestFindEndomorphismRequiresZeroA(t *testing.T) {
+	c := weierstrass.New(elliptic.P256().Params(), big.NewInt(-3))
+	if _, err := FindEndomorphism(c); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestLatticeBasis(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := elliptic.P384().Params().N
+	for trial := 0; trial < 128; trial++ {
+		lambda := new(big.Int).Rand(r, n)
+		a1, b1, a2, b2 := LatticeBasis(lambda, n)
+
+		for _, v := range [][2]*big.Int{{a1, b1}, {a2, b2}} {
+			x := new(big.Int).Mul(v[1], lambda)
+			x.Add(x, v[0])
+			if x.Mod(x, n).Sign() != 0 {
+				t.Fatalf("lambda = %#x: vector (%#x, %#x) not in lattice", lambda, v[0], v[1])
+			}
+		}
+
+		if b1.Sign() >= 0 || b2.Sign() <= 0 {
+			t.Fatalf("lambda = %#x: unexpected signs b1 = %#x b2 = %#x", lambda, b1, b2)
+		}
+
+		if d := det(a1, b1, a2, b2); !bigint.Equal(d, n) {
+			t.Fatalf("lambda = %#x: determinant %#x", lambda, d)
+		}
+	}
+}
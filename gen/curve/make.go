@@ -0,0 +1,58 @@
+// +build ignore
+
+package main
+
+import (
+	"crypto"
+	"crypto/elliptic"
+	"flag"
+	"log"
+
+	"github.com/mmcloughlin/ec3/asm/fp/mont"
+	"github.com/mmcloughlin/ec3/gen"
+	"github.com/mmcloughlin/ec3/gen/curve"
+	"github.com/mmcloughlin/ec3/gen/fp"
+	"github.com/mmcloughlin/ec3/prime"
+)
+
+var output = flag.String("output", "tmpl/shortw", "output directory")
+
+// Generates the scalar field implementation and test vectors used by the
+// template stubs. This is the code a curve package would contain for the P-384
+// group order, with interleaved multiplication so that template tests exercise
+// it at the six limb register limit, and safegcd inversion so that it is tested
+// against the addition chain method.
+func main() {
+	flag.Parse()
+
+	params := elliptic.P384().Params()
+	c := curve.ShortWeierstrass{
+		PackageName: "shortw",
+		Params:      params,
+
+		ScalarInversion: fp.InversionSafeGCD,
+		ScalarField:     mont.NewWithMultiplication(prime.NewOther(params.N), mont.Interleaved),
+
+		ECDSA: true,
+		HashToCurve: &curve.HashToCurve{
+			Hash:          crypto.SHA384,
+			SecurityLevel: 192,
+		},
+	}
+
+	scalarfiles, err := fp.Package(c.ScalarConfig())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testdata, err := c.TestData()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fs := gen.Merge(scalarfiles, testdata)
+
+	if err := fs.Output(*output); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,145 @@
+package curve
+
+import (
+	"math/big"
+
+	"github.com/mmcloughlin/ec3/internal/bigint"
+)
+
+// SSWUZ returns the parameter Z of the Simplified SWU map for the curve
+// y² = x³ + ax + b over GF(p), selected with the find_z_sswu procedure of RFC
+// 9380 Section H.2. Requires a and b to be non-zero.
+func SSWUZ(p, a, b *big.Int) *big.Int {
+	f := field{p: p}
+	for ctr := int64(1); ; ctr++ {
+		for _, z := range []*big.Int{big.NewInt(ctr), big.NewInt(-ctr)} {
+			z = f.mod(z)
+			if f.goodz(z, a, b) {
+				return z
+			}
+		}
+	}
+}
+
+// field provides arithmetic modulo p.
+type field struct {
+	p *big.Int
+}
+
+func (f field) mod(x *big.Int) *big.Int { return x.Mod(x, f.p) }
+
+func (f field) mul(x, y *big.Int) *big.Int { return f.mod(new(big.Int).Mul(x, y)) }
+
+func (f field) add(x, y *big.Int) *big.Int { return f.mod(new(big.Int).Add(x, y)) }
+
+func (f field) sub(x, y *big.Int) *big.Int { return f.mod(new(big.Int).Sub(x, y)) }
+
+func (f field) inv(x *big.Int) *big.Int { return new(big.Int).ModInverse(x, f.p) }
+
+func (f field) issquare(x *big.Int) bool { return big.Jacobi(x, f.p) >= 0 }
+
+// g evaluates x³ + ax + b.
+func (f field) g(x, a, b *big.Int) *big.Int {
+	x3 := f.mul(f.mul(x, x), x)
+	return f.add(f.add(x3, f.mul(a, x)), b)
+}
+
+// goodz implements the is_good_Z criteria of RFC 9380 Section H.2.
+func (f field) goodz(z, a, b *big.Int) bool {
+	// Criterion 1: Z is non-square.
+	if f.issquare(z) {
+		return false
+	}
+
+	// Criterion 2: Z != -1.
+	if bigint.Equal(z, new(big.Int).Sub(f.p, bigint.One())) {
+		return false
+	}
+
+	// Criterion 3: g(x) - Z is irreducible. For a cubic this is equivalent to
+	// having no roots in GF(p).
+	poly := []*big.Int{f.sub(b, z), new(big.Int).Set(a), bigint.Zero(), bigint.One()}
+	if f.hasroot(poly) {
+		return false
+	}
+
+	// Criterion 4: g(B / (Z * A)) is square.
+	x := f.mul(b, f.inv(f.mul(z, a)))
+	return f.issquare(f.g(x, a, b))
+}
+
+// hasroot reports whether the monic polynomial m, given as coefficients in
+// increasing degree, has a root in GF(p). This holds if and only if
+// gcd(x^p - x, m) is non-constant.
+func (f field) hasroot(m []*big.Int) bool {
+	// Compute x^p mod m by square-and-multiply.
+	r := []*big.Int{bigint.One()}
+	x := []*big.Int{bigint.Zero(), bigint.One()}
+	for i := f.p.BitLen() - 1; i >= 0; i-- {
+		r = f.polymod(f.polymul(r, r), m)
+		if f.p.Bit(i) == 1 {
+			r = f.polymod(f.polymul(r, x), m)
+		}
+	}
+
+	// Subtract x and take the gcd with m.
+	for len(r) < 2 {
+		r = append(r, bigint.Zero())
+	}
+	r[1] = f.sub(r[1], bigint.One())
+
+	return len(f.polygcd(m, r)) > 1
+}
+
+// polymul returns the product of polynomials x and y.
+func (f field) polymul(x, y []*big.Int) []*big.Int {
+	z := make([]*big.Int, len(x)+len(y)-1)
+	for i := range z {
+		z[i] = bigint.Zero()
+	}
+	for i := range x {
+		for j := range y {
+			z[i+j] = f.add(z[i+j], f.mul(x[i], y[j]))
+		}
+	}
+	return f.polynorm(z)
+}
+
+// polymod returns the remainder of x divided by the non-zero polynomial m.
+func (f field) polymod(x, m []*big.Int) []*big.Int {
+	x = f.polynorm(append([]*big.Int{}, x...))
+	m = f.polynorm(m)
+	lead := f.inv(m[len(m)-1])
+	for len(x) >= len(m) && !(len(x) == 1 && bigint.IsZero(x[0])) {
+		// Cancel the leading term of x.
+		c := f.mul(x[len(x)-1], lead)
+		shift := len(x) - len(m)
+		for i := range m {
+			x[shift+i] = f.sub(x[shift+i], f.mul(c, m[i]))
+		}
+		x = f.polynorm(x[:len(x)-1])
+	}
+	return x
+}
+
+// polygcd returns the greatest common divisor of x and y, up to a constant
+// factor. The zero polynomial is represented by the single coefficient 0.
+func (f field) polygcd(x, y []*big.Int) []*big.Int {
+	x, y = f.polynorm(x), f.polynorm(y)
+	for !(len(y) == 1 && bigint.IsZero(y[0])) {
+		x, y = y, f.polymod(x, y)
+	}
+	return x
+}
+
+// polynorm strips zero leading coefficients, retaining at least one
+// coefficient.
+func (f field) polynorm(x []*big.Int) []*big.Int {
+	for len(x) > 1 && bigint.IsZero(x[len(x)-1]) {
+		x = x[:len(x)-1]
+	}
+	if len(x) == 0 {
+		return []*big.Int{bigint.Zero()}
+	}
+	return x
+}
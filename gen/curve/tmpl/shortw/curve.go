@@ -4,6 +4,8 @@ package shortw
 
 import (
 	"crypto/elliptic"
+	"crypto/subtle"
+	"errors"
 	"math/big"
 )
 
@@ -39,45 +41,354 @@ func init() {
 	curvename.Gx, _ = new(big.Int).SetString(ConstGxHex, 16)
 	curvename.Gy, _ = new(big.Int).SetString(ConstGyHex, 16)
 	curvename.BitSize = ConstBitSize
+
+	orderbits = curvename.N.BitLen()
+
+	curvea.SetInt64(ConstA)
+	curveb.SetInt(curvename.B)
+
+	identity.p.Y.SetInt64(1)
+
+	generator.p.X.SetInt(curvename.Gx)
+	generator.p.Y.SetInt(curvename.Gy)
+	generator.p.Z.SetInt64(1)
+
+	basetable = oddmultiples(jacobian(&generator.p), wnafbasew)
 }
 
 // Add returns the sum of (x1,y1) and (x2,y2).
 func (c curve) Add(x1, y1, x2, y2 *big.Int) (x, y *big.Int) {
-	a1 := NewAffine(x1, y1)
-	a2 := NewAffine(x2, y2)
-	p1 := a1.Projective()
-	p2 := a2.Projective()
-	s := new(Projective)
-	s.CompleteAdd(p1, p2)
-	return s.Affine().Coordinates()
+	p := new(Point).Add(newpoint(x1, y1), newpoint(x2, y2))
+	return p.coordinates()
 }
 
 // Double returns 2*(x1,y1).
 func (c curve) Double(x1, y1 *big.Int) (x, y *big.Int) {
-	a1 := NewAffine(x1, y1)
-	j1 := a1.Jacobian()
-	d := new(Jacobian)
-	d.Double(j1)
-	return d.Affine().Coordinates()
+	p := new(Point).Double(newpoint(x1, y1))
+	return p.coordinates()
 }
 
 // ScalarMult returns k*(x1,y1) where k is a number in big-endian form.
 func (c curve) ScalarMult(x1, y1 *big.Int, k []byte) (x, y *big.Int) {
-	// Implementation follows [msrecclibpaper] Algorithm 1.
+	p, err := new(Point).ScalarMult(newpoint(x1, y1), c.scalar(k))
+	if err != nil {
+		panic(err)
+	}
+	return p.coordinates()
+}
 
-	// Scalar recoding window size.
-	const w = ConstW
+// ScalarBaseMult returns k*G, where G is the base point of the group
+// and k is an integer in big-endian form.
+func (c curve) ScalarBaseMult(k []byte) (x, y *big.Int) {
+	p, err := new(Point).ScalarBaseMult(c.scalar(k))
+	if err != nil {
+		panic(err)
+	}
+	return p.coordinates()
+}
 
-	// Convert point from affine.
-	a := NewAffine(x1, y1)
-	p := a.Jacobian()
+// scalar reduces the big-endian integer k modulo the order N and returns its
+// encoding as a ScalarSize byte slice.
+func (c curve) scalar(k []byte) []byte {
+	K := new(big.Int).SetBytes(k)
+	if K.Cmp(c.N) >= 0 {
+		K.Mod(K, c.N)
+	}
+	return K.FillBytes(make([]byte, ScalarSize))
+}
 
-	// Step 1: scalar validation.
+// Inverse computes the inverse of k modulo the order N. Satisfies the
+// crypto/ecdsa.invertable interface.
+func (curve) Inverse(k *big.Int) *big.Int {
+	var (
+		K   scalar
+		inv scalar
+	)
+
+	K.SetInt(k)
+	scalarinv(&inv, &K)
+	return inv.Int()
+}
 
-	// TODO(mbm): exit if scalar is 0.
+// IsOnCurve reports whether the given (x,y) lies on the curve.
+func (c curve) IsOnCurve(x, y *big.Int) bool {
+	if x.Sign() < 0 || x.Cmp(c.P) >= 0 || y.Sign() < 0 || y.Cmp(c.P) >= 0 {
+		return false
+	}
+	return NewAffine(x, y).IsOnCurve() == 1
+}
+
+// newpoint converts affine coordinates to a point, following the
+// crypto/elliptic convention that (0,0) represents the point at infinity.
+// Panics if the point is not on the curve, matching the behavior of the
+// standard library curves.
+func newpoint(x, y *big.Int) *Point {
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return NewPoint()
+	}
+	a := NewAffine(x, y)
+	if a.IsOnCurve() != 1 {
+		panic(ConstCanonicalName + ": attempted operation on invalid point")
+	}
+	return &Point{p: *a.Projective()}
+}
+
+// coordinates returns the affine coordinates of p, or (0,0) for the point at
+// infinity.
+func (p *Point) coordinates() (x, y *big.Int) {
+	if p.IsIdentity() {
+		return new(big.Int), new(big.Int)
+	}
+	return p.p.Affine().Coordinates()
+}
 
+const (
+	// fieldsize is the size of an encoded field element in bytes.
+	fieldsize = ConstFieldSize
+
+	// ScalarSize is the size of an encoded scalar in bytes.
+	ScalarSize = ConstScalarSize
+
+	// UncompressedSize is the size of an uncompressed point encoding in bytes.
+	UncompressedSize = 1 + 2*fieldsize
+
+	// CompressedSize is the size of a compressed point encoding in bytes.
+	CompressedSize = 1 + fieldsize
+)
+
+var (
+	// curvea and curveb are the curve parameters a and b as field elements.
+	curvea, curveb Elt
+
+	// identity is the point at infinity.
+	identity Point
+
+	// generator is the base point of the group.
+	generator Point
+
+	// orderbits is the bit length of the order N.
+	orderbits int
+
+	// scalarzero is the zero scalar.
+	scalarzero scalar
+)
+
+// Point is a point on the CanonicalName curve. The zero value is not valid;
+// use NewPoint or NewGenerator to construct a point.
+type Point struct {
+	p Projective
+}
+
+// NewPoint returns a new point set to the identity.
+func NewPoint() *Point {
+	return new(Point).Set(&identity)
+}
+
+// NewGenerator returns a new point set to the canonical generator.
+func NewGenerator() *Point {
+	return new(Point).Set(&generator)
+}
+
+// Set sets p = q and returns p.
+func (p *Point) Set(q *Point) *Point {
+	p.p = q.p
+	return p
+}
+
+// IsIdentity reports whether p is the point at infinity.
+func (p *Point) IsIdentity() bool {
+	return IsZero(&p.p.Z) == 1
+}
+
+// SetBytes sets p to the point encoded in b, in either the uncompressed or
+// compressed form specified in SEC 1, Version 2.0, Section 2.3.4. The point at
+// infinity is encoded as the single byte 0x00. Returns an error if b is not a
+// valid encoding of a point on the curve, in which case p is unchanged.
+func (p *Point) SetBytes(b []byte) (*Point, error) {
+	switch {
+	case len(b) == 1 && b[0] == 0:
+		return p.Set(&identity), nil
+	case len(b) == UncompressedSize && b[0] == 4:
+		var a Affine
+		valid := a.X.SetCanonicalBytes(b[1 : 1+fieldsize])
+		valid &= a.Y.SetCanonicalBytes(b[1+fieldsize:])
+		valid &= a.IsOnCurve()
+		if valid != 1 {
+			return nil, errors.New("invalid point encoding")
+		}
+		p.p = *a.Projective()
+		return p, nil
+	case len(b) == CompressedSize && (b[0] == 2 || b[0] == 3):
+		var a Affine
+		valid := a.X.SetCanonicalBytes(b[1:])
+
+		// Recover y from the curve equation, choosing the root with the
+		// requested parity.
+		rhs(&a.Y, &a.X)
+		valid &= Sqrt(&a.Y, &a.Y)
+
+		odd := uint(b[0] & 1)
+		var neg Elt
+		Neg(&neg, &a.Y)
+		CMov(&a.Y, &neg, parity(&a.Y)^odd)
+
+		// Zero has no root of odd parity.
+		valid &= 1 ^ (IsZero(&a.Y) & odd)
+		valid &= a.IsOnCurve()
+
+		if valid != 1 {
+			return nil, errors.New("invalid point encoding")
+		}
+		p.p = *a.Projective()
+		return p, nil
+	default:
+		return nil, errors.New("invalid point encoding")
+	}
+}
+
+// Bytes returns the uncompressed encoding of p, as specified in SEC 1,
+// Version 2.0, Section 2.3.3. The point at infinity is encoded as the single
+// byte 0x00.
+func (p *Point) Bytes() []byte {
+	if p.IsIdentity() {
+		return []byte{0}
+	}
+	a := p.p.Affine()
+	b := make([]byte, UncompressedSize)
+	b[0] = 4
+	a.X.FillBytes(b[1 : 1+fieldsize])
+	a.Y.FillBytes(b[1+fieldsize:])
+	return b
+}
+
+// BytesCompressed returns the compressed encoding of p, as specified in SEC 1,
+// Version 2.0, Section 2.3.3. The point at infinity is encoded as the single
+// byte 0x00.
+func (p *Point) BytesCompressed() []byte {
+	if p.IsIdentity() {
+		return []byte{0}
+	}
+	a := p.p.Affine()
+	b := make([]byte, CompressedSize)
+	b[0] = 2 | byte(parity(&a.Y))
+	a.X.FillBytes(b[1:])
+	return b
+}
+
+// Add sets p = q + r and returns p.
+func (p *Point) Add(q, r *Point) *Point {
+	p.p.CompleteAdd(&q.p, &r.p)
+	return p
+}
+
+// Double sets p = 2*q and returns p.
+func (p *Point) Double(q *Point) *Point {
+	p.p.CompleteAdd(&q.p, &q.p)
+	return p
+}
+
+// ScalarMult sets p = k*q and returns p, where k is a big-endian integer of
+// ScalarSize bytes. Returns an error if k is not less than the order N.
+func (p *Point) ScalarMult(q *Point, k []byte) (*Point, error) {
+	if len(k) != ScalarSize {
+		return nil, errors.New("invalid scalar length")
+	}
 	var K scalar
-	K.SetBytesRaw(k)
+	if K.SetCanonicalBytesRaw(k) != 1 {
+		return nil, errors.New("scalar out of range")
+	}
+	if ConstGLV {
+		scalarmultglv(&p.p, &q.p, &K)
+	} else {
+		scalarmult(&p.p, &q.p, &K)
+	}
+	return p, nil
+}
+
+// ScalarBaseMult sets p = k*G and returns p, where G is the generator and k is
+// a big-endian integer of ScalarSize bytes. Returns an error if k is not less
+// than the order N.
+func (p *Point) ScalarBaseMult(k []byte) (*Point, error) {
+	return p.ScalarMult(&generator, k)
+}
+
+// DoubleScalarBaseMultVarTime sets p = u1*G + u2*q and returns p, where G is
+// the generator and u1, u2 are big-endian integers of ScalarSize bytes. Returns
+// an error if either scalar is not less than the order N.
+//
+// As the VarTime suffix indicates, this runs in time dependent on its inputs,
+// and must only be used with public values, such as in signature verification.
+func (p *Point) DoubleScalarBaseMultVarTime(u1 []byte, q *Point, u2 []byte) (*Point, error) {
+	if len(u1) != ScalarSize || len(u2) != ScalarSize {
+		return nil, errors.New("invalid scalar length")
+	}
+	var k1, k2 scalar
+	if k1.SetCanonicalBytesRaw(u1)&k2.SetCanonicalBytesRaw(u2) != 1 {
+		return nil, errors.New("scalar out of range")
+	}
+
+	// Interleaved wNAF multiplication, using the precomputed table for the
+	// generator and a table for q computed on the fly.
+	d1 := k1.WNAFRecodeVarTime(wnafbasew)
+	var d2 []int32
+	var tbl []Jacobian
+	if !q.IsIdentity() {
+		d2 = k2.WNAFRecodeVarTime(wnafw)
+		tbl = oddmultiples(jacobian(&q.p), wnafw)
+	}
+
+	n := len(d1)
+	if len(d2) > n {
+		n = len(d2)
+	}
+
+	// The accumulator starts at the identity, with Z = 0.
+	var acc Jacobian
+	for i := n - 1; i >= 0; i-- {
+		if IsZero(&acc.Z) == 0 {
+			acc.Double(&acc)
+		}
+		if i < len(d1) && d1[i] != 0 {
+			addvartime(&acc, basetable, d1[i])
+		}
+		if i < len(d2) && d2[i] != 0 {
+			addvartime(&acc, tbl, d2[i])
+		}
+	}
+
+	if IsZero(&acc.Z) == 1 {
+		return p.Set(&identity), nil
+	}
+	p.p = *acc.Projective()
+	return p, nil
+}
+
+// scalarmult sets p = k*q in constant time. The scalar k must be less than
+// the order N.
+func scalarmult(p, q *Projective, k *scalar) {
+	// Implementation follows [msrecclibpaper] Algorithm 1.
+
+	// Scalar recoding window size.
+	const w = ConstW
+
+	// Step 1: scalar validation.
+	//
+	// The recoding below requires a non-zero scalar and the point formulae
+	// require a point other than the identity. Substitute the scalar 1 and the
+	// generator in these cases, and replace the result with the identity at the
+	// end.
+	var zero scalar
+	infinity := uint(subtle.ConstantTimeCompare(k[:], zero[:]))
+	infinity |= IsZero(&q.Z)
+
+	K := *k
+	one := scalar{1}
+	scalarcmov(&K, &one, infinity)
+
+	P := *q
+	CMov(&P.X, &generator.p.X, infinity)
+	CMov(&P.Y, &generator.p.Y, infinity)
+	CMov(&P.Z, &generator.p.Z, infinity)
 
 	// Step 5: odd = k mod 2
 	// Step 6: if odd = 0 then k = r − k
@@ -88,42 +399,144 @@ func (c curve) ScalarMult(x1, y1 *big.Int, k []byte) (x, y *big.Int) {
 
 	// Step 4: Compute P[i] = (2i + 1)P for 0 ⩽ i < 2^{w−2}.
 	var tbl table
-	tbl.Precompute(p)
+	tbl.Precompute(jacobian(&P))
 
 	// Step 8: Q = s_t * P[(|k_t| − 1)/2]
-	var q, r Jacobian
+	var q0, r Jacobian
 
 	t := len(digits) - 1
-	tbl.Lookup(&q, digits[t])
+	tbl.Lookup(&q0, digits[t])
 
 	// Step 9: for i = (t − 1) to 1
 	for i := t - 1; i >= 1; i-- {
 		// Step 14: Q = 2^{w−1}Q
 		for j := 0; j < w-1; j++ {
-			q.Double(&q)
+			q0.Double(&q0)
 		}
 
 		// Step 15: Q = Q + s_i * P[(|k_i| − 1)/2]
 		tbl.Lookup(&r, digits[i])
-		q.Add(&q, &r)
+		q0.Add(&q0, &r)
 	}
 
 	// Step 18: Q = 2^{w−1}Q
 	for j := 0; j < w-1; j++ {
-		q.Double(&q)
+		q0.Double(&q0)
 	}
 
 	// Step 19: Q = Q ⊕ s₀ * P[(|k₀| − 1)/2]
 	tbl.Lookup(&r, digits[0])
 	rp := r.Projective()
-	qp := q.Projective()
+	qp := q0.Projective()
 	qp.CompleteAdd(qp, rp)
 
 	// Step 20: if odd = 0 then Q = −Q
 	qp.CNeg(even)
 
-	// Step 21: Convert Q to affine coordinates (x, y).
-	return qp.Affine().Coordinates()
+	// Replace with the identity for the special cases handled in step 1.
+	CMov(&qp.X, &identity.p.X, infinity)
+	CMov(&qp.Y, &identity.p.Y, infinity)
+	CMov(&qp.Z, &identity.p.Z, infinity)
+
+	*p = *qp
+}
+
+// jacobian converts a projective point to jacobian coordinates.
+func jacobian(p *Projective) *Jacobian {
+	j := new(Jacobian)
+	Mul(&j.X, &p.X, &p.Z)
+	Sqr(&j.Y, &p.Z)
+	Mul(&j.Y, &j.Y, &p.Y)
+	j.Z = p.Z
+	return j
+}
+
+// rhs sets z to the right-hand side of the curve equation x³ + ax + b.
+func rhs(z, x *Elt) {
+	var t Elt
+	Sqr(&t, x)
+	Add(&t, &t, &curvea)
+	Mul(z, &t, x)
+	Add(z, z, &curveb)
+}
+
+// parity returns the least significant bit of the canonical form of x.
+func parity(x *Elt) uint {
+	var b [fieldsize]byte
+	x.FillBytes(b[:])
+	return uint(b[fieldsize-1] & 1)
+}
+
+const (
+	// wnafbasew is the wNAF window size for multiples of the generator, which
+	// use the precomputed basetable.
+	wnafbasew = 7
+
+	// wnafw is the wNAF window size for multiples of other points, which use
+	// tables computed on the fly.
+	wnafw = 5
+)
+
+// basetable holds odd multiples of the generator for wNAF multiplication.
+var basetable []Jacobian
+
+// oddmultiples returns the odd multiples P, 3P, ..., (2^(w-1)-1)P of the point
+// p, which must not be the identity.
+func oddmultiples(p *Jacobian, w uint) []Jacobian {
+	t := make([]Jacobian, 1<<(w-2))
+	t[0].Set(p)
+
+	var _2p Jacobian
+	_2p.Double(p)
+
+	for i := 1; i < len(t); i++ {
+		t[i].Add(&t[i-1], &_2p)
+	}
+	return t
+}
+
+// addvartime sets p = p + digit*P, where tbl holds odd multiples of P as
+// returned by oddmultiples and digit is odd. The exceptional cases of the
+// jacobian addition formula are handled in variable time.
+func addvartime(p *Jacobian, tbl []Jacobian, digit int32) {
+	var q Jacobian
+	if digit > 0 {
+		q.Set(&tbl[digit/2])
+	} else {
+		q.Set(&tbl[-digit/2])
+		q.CNeg(1)
+	}
+
+	if IsZero(&p.Z) == 1 {
+		p.Set(&q)
+		return
+	}
+
+	prev := *p
+	p.Add(p, &q)
+	if IsZero(&p.Z) == 0 {
+		return
+	}
+
+	// The addition formula produces Z = 0 when the inputs have equal
+	// x-coordinates. That is the correct result when q = -p, but if q = p the
+	// result must be computed by doubling.
+	if equaly(&prev, &q) {
+		p.Double(&prev)
+	}
+}
+
+// equaly reports whether the jacobian points p and q have equal y-coordinates
+// in affine form. Runs in variable time.
+func equaly(p, q *Jacobian) bool {
+	var z, a, b Elt
+	Sqr(&z, &q.Z)
+	Mul(&z, &z, &q.Z)
+	Mul(&a, &p.Y, &z)
+	Sqr(&z, &p.Z)
+	Mul(&z, &z, &p.Z)
+	Mul(&b, &q.Y, &z)
+	return Equal(&a, &b) == 1
 }
 
 // tablesize is the size of the lookup table used by ScalarMult.
@@ -162,22 +575,3 @@ func abs(x int32) int32 {
 func sign(x int32) uint {
 	return uint(x>>31) & 1
 }
-
-// ScalarBaseMult returns k*G, where G is the base point of the group
-// and k is an integer in big-endian form.
-func (c curve) ScalarBaseMult(k []byte) (x, y *big.Int) {
-	return c.ScalarMult(c.Gx, c.Gy, k)
-}
-
-// Inverse computes the inverse of k modulo the order N. Satisfies the
-// crypto/ecdsa.invertable interface.
-func (curve) Inverse(k *big.Int) *big.Int {
-	var (
-		K   scalar
-		inv scalar
-	)
-
-	K.SetInt(k)
-	scalarinv(&inv, &K)
-	return inv.Int()
-}
@@ -3,14 +3,13 @@
 package shortw
 
 import (
+	"bytes"
+	"crypto/elliptic"
 	"math/big"
 	"testing"
 )
 
-var (
-	cur = CURVENAME()
-	ref = curvename.CurveParams
-)
+var cur = CURVENAME()
 
 func TestCurveAddRand(t *testing.T) {
 	for trial := 0; trial < ConstNumTrials; trial++ {
@@ -102,6 +101,345 @@ func TestCurveInverseRand(t *testing.T) {
 	}
 }
 
+func TestPointBytesRoundTrip(t *testing.T) {
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		x, y := RandPoint(t)
+		b := elliptic.Marshal(ref, x, y)
+
+		p, err := new(Point).SetBytes(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(p.Bytes(), b) {
+			t.Fatal("round trip mismatch")
+		}
+	}
+}
+
+func TestPointBytesCompressedRoundTrip(t *testing.T) {
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		x, y := RandPoint(t)
+		b := elliptic.MarshalCompressed(ref, x, y)
+
+		p, err := new(Point).SetBytes(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(p.BytesCompressed(), b) {
+			t.Fatal("compressed round trip mismatch")
+		}
+
+		if !bytes.Equal(p.Bytes(), elliptic.Marshal(ref, x, y)) {
+			t.Fatal("decompressed point mismatch")
+		}
+	}
+}
+
+func TestPointIdentity(t *testing.T) {
+	p := NewPoint()
+	if !p.IsIdentity() {
+		t.Fatal("expected identity")
+	}
+	if !bytes.Equal(p.Bytes(), []byte{0}) {
+		t.Fatal("unexpected encoding of identity")
+	}
+	if !bytes.Equal(p.BytesCompressed(), []byte{0}) {
+		t.Fatal("unexpected compressed encoding of identity")
+	}
+
+	q, err := new(Point).SetBytes([]byte{0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !q.IsIdentity() {
+		t.Fatal("expected identity")
+	}
+}
+
+func TestPointSetBytesInvalid(t *testing.T) {
+	x, y := RandPoint(t)
+	valid := elliptic.Marshal(ref, x, y)
+
+	// Coordinate out of range.
+	P := ref.P.FillBytes(make([]byte, fieldsize))
+	outofrange := append([]byte{4}, P...)
+	outofrange = append(outofrange, valid[1+fieldsize:]...)
+
+	// Point not on the curve.
+	offcurve := append([]byte{}, valid...)
+	offcurve[len(offcurve)-1] ^= 1
+
+	cases := map[string][]byte{
+		"empty":           {},
+		"short":           valid[:len(valid)-1],
+		"long":            append(append([]byte{}, valid...), 0),
+		"prefix":          append([]byte{5}, valid[1:]...),
+		"identity_long":   {0, 0},
+		"out_of_range":    outofrange,
+		"not_on_curve":    offcurve,
+		"compressed_long": append([]byte{2}, valid[1:]...),
+		"compressed_x":    NonSquareCompressed(t),
+	}
+	for name, b := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := NewGenerator()
+			if _, err := p.SetBytes(b); err == nil {
+				t.Fatal("expected error")
+			}
+			if !bytes.Equal(p.Bytes(), NewGenerator().Bytes()) {
+				t.Fatal("point modified on error")
+			}
+		})
+	}
+}
+
+func TestPointSetBytesInvalidCurve(t *testing.T) {
+	// Points on other curves y² = x³ + ax + b' are the basis of invalid-curve
+	// attacks. A random pair of coordinates lies on such a curve.
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		x, y := RandInvalidPoint(t)
+		b := UncompressedEncoding(x, y)
+		if _, err := new(Point).SetBytes(b); err == nil {
+			t.Fatalf("accepted invalid point %x", b)
+		}
+	}
+}
+
+func TestPointSetBytesInvalidCurveEdgeCases(t *testing.T) {
+	zero := new(big.Int)
+	one := big.NewInt(1)
+	x, y := RandPoint(t)
+	sx, sy := SmallPoint(t)
+	cases := map[string][2]*big.Int{
+		// The crypto/elliptic representation of infinity is not a valid
+		// encoding.
+		"zero": {zero, zero},
+		// Points with y = 0 have order two on curves with a root at x.
+		"y_zero": {x, zero},
+		"x_zero": {zero, one},
+		// Coordinate outside the field, that would be valid if reduced.
+		"x_plus_p": {new(big.Int).Add(sx, ref.P), sy},
+		// Negation of a point component.
+		"neg_x": {new(big.Int).Sub(ref.P, x), y},
+	}
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			b := UncompressedEncoding(c[0], c[1])
+			if _, err := new(Point).SetBytes(b); err == nil {
+				t.Fatalf("accepted invalid point %x", b)
+			}
+		})
+	}
+}
+
+func TestSmallPointValid(t *testing.T) {
+	x, y := SmallPoint(t)
+	if _, err := new(Point).SetBytes(UncompressedEncoding(x, y)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCurveIsOnCurve(t *testing.T) {
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		x, y := RandPoint(t)
+		if !cur.IsOnCurve(x, y) {
+			t.Fatal("valid point reported off curve")
+		}
+		if cur.IsOnCurve(new(big.Int).Add(x, ref.P), y) {
+			t.Fatal("unreduced point reported on curve")
+		}
+
+		x, y = RandInvalidPoint(t)
+		if cur.IsOnCurve(x, y) {
+			t.Fatal("invalid point reported on curve")
+		}
+	}
+}
+
+func TestCurveScalarMultInvalidPoint(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	x, y := RandInvalidPoint(t)
+	k := RandScalarNonZero(t)
+	cur.ScalarMult(x, y, k.Bytes())
+}
+
+func TestPointAddRand(t *testing.T) {
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		x1, y1 := RandPoint(t)
+		x2, y2 := RandPoint(t)
+
+		p := new(Point).Add(MarshalPoint(t, x1, y1), MarshalPoint(t, x2, y2))
+
+		ex, ey := ref.Add(x1, y1, x2, y2)
+		EqualPoint(t, ex, ey, p)
+	}
+}
+
+func TestPointDoubleRand(t *testing.T) {
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		x, y := RandPoint(t)
+
+		p := MarshalPoint(t, x, y)
+		p.Double(p)
+
+		ex, ey := ref.Double(x, y)
+		EqualPoint(t, ex, ey, p)
+	}
+}
+
+func TestPointScalarMultRand(t *testing.T) {
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		k := RandScalarNonZero(t)
+		x, y := RandPoint(t)
+
+		p, err := new(Point).ScalarMult(MarshalPoint(t, x, y), ScalarBytes(k))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ex, ey := ref.ScalarMult(x, y, k.Bytes())
+		EqualPoint(t, ex, ey, p)
+	}
+}
+
+func TestPointScalarBaseMultRand(t *testing.T) {
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		k := RandScalarNonZero(t)
+
+		p, err := new(Point).ScalarBaseMult(ScalarBytes(k))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ex, ey := ref.ScalarBaseMult(k.Bytes())
+		EqualPoint(t, ex, ey, p)
+	}
+}
+
+func TestPointScalarMultEdgeCases(t *testing.T) {
+	// Zero scalar.
+	p, err := new(Point).ScalarBaseMult(make([]byte, ScalarSize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.IsIdentity() {
+		t.Fatal("expected identity for zero scalar")
+	}
+
+	// Identity point.
+	k := RandScalarNonZero(t)
+	p, err = new(Point).ScalarMult(NewPoint(), ScalarBytes(k))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.IsIdentity() {
+		t.Fatal("expected identity for identity input")
+	}
+
+	// Scalar equal to the order.
+	if _, err := new(Point).ScalarBaseMult(ScalarBytes(ref.N)); err == nil {
+		t.Fatal("expected error for unreduced scalar")
+	}
+
+	// Wrong length scalar.
+	if _, err := new(Point).ScalarBaseMult(make([]byte, ScalarSize+1)); err == nil {
+		t.Fatal("expected error for scalar of incorrect length")
+	}
+}
+
+func TestPointDoubleScalarBaseMultVarTimeRand(t *testing.T) {
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		u1 := RandScalarNonZero(t)
+		u2 := RandScalarNonZero(t)
+		x, y := RandPoint(t)
+
+		p, err := new(Point).DoubleScalarBaseMultVarTime(ScalarBytes(u1), MarshalPoint(t, x, y), ScalarBytes(u2))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		x1, y1 := ref.ScalarBaseMult(u1.Bytes())
+		x2, y2 := ref.ScalarMult(x, y, u2.Bytes())
+		ex, ey := ref.Add(x1, y1, x2, y2)
+		EqualPoint(t, ex, ey, p)
+	}
+}
+
+func TestPointDoubleScalarBaseMultVarTimeEdgeCases(t *testing.T) {
+	N := curvename.Params().N
+	k := RandScalarNonZero(t)
+	negk := new(big.Int).Sub(N, k)
+	zero := new(big.Int)
+	x, y := RandPoint(t)
+	gx, gy := curvename.Params().Gx, curvename.Params().Gy
+
+	// Scalars with all-ones words exercise carries in wNAF recoding.
+	ones := func(n uint) *big.Int {
+		one := big.NewInt(1)
+		k := new(big.Int).Lsh(one, n)
+		return k.Sub(k, one)
+	}
+
+	cases := []struct {
+		Name   string
+		U1, U2 *big.Int
+		X, Y   *big.Int // zero for the identity
+	}{
+		{Name: "zero_u1", U1: zero, U2: k, X: x, Y: y},
+		{Name: "zero_u2", U1: k, U2: zero, X: x, Y: y},
+		{Name: "zero_both", U1: zero, U2: zero, X: x, Y: y},
+		{Name: "identity_q", U1: k, U2: k, X: zero, Y: zero},
+		{Name: "generator_double", U1: k, U2: k, X: gx, Y: gy},
+		{Name: "generator_cancel", U1: k, U2: negk, X: gx, Y: gy},
+		{Name: "one_one", U1: big.NewInt(1), U2: big.NewInt(1), X: gx, Y: gy},
+		{Name: "ones_words", U1: ones(65), U2: ones(129), X: x, Y: y},
+		{Name: "ones_words_negated", U1: new(big.Int).Sub(N, ones(64)), U2: ones(128), X: x, Y: y},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			q := NewPoint()
+			if c.X.Sign() != 0 {
+				q = MarshalPoint(t, c.X, c.Y)
+			}
+
+			p, err := new(Point).DoubleScalarBaseMultVarTime(ScalarBytes(c.U1), q, ScalarBytes(c.U2))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			x1, y1 := ref.ScalarBaseMult(c.U1.Bytes())
+			x2, y2 := ref.ScalarMult(c.X, c.Y, c.U2.Bytes())
+			ex, ey := ref.Add(x1, y1, x2, y2)
+			EqualPoint(t, ex, ey, p)
+		})
+	}
+}
+
+func TestPointDoubleScalarBaseMultVarTimeInvalidScalar(t *testing.T) {
+	N := ScalarBytes(curvename.Params().N)
+	k := ScalarBytes(RandScalarNonZero(t))
+	g := NewGenerator()
+
+	if _, err := new(Point).DoubleScalarBaseMultVarTime(N, g, k); err == nil {
+		t.Error("expected error for out of range u1")
+	}
+	if _, err := new(Point).DoubleScalarBaseMultVarTime(k, g, N); err == nil {
+		t.Error("expected error for out of range u2")
+	}
+	if _, err := new(Point).DoubleScalarBaseMultVarTime(k[1:], g, k); err == nil {
+		t.Error("expected error for short scalar")
+	}
+}
+
 func BenchmarkScalarMult(b *testing.B) {
 	x, y := RandPoint(b)
 	K := RandScalarNonZero(b)
@@ -120,3 +458,25 @@ func BenchmarkScalarBaseMult(b *testing.B) {
 		cur.ScalarBaseMult(k)
 	}
 }
+
+func BenchmarkPointScalarBaseMult(b *testing.B) {
+	K := RandScalarNonZero(b)
+	k := ScalarBytes(K)
+	p := NewPoint()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.ScalarBaseMult(k)
+	}
+}
+
+func BenchmarkPointDoubleScalarBaseMultVarTime(b *testing.B) {
+	u1 := ScalarBytes(RandScalarNonZero(b))
+	u2 := ScalarBytes(RandScalarNonZero(b))
+	x, y := RandPoint(b)
+	q := MarshalPoint(b, x, y)
+	p := NewPoint()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.DoubleScalarBaseMultVarTime(u1, q, u2)
+	}
+}
@@ -0,0 +1,152 @@
+// CodeGenerationWarning
+
+package shortw
+
+import (
+	"crypto"
+	"crypto/subtle"
+	"errors"
+	"io"
+)
+
+// References:
+//
+//	[sec1]  Certicom Research. SEC 1: Elliptic Curve Cryptography, Version 2.0. 2009.
+//	        https://www.secg.org/sec1-v2.pdf
+
+// PrivateKey is an ECDH private key on the CanonicalName curve.
+type PrivateKey struct {
+	d         [ScalarSize]byte
+	publicKey *PublicKey
+}
+
+// PublicKey is an ECDH public key on the CanonicalName curve.
+type PublicKey struct {
+	q Point
+	b []byte
+}
+
+// GenerateKey generates a random private key, reading entropy from rand.
+func GenerateKey(rand io.Reader) (*PrivateKey, error) {
+	// Rejection sampling: mask excess high bits and retry until the candidate
+	// is in the range [1, N).
+	excess := uint(8*ScalarSize - orderbits)
+	for {
+		var d [ScalarSize]byte
+		if _, err := io.ReadFull(rand, d[:]); err != nil {
+			return nil, err
+		}
+		d[0] &= 0xff >> excess
+
+		if k, err := NewPrivateKey(d[:]); err == nil {
+			return k, nil
+		}
+	}
+}
+
+// NewPrivateKey checks that key is a valid private key and returns it. The
+// key must be a big-endian integer of ScalarSize bytes in the range [1, N);
+// out of range keys are rejected rather than reduced.
+func NewPrivateKey(key []byte) (*PrivateKey, error) {
+	if len(key) != ScalarSize {
+		return nil, errors.New("invalid private key length")
+	}
+	var K scalar
+	valid := K.SetCanonicalBytes(key)
+	valid &= 1 ^ scalarequal(&K, &scalarzero)
+	if valid != 1 {
+		return nil, errors.New("private key out of range")
+	}
+
+	k := &PrivateKey{}
+	copy(k.d[:], key)
+
+	q, err := new(Point).ScalarBaseMult(k.d[:])
+	if err != nil {
+		return nil, err
+	}
+	k.publicKey = &PublicKey{q: *q, b: q.Bytes()}
+
+	return k, nil
+}
+
+// Bytes returns a copy of the encoding of the private key.
+func (k *PrivateKey) Bytes() []byte {
+	b := make([]byte, ScalarSize)
+	copy(b, k.d[:])
+	return b
+}
+
+// PublicKey returns the public key corresponding to k.
+func (k *PrivateKey) PublicKey() *PublicKey {
+	return k.publicKey
+}
+
+// Public returns the public key corresponding to k, implementing the implicit
+// interface of standard library private keys.
+func (k *PrivateKey) Public() crypto.PublicKey {
+	return k.PublicKey()
+}
+
+// Equal reports whether x is a private key with the same value as k, in
+// constant time.
+func (k *PrivateKey) Equal(x crypto.PrivateKey) bool {
+	xx, ok := x.(*PrivateKey)
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare(k.d[:], xx.d[:]) == 1
+}
+
+// ECDH performs an ECDH exchange and returns the shared secret, the
+// x-coordinate of the shared point encoded as specified in [sec1] Section
+// 3.3.1. Returns an error if the shared point is the identity.
+func (k *PrivateKey) ECDH(remote *PublicKey) ([]byte, error) {
+	p, err := new(Point).ScalarMult(&remote.q, k.d[:])
+	if err != nil {
+		return nil, err
+	}
+	if p.IsIdentity() {
+		return nil, errors.New("shared point is the identity")
+	}
+	secret := make([]byte, fieldsize)
+	p.p.Affine().X.FillBytes(secret)
+	return secret, nil
+}
+
+// NewPublicKey checks that key is a valid public key and returns it. The key
+// must be an uncompressed point encoding, as specified in [sec1] Section
+// 2.3.3. The identity is rejected.
+func NewPublicKey(key []byte) (*PublicKey, error) {
+	if len(key) != UncompressedSize || key[0] != 4 {
+		return nil, errors.New("invalid public key encoding")
+	}
+	q, err := new(Point).SetBytes(key)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, len(key))
+	copy(b, key)
+	return &PublicKey{q: *q, b: b}, nil
+}
+
+// Bytes returns a copy of the uncompressed encoding of the public key.
+func (k *PublicKey) Bytes() []byte {
+	b := make([]byte, len(k.b))
+	copy(b, k.b)
+	return b
+}
+
+// Point returns the public key as a point.
+func (k *PublicKey) Point() *Point {
+	return new(Point).Set(&k.q)
+}
+
+// Equal reports whether x is a public key with the same value as k.
+func (k *PublicKey) Equal(x crypto.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare(k.b, xx.b) == 1
+}
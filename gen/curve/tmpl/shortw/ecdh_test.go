@@ -0,0 +1,162 @@
+// CodeGenerationWarning
+
+package shortw
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"testing/iotest"
+)
+
+func TestECDHSharedSecret(t *testing.T) {
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		a, err := GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ab, err := a.ECDH(b.PublicKey())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ba, err := b.ECDH(a.PublicKey())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(ab, ba) {
+			t.Fatal("shared secrets differ")
+		}
+
+		// Compare with the reference implementation.
+		x, y := elliptic.Unmarshal(ref, b.PublicKey().Bytes())
+		ex, _ := ref.ScalarMult(x, y, a.Bytes())
+		expect := ex.FillBytes(make([]byte, len(ab)))
+		if !bytes.Equal(ab, expect) {
+			t.Logf("   got %x", ab)
+			t.Logf("expect %x", expect)
+			t.Fatal("shared secret mismatch")
+		}
+	}
+}
+
+func TestGenerateKeyRejectionSampling(t *testing.T) {
+	// The order itself must be rejected, after which the key 1 is accepted.
+	one := make([]byte, ScalarSize)
+	one[ScalarSize-1] = 1
+	r := bytes.NewReader(append(ScalarBytes(curvename.N), one...))
+
+	k, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(k.Bytes(), one) {
+		t.Fatalf("got key %x", k.Bytes())
+	}
+}
+
+func TestGenerateKeyReaderError(t *testing.T) {
+	r := iotest.ErrReader(errors.New("entropy exhausted"))
+	if _, err := GenerateKey(r); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestNewPrivateKeyInvalid(t *testing.T) {
+	cases := map[string][]byte{
+		"zero":  make([]byte, ScalarSize),
+		"order": ScalarBytes(curvename.N),
+		"max":   bytes.Repeat([]byte{0xff}, ScalarSize),
+		"short": make([]byte, ScalarSize-1),
+		"long":  make([]byte, ScalarSize+1),
+	}
+	for name, key := range cases {
+		key := key
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewPrivateKey(key); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestNewPublicKeyInvalid(t *testing.T) {
+	k, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := k.PublicKey().Bytes()
+
+	offcurve := append([]byte{}, valid...)
+	offcurve[len(offcurve)-1] ^= 1
+
+	cases := map[string][]byte{
+		"identity":   {0},
+		"compressed": k.PublicKey().Point().BytesCompressed(),
+		"offcurve":   offcurve,
+		"short":      valid[:len(valid)-1],
+		"empty":      {},
+	}
+	for name, key := range cases {
+		key := key
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewPublicKey(key); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestKeyRoundTrip(t *testing.T) {
+	k, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	priv, err := NewPrivateKey(k.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !priv.Equal(k) || !priv.PublicKey().Equal(k.PublicKey()) {
+		t.Fatal("private key round trip failed")
+	}
+
+	pub, err := NewPublicKey(k.PublicKey().Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pub.Equal(k.PublicKey()) {
+		t.Fatal("public key round trip failed")
+	}
+
+	other, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k.Equal(other) || k.PublicKey().Equal(other.PublicKey()) {
+		t.Fatal("distinct keys reported equal")
+	}
+}
+
+func BenchmarkECDH(b *testing.B) {
+	k, err := GenerateKey(rand.Reader)
+	if err != nil {
+		b.Fatal(err)
+	}
+	remote, err := GenerateKey(rand.Reader)
+	if err != nil {
+		b.Fatal(err)
+	}
+	pub := remote.PublicKey()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = k.ECDH(pub)
+	}
+}
@@ -0,0 +1,333 @@
+// CodeGenerationWarning
+
+package shortw
+
+import (
+	"crypto/hmac"
+	"errors"
+	"hash"
+)
+
+// References:
+//
+//	[rfc6979]  T. Pornin. Deterministic Usage of the Digital Signature Algorithm (DSA) and
+//	           Elliptic Curve Digital Signature Algorithm (ECDSA). RFC 6979. 2013.
+//	           https://tools.ietf.org/html/rfc6979
+//
+//	[sec1]     Certicom Research. SEC 1: Elliptic Curve Cryptography, Version 2.0. 2009.
+//	           https://www.secg.org/sec1-v2.pdf
+
+// Sign computes an ECDSA signature of digest with the private key d, which
+// must be a big-endian integer of ScalarSize bytes in the range [1, N). The
+// nonce is derived deterministically from d and digest as specified in
+// [rfc6979] with the hash function h, which should be the same hash used to
+// compute digest. Returns the signature values r and s as big-endian integers
+// of ScalarSize bytes.
+//
+// Operations involving the private key and nonce run in constant time.
+func Sign(d, digest []byte, h func() hash.Hash) (r, s []byte, err error) {
+	if len(d) != ScalarSize {
+		return nil, nil, errors.New("invalid private key length")
+	}
+	var D scalar
+	valid := D.SetCanonicalBytes(d)
+	valid &= 1 ^ scalarequal(&D, &scalarzero)
+	if valid != 1 {
+		return nil, nil, errors.New("private key out of range")
+	}
+
+	var E scalar
+	E.SetBytesWide(bits2int(digest))
+
+	g := newnonces(h, d, digest)
+	for {
+		k := g.next()
+
+		// Compute r as the x-coordinate of k*G, reduced modulo N.
+		R, err := new(Point).ScalarBaseMult(k)
+		if err != nil {
+			return nil, nil, err
+		}
+		var x [fieldsize]byte
+		R.p.Affine().X.FillBytes(x[:])
+
+		var Rs scalar
+		Rs.SetBytesWide(x[:])
+
+		// Compute s = k⁻¹(e + r*d).
+		var K, Ss scalar
+		K.SetCanonicalBytes(k)
+		scalarinv(&K, &K)
+		scalarmul(&Ss, &Rs, &D)
+		scalaradd(&Ss, &Ss, &E)
+		scalarmul(&Ss, &Ss, &K)
+
+		// In the negligible case that either is zero, proceed to the next nonce.
+		if scalarequal(&Rs, &scalarzero)|scalarequal(&Ss, &scalarzero) != 0 {
+			continue
+		}
+
+		r = Rs.FillBytes(make([]byte, ScalarSize))
+		s = Ss.FillBytes(make([]byte, ScalarSize))
+		return r, s, nil
+	}
+}
+
+// SignASN1 computes an ECDSA signature of digest with the private key d, as
+// for Sign, and returns it in the ASN.1 DER encoding of [sec1] Section C.8.
+func SignASN1(d, digest []byte, h func() hash.Hash) ([]byte, error) {
+	r, s, err := Sign(d, digest, h)
+	if err != nil {
+		return nil, err
+	}
+	return marshalsig(r, s), nil
+}
+
+// Verify reports whether (r, s) is a valid ECDSA signature of digest for the
+// public key q. The signature values are big-endian integers of ScalarSize
+// bytes.
+func Verify(q *Point, digest, r, s []byte) bool {
+	if q.IsIdentity() || len(r) != ScalarSize || len(s) != ScalarSize {
+		return false
+	}
+
+	// Signature values must be in the range [1, N).
+	var Rs, Ss scalar
+	valid := Rs.SetCanonicalBytes(r)
+	valid &= Ss.SetCanonicalBytes(s)
+	valid &= 1 ^ scalarequal(&Rs, &scalarzero)
+	valid &= 1 ^ scalarequal(&Ss, &scalarzero)
+	if valid != 1 {
+		return false
+	}
+
+	// Compute u1 = e/s and u2 = r/s.
+	var E, W, U1, U2 scalar
+	E.SetBytesWide(bits2int(digest))
+	scalarinv(&W, &Ss)
+	scalarmul(&U1, &E, &W)
+	scalarmul(&U2, &Rs, &W)
+
+	u1 := U1.FillBytes(make([]byte, ScalarSize))
+	u2 := U2.FillBytes(make([]byte, ScalarSize))
+
+	// Signature is valid if the x-coordinate of u1*G + u2*Q is r modulo N.
+	R, err := new(Point).DoubleScalarBaseMultVarTime(u1, q, u2)
+	if err != nil || R.IsIdentity() {
+		return false
+	}
+
+	var x [fieldsize]byte
+	R.p.Affine().X.FillBytes(x[:])
+
+	var V scalar
+	V.SetBytesWide(x[:])
+	return scalarequal(&V, &Rs) == 1
+}
+
+// VerifyASN1 reports whether sig is a valid ASN.1 DER encoded ECDSA signature
+// of digest for the public key q. Encodings that are not strictly DER are
+// rejected.
+func VerifyASN1(q *Point, digest, sig []byte) bool {
+	r, s, ok := parsesig(sig)
+	if !ok {
+		return false
+	}
+	return Verify(q, digest, r, s)
+}
+
+// nonces generates the sequence of candidate nonces of [rfc6979] Section 3.2.
+type nonces struct {
+	h    func() hash.Hash
+	k, v []byte
+	more bool
+}
+
+// newnonces initializes nonce generation for private key d and message digest,
+// following [rfc6979] Section 3.2 steps b to g.
+func newnonces(h func() hash.Hash, d, digest []byte) *nonces {
+	size := h().Size()
+	g := &nonces{
+		h: h,
+		k: make([]byte, size),
+		v: make([]byte, size),
+	}
+	for i := range g.v {
+		g.v[i] = 1
+	}
+
+	// bits2octets(digest) is the encoding of bits2int(digest) mod N.
+	var e scalar
+	e.SetBytesWide(bits2int(digest))
+	h1 := e.FillBytes(make([]byte, ScalarSize))
+
+	g.k = g.mac(g.v, []byte{0}, d, h1)
+	g.v = g.mac(g.v)
+	g.k = g.mac(g.v, []byte{1}, d, h1)
+	g.v = g.mac(g.v)
+
+	return g
+}
+
+// next returns the next nonce in the range [1, N), as a big-endian integer of
+// ScalarSize bytes.
+func (g *nonces) next() []byte {
+	for {
+		// Step h.3: update state between candidates.
+		if g.more {
+			g.k = g.mac(g.v, []byte{0})
+			g.v = g.mac(g.v)
+		}
+		g.more = true
+
+		// Step h.1 and h.2: generate a candidate.
+		var t []byte
+		for len(t) < ScalarSize {
+			g.v = g.mac(g.v)
+			t = append(t, g.v...)
+		}
+		k := bits2int(t)
+
+		var K scalar
+		valid := K.SetCanonicalBytes(k)
+		valid &= 1 ^ scalarequal(&K, &scalarzero)
+		if valid == 1 {
+			return k
+		}
+	}
+}
+
+// mac returns the HMAC of the concatenation of data with the current key.
+func (g *nonces) mac(data ...[]byte) []byte {
+	m := hmac.New(g.h, g.k)
+	for _, d := range data {
+		m.Write(d)
+	}
+	return m.Sum(nil)
+}
+
+// bits2int returns the big-endian integer formed from the leftmost orderbits
+// bits of b, encoded as ScalarSize bytes. See [rfc6979] Section 2.3.2.
+func bits2int(b []byte) []byte {
+	if len(b) > ScalarSize {
+		b = b[:ScalarSize]
+	}
+	x := make([]byte, ScalarSize)
+	copy(x[ScalarSize-len(b):], b)
+
+	// Shift out any excess low bits.
+	if 8*len(b) <= orderbits {
+		return x
+	}
+	excess := uint(8*len(b) - orderbits)
+	for i := ScalarSize - 1; i > 0; i-- {
+		x[i] = x[i]>>excess | x[i-1]<<(8-excess)
+	}
+	x[0] >>= excess
+	return x
+}
+
+// marshalsig returns the DER encoding of the signature (r, s).
+func marshalsig(r, s []byte) []byte {
+	content := append(derint(r), derint(s)...)
+	return append(derheader(0x30, len(content)), content...)
+}
+
+// derint returns the DER encoding of the non-negative big-endian integer x.
+func derint(x []byte) []byte {
+	for len(x) > 1 && x[0] == 0 {
+		x = x[1:]
+	}
+	if len(x) == 0 || x[0]&0x80 != 0 {
+		x = append([]byte{0}, x...)
+	}
+	return append(derheader(0x02, len(x)), x...)
+}
+
+// derheader returns the DER tag and length octets for content of length n.
+func derheader(tag byte, n int) []byte {
+	switch {
+	case n < 0x80:
+		return []byte{tag, byte(n)}
+	case n < 0x100:
+		return []byte{tag, 0x81, byte(n)}
+	default:
+		return []byte{tag, 0x82, byte(n >> 8), byte(n)}
+	}
+}
+
+// parsesig parses a DER encoded signature, returning r and s as big-endian
+// integers of ScalarSize bytes.
+func parsesig(sig []byte) (r, s []byte, ok bool) {
+	content, rest, ok := dertlv(sig, 0x30)
+	if !ok || len(rest) != 0 {
+		return nil, nil, false
+	}
+	ri, content, ok := dertlv(content, 0x02)
+	if !ok {
+		return nil, nil, false
+	}
+	si, content, ok := dertlv(content, 0x02)
+	if !ok || len(content) != 0 {
+		return nil, nil, false
+	}
+	if r, ok = derscalar(ri); !ok {
+		return nil, nil, false
+	}
+	if s, ok = derscalar(si); !ok {
+		return nil, nil, false
+	}
+	return r, s, true
+}
+
+// dertlv parses a DER element with the given tag from the start of b,
+// returning its content and the remaining bytes. Only the length encodings
+// that derheader can produce are accepted.
+func dertlv(b []byte, tag byte) (content, rest []byte, ok bool) {
+	if len(b) < 2 || b[0] != tag {
+		return nil, nil, false
+	}
+	n, b := int(b[1]), b[2:]
+	switch {
+	case n < 0x80:
+	case n == 0x81:
+		// Long form is only permitted for lengths that require it.
+		if len(b) < 1 || b[0] < 0x80 {
+			return nil, nil, false
+		}
+		n, b = int(b[0]), b[1:]
+	case n == 0x82:
+		if len(b) < 2 || b[0] == 0 {
+			return nil, nil, false
+		}
+		n, b = int(b[0])<<8|int(b[1]), b[2:]
+	default:
+		return nil, nil, false
+	}
+	if len(b) < n {
+		return nil, nil, false
+	}
+	return b[:n], b[n:], true
+}
+
+// derscalar converts the content of a DER INTEGER to a big-endian integer of
+// ScalarSize bytes. Negative, non-minimal and oversized encodings are rejected.
+func derscalar(c []byte) ([]byte, bool) {
+	switch {
+	case len(c) == 0:
+		return nil, false
+	case c[0]&0x80 != 0:
+		return nil, false
+	case len(c) > 1 && c[0] == 0 && c[1]&0x80 == 0:
+		return nil, false
+	}
+	if c[0] == 0 {
+		c = c[1:]
+	}
+	if len(c) > ScalarSize {
+		return nil, false
+	}
+	x := make([]byte, ScalarSize)
+	copy(x[ScalarSize-len(c):], c)
+	return x, true
+}
@@ -0,0 +1,222 @@
+// CodeGenerationWarning
+
+package shortw
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	_ "crypto/sha256" // register hash functions
+	_ "crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"testing"
+)
+
+func TestECDSASignRand(t *testing.T) {
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		d := RandScalarNonZero(t)
+		pub := ECDSAPublicKey(d)
+		q := MarshalPoint(t, pub.X, pub.Y)
+		digest := RandDigest(t)
+
+		r, s, err := Sign(ScalarBytes(d), digest, crypto.SHA256.New)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !Verify(q, digest, r, s) {
+			t.Fatal("signature failed verification")
+		}
+
+		// Confirm with the standard library.
+		R, S := new(big.Int).SetBytes(r), new(big.Int).SetBytes(s)
+		if !ecdsa.Verify(pub, digest, R, S) {
+			t.Fatal("signature failed verification with crypto/ecdsa")
+		}
+	}
+}
+
+func TestECDSASignDeterministic(t *testing.T) {
+	d := ScalarBytes(RandScalarNonZero(t))
+	digest := RandDigest(t)
+
+	sig1, err := SignASN1(d, digest, crypto.SHA256.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig2, err := SignASN1(d, digest, crypto.SHA256.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hex.EncodeToString(sig1) != hex.EncodeToString(sig2) {
+		t.Fatal("signatures differ")
+	}
+}
+
+func TestECDSASignInvalidKey(t *testing.T) {
+	digest := RandDigest(t)
+	cases := map[string][]byte{
+		"zero":  make([]byte, ScalarSize),
+		"order": ScalarBytes(curvename.N),
+		"short": make([]byte, ScalarSize-1),
+	}
+	for name, d := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := Sign(d, digest, crypto.SHA256.New); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestECDSAVerifyRand(t *testing.T) {
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		d := RandScalarNonZero(t)
+		pub := ECDSAPublicKey(d)
+		q := MarshalPoint(t, pub.X, pub.Y)
+		digest := RandDigest(t)
+
+		priv := &ecdsa.PrivateKey{PublicKey: *pub, D: d}
+		sig, err := ecdsa.SignASN1(rand.Reader, priv, digest)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !VerifyASN1(q, digest, sig) {
+			t.Fatal("crypto/ecdsa signature failed verification")
+		}
+
+		digest[0] ^= 1
+		if VerifyASN1(q, digest, sig) {
+			t.Fatal("signature verified for modified digest")
+		}
+	}
+}
+
+func TestECDSAVerifyIdentityKey(t *testing.T) {
+	d := ScalarBytes(RandScalarNonZero(t))
+	digest := RandDigest(t)
+
+	r, s, err := Sign(d, digest, crypto.SHA256.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if Verify(NewPoint(), digest, r, s) {
+		t.Fatal("signature verified for identity public key")
+	}
+}
+
+// ECDSAVectors is a file of ECDSA test vectors in the Wycheproof format, as
+// generated alongside this package.
+type ECDSAVectors struct {
+	TestGroups []struct {
+		Type string `json:"type"`
+		Key  struct {
+			Curve        string `json:"curve"`
+			Uncompressed string `json:"uncompressed"`
+			D            string `json:"d"`
+		} `json:"key"`
+		SHA   string `json:"sha"`
+		Tests []struct {
+			TcID    int    `json:"tcId"`
+			Comment string `json:"comment"`
+			Msg     string `json:"msg"`
+			Sig     string `json:"sig"`
+			Result  string `json:"result"`
+		} `json:"tests"`
+	} `json:"testGroups"`
+}
+
+func TestECDSAVectors(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/ecdsa.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v ECDSAVectors
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	hashes := map[string]crypto.Hash{
+		"SHA-224": crypto.SHA224,
+		"SHA-256": crypto.SHA256,
+		"SHA-384": crypto.SHA384,
+		"SHA-512": crypto.SHA512,
+	}
+
+	for _, g := range v.TestGroups {
+		h, ok := hashes[g.SHA]
+		if !ok {
+			t.Fatalf("unknown hash function %q", g.SHA)
+		}
+
+		q, err := new(Point).SetBytes(DecodeHex(t, g.Key.Uncompressed))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, c := range g.Tests {
+			hash := h.New()
+			hash.Write(DecodeHex(t, c.Msg))
+			digest := hash.Sum(nil)
+			sig := DecodeHex(t, c.Sig)
+
+			switch g.Type {
+			case "EcdsaVerify":
+				if c.Result == "acceptable" {
+					continue
+				}
+				expect := c.Result == "valid"
+				if got := VerifyASN1(q, digest, sig); got != expect {
+					t.Errorf("tcId %d (%s): got %v expect %v", c.TcID, c.Comment, got, expect)
+				}
+			case "EcdsaSign":
+				got, err := SignASN1(DecodeHex(t, g.Key.D), digest, h.New)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if hex.EncodeToString(got) != c.Sig {
+					t.Errorf("tcId %d (%s): got %x expect %s", c.TcID, c.Comment, got, c.Sig)
+				}
+			default:
+				t.Fatalf("unknown test group type %q", g.Type)
+			}
+		}
+	}
+}
+
+func BenchmarkECDSASign(b *testing.B) {
+	d := ScalarBytes(RandScalarNonZero(b))
+	digest := RandDigest(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = Sign(d, digest, crypto.SHA256.New)
+	}
+}
+
+func BenchmarkECDSAVerify(b *testing.B) {
+	d := RandScalarNonZero(b)
+	pub := ECDSAPublicKey(d)
+	q := MarshalPoint(b, pub.X, pub.Y)
+	digest := RandDigest(b)
+	r, s, err := Sign(ScalarBytes(d), digest, crypto.SHA256.New)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(q, digest, r, s)
+	}
+}
+
+func ECDSAPublicKey(d *big.Int) *ecdsa.PublicKey {
+	x, y := ref.ScalarBaseMult(d.Bytes())
+	return &ecdsa.PublicKey{Curve: ref, X: x, Y: y}
+}
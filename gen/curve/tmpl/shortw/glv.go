@@ -0,0 +1,252 @@
+// CodeGenerationWarning
+
+package shortw
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// References:
+//
+//	[glv]    Robert P. Gallant, Robert J. Lambert and Scott A. Vanstone. Faster Point
+//	         Multiplication on Elliptic Curves with Efficient Endomorphisms. In
+//	         Advances in Cryptology - CRYPTO 2001.
+//	[guide]  Darrel Hankerson, Alfred Menezes and Scott Vanstone. Guide to Elliptic
+//	         Curve Cryptography. Springer. 2004.
+
+// glvbits bounds the bit length of the scalars produced by GLV decomposition,
+// after conversion to odd.
+const glvbits = ConstGLVBits
+
+// glvint is a signed integer in two's complement, modulo 2^(64·words).
+type glvint [words]uint64
+
+var (
+	// glvg1 and glvg2 are the fixed-point approximations round(2ʷb₂/N) and
+	// round(-2ʷb₁/N), where w is the scalar width in bits.
+	glvg1, glvg2 glvint
+
+	// glva1, glvb1, glva2 and glvb2 are the lattice basis vectors (a₁, b₁) and
+	// (a₂, b₂), with a₁ + b₁λ ≡ a₂ + b₂λ ≡ 0 (mod N).
+	glva1, glvb1, glva2, glvb2 glvint
+)
+
+func init() {
+	glvg1 = glvconst(ConstGLVG1Hex)
+	glvg2 = glvconst(ConstGLVG2Hex)
+	glva1 = glvconst(ConstGLVA1Hex)
+	glvb1 = glvconst(ConstGLVB1Hex)
+	glva2 = glvconst(ConstGLVA2Hex)
+	glvb2 = glvconst(ConstGLVB2Hex)
+}
+
+// glvconst parses the signed hex integer s.
+func glvconst(s string) glvint {
+	x, _ := new(big.Int).SetString(s, 16)
+	x.Mod(x, new(big.Int).Lsh(big.NewInt(1), 64*words))
+	mask := new(big.Int).SetUint64(^uint64(0))
+
+	var z glvint
+	for i := range z {
+		z[i] = new(big.Int).And(x, mask).Uint64()
+		x.Rsh(x, 64)
+	}
+	return z
+}
+
+// GLVDecompose splits k into scalars k₁ and k₂ of at most half the length, with
+// k = k₁ + k₂λ (mod N), in constant time. Returns the absolute values of k₁ and
+// k₂ and whether each is negative. The scalar k must be less than the order N.
+func (k *scalar) GLVDecompose() (k1, k2 scalar, neg1, neg2 uint) {
+	// Implementation follows [guide] Algorithm 3.74, with the divisions by N
+	// replaced by fixed-point multiplications.
+	K := glvint(*k.uint64s())
+
+	// c₁ = round(b₂k/N), c₂ = round(-b₁k/N)
+	c1 := glvmulround(&K, &glvg1)
+	c2 := glvmulround(&K, &glvg2)
+
+	// k₁ = k - c₁a₁ - c₂a₂
+	t1 := glvmul(&c1, &glva1)
+	t2 := glvmul(&c2, &glva2)
+	K1 := glvsub(&K, &t1)
+	K1 = glvsub(&K1, &t2)
+
+	// k₂ = -c₁b₁ - c₂b₂
+	t1 = glvmul(&c1, &glvb1)
+	t2 = glvmul(&c2, &glvb2)
+	var K2 glvint
+	K2 = glvsub(&K2, &t1)
+	K2 = glvsub(&K2, &t2)
+
+	K1, neg1 = glvabs(&K1)
+	K2, neg2 = glvabs(&K2)
+	*k1.uint64s() = K1
+	*k2.uint64s() = K2
+
+	return
+}
+
+// glvmulround returns x*y/2^(64·words) rounded to the nearest integer, for
+// non-negative x and y.
+func glvmulround(x, y *glvint) glvint {
+	var t [2 * words]uint64
+	for i := 0; i < words; i++ {
+		var c uint64
+		for j := 0; j < words; j++ {
+			hi, lo := bits.Mul64(x[i], y[j])
+			var cc uint64
+			lo, cc = bits.Add64(lo, t[i+j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[i+j] = lo
+			c = hi
+		}
+		t[i+words] = c
+	}
+
+	// Round with the most significant discarded bit.
+	var z glvint
+	c := t[words-1] >> 63
+	for i := 0; i < words; i++ {
+		z[i], c = bits.Add64(t[words+i], 0, c)
+	}
+	return z
+}
+
+// glvmul returns x*y.
+func glvmul(x, y *glvint) glvint {
+	var z glvint
+	for i := 0; i < words; i++ {
+		var c uint64
+		for j := 0; i+j < words; j++ {
+			hi, lo := bits.Mul64(x[i], y[j])
+			var cc uint64
+			lo, cc = bits.Add64(lo, z[i+j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			z[i+j] = lo
+			c = hi
+		}
+	}
+	return z
+}
+
+// glvsub returns x-y.
+func glvsub(x, y *glvint) glvint {
+	var z glvint
+	var b uint64
+	for i := 0; i < words; i++ {
+		z[i], b = bits.Sub64(x[i], y[i], b)
+	}
+	return z
+}
+
+// glvabs returns the absolute value of x, and 1 if x is negative.
+func glvabs(x *glvint) (glvint, uint) {
+	neg := x[words-1] >> 63
+	mask := -neg
+	var z glvint
+	c := neg
+	for i := 0; i < words; i++ {
+		z[i], c = bits.Add64(x[i]^mask, 0, c)
+	}
+	return z, uint(neg)
+}
+
+// scalarmultglv sets p = k*q in constant time, using the endomorphism to halve
+// the number of doublings. The scalar k must be less than the order N.
+func scalarmultglv(p, q *Projective, k *scalar) {
+	// Scalar recoding window size.
+	const w = ConstW
+
+	// The point formulae require a point other than the identity. Substitute
+	// the generator in this case, and replace the result with the identity at
+	// the end. A zero scalar needs no special handling.
+	infinity := IsZero(&q.Z)
+
+	P := *q
+	CMov(&P.X, &generator.p.X, infinity)
+	CMov(&P.Y, &generator.p.Y, infinity)
+	CMov(&P.Z, &generator.p.Z, infinity)
+
+	// Decompose k = k₁ + k₂λ, and compute |k₁|P₁ + |k₂|P₂, where P₁ = ±P and
+	// P₂ = ±φ(P) absorb the signs.
+	k1, k2, neg1, neg2 := k.GLVDecompose()
+
+	// Recoding requires odd scalars. Add one to even scalars, and subtract the
+	// corresponding point at the end.
+	skew1 := uint(k1[0]&1) ^ 1
+	skew2 := uint(k2[0]&1) ^ 1
+	k1[0] |= 1
+	k2[0] |= 1
+
+	d1 := k1.FixedWindowRecodeBits(glvbits)
+	d2 := k2.FixedWindowRecodeBits(glvbits)
+
+	// Odd multiples of P₁, and their images under the endomorphism.
+	var tbl1, tbl2 table
+	P1 := jacobian(&P)
+	P1.CNeg(neg1)
+	tbl1.Precompute(P1)
+	for i := range tbl2 {
+		tbl2[i].Endomorphism(&tbl1[i])
+		tbl2[i].CNeg(neg1 ^ neg2)
+	}
+
+	// Joint fixed window ladder. Doubling uses jacobian coordinates, but
+	// additions use the complete formula: unlike the single scalar case, the
+	// accumulator may coincide with a table point, or the identity, for
+	// particular scalars.
+	var q0, r Jacobian
+
+	t := len(d1) - 1
+	tbl1.Lookup(&r, d1[t])
+	acc := r.Projective()
+	tbl2.Lookup(&r, d2[t])
+	acc.CompleteAdd(acc, r.Projective())
+
+	for i := t - 1; i >= 0; i-- {
+		// The identity converts to jacobian coordinates with X = Y = 0, which
+		// doubling would not preserve. Represent it as (1, 1, 0) instead.
+		q0 = *jacobian(acc)
+		z := IsZero(&q0.Z)
+		CMov(&q0.X, &identity.p.Y, z)
+		CMov(&q0.Y, &identity.p.Y, z)
+
+		for j := 0; j < w-1; j++ {
+			q0.Double(&q0)
+		}
+		acc = q0.Projective()
+
+		tbl1.Lookup(&r, d1[i])
+		acc.CompleteAdd(acc, r.Projective())
+		tbl2.Lookup(&r, d2[i])
+		acc.CompleteAdd(acc, r.Projective())
+	}
+
+	// Subtract P₁ and P₂ for scalars made odd.
+	csub(acc, &tbl1[0], skew1)
+	csub(acc, &tbl2[0], skew2)
+
+	// Replace with the identity for the special case handled above.
+	CMov(&acc.X, &identity.p.X, infinity)
+	CMov(&acc.Y, &identity.p.Y, infinity)
+	CMov(&acc.Z, &identity.p.Z, infinity)
+
+	*p = *acc
+}
+
+// csub sets p = p - q if c is 1, in constant time.
+func csub(p *Projective, q *Jacobian, c uint) {
+	r := *q
+	r.CNeg(1)
+	var d Projective
+	d.CompleteAdd(p, r.Projective())
+	CMov(&p.X, &d.X, c)
+	CMov(&p.Y, &d.Y, c)
+	CMov(&p.Z, &d.Z, c)
+}
@@ -0,0 +1,124 @@
+// CodeGenerationWarning
+
+package shortw
+
+import (
+	"math/big"
+	"testing"
+)
+
+// GLVLambda returns the eigenvalue λ of the endomorphism.
+func GLVLambda(tb testing.TB) *big.Int {
+	tb.Helper()
+	lambda, ok := new(big.Int).SetString(ConstGLVLambdaHex, 16)
+	if !ok {
+		tb.Fatal("invalid lambda")
+	}
+	return lambda
+}
+
+// GLVScalars returns special cases for GLV scalar multiplication, followed by n
+// random scalars.
+func GLVScalars(tb testing.TB, n int) []*big.Int {
+	tb.Helper()
+	N := curvename.Params().N
+	lambda := GLVLambda(tb)
+	ks := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		new(big.Int).Sub(N, big.NewInt(1)),
+		new(big.Int).Rsh(N, 1),
+		lambda,
+		new(big.Int).Sub(N, lambda),
+	}
+
+	// Scalars near lattice points, which decompose to small or extreme values.
+	for _, s := range []string{ConstGLVA1Hex, ConstGLVB1Hex, ConstGLVA2Hex, ConstGLVB2Hex} {
+		v, _ := new(big.Int).SetString(s, 16)
+		for _, d := range []int64{-1, 0, 1} {
+			k := new(big.Int).Add(v, big.NewInt(d))
+			ks = append(ks, k.Mod(k, N))
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		ks = append(ks, RandScalarNonZero(tb))
+	}
+
+	return ks
+}
+
+func TestScalarGLVDecompose(t *testing.T) {
+	N := curvename.Params().N
+	lambda := GLVLambda(t)
+	for _, k := range GLVScalars(t, ConstNumTrials) {
+		var K scalar
+		K.SetIntRaw(k)
+		K1, K2, neg1, neg2 := K.GLVDecompose()
+		k1, k2 := K1.IntRaw(), K2.IntRaw()
+
+		// Both halves, once made odd, must be within the recoding bound.
+		for _, x := range []*big.Int{k1, k2} {
+			if new(big.Int).SetBit(x, 0, 1).BitLen() > glvbits {
+				t.Fatalf("k = %x: decomposed scalar %x too large", k, x)
+			}
+		}
+
+		// Verify k = k₁ + k₂λ (mod N).
+		if neg1 == 1 {
+			k1.Neg(k1)
+		}
+		if neg2 == 1 {
+			k2.Neg(k2)
+		}
+		got := new(big.Int).Mul(k2, lambda)
+		got.Add(got, k1)
+		got.Mod(got, N)
+		EqualInt(t, "k", k, got)
+	}
+}
+
+func TestJacobianEndomorphism(t *testing.T) {
+	lambda := GLVLambda(t)
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		x, y := RandPoint(t)
+
+		var p Jacobian
+		p.Endomorphism(NewAffine(x, y).Jacobian())
+		gx, gy := p.Affine().Coordinates()
+		ex, ey := ref.ScalarMult(x, y, lambda.Bytes())
+
+		EqualInt(t, "x", ex, gx)
+		EqualInt(t, "y", ey, gy)
+	}
+}
+
+func TestScalarMultGLV(t *testing.T) {
+	x, y := RandPoint(t)
+	q := MarshalPoint(t, x, y)
+	for _, k := range GLVScalars(t, ConstNumTrials/8) {
+		var K scalar
+		K.SetIntRaw(k)
+
+		var p Point
+		scalarmultglv(&p.p, &q.p, &K)
+
+		ex, ey := ref.ScalarMult(x, y, k.Bytes())
+		EqualPoint(t, ex, ey, &p)
+	}
+}
+
+func TestScalarMultGLVIdentity(t *testing.T) {
+	for _, k := range GLVScalars(t, 0) {
+		var K scalar
+		K.SetIntRaw(k)
+
+		var p Point
+		scalarmultglv(&p.p, &identity.p, &K)
+
+		if !p.IsIdentity() {
+			t.Fatalf("k = %x: expected identity", k)
+		}
+	}
+}
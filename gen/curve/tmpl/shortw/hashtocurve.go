@@ -0,0 +1,181 @@
+// CodeGenerationWarning
+
+package shortw
+
+import (
+	"crypto"
+	_ "crypto/sha256" // register hash functions
+	_ "crypto/sha512"
+	"math/big"
+)
+
+// References:
+//
+//	[rfc9380]  A. Faz-Hernandez, S. Scott, N. Sullivan, R. S. Wahby and C. A. Wood. Hashing to
+//	           Elliptic Curves. RFC 9380. 2023.
+//	           https://www.rfc-editor.org/rfc/rfc9380
+
+const (
+	// h2chash is the hash function used by expand_message_xmd.
+	h2chash crypto.Hash = ConstHashToCurveHash
+
+	// hashtofieldsize is the number of bytes L hashed to each field element,
+	// which is sufficient for the target security level.
+	hashtofieldsize = ConstHashToFieldSize
+)
+
+var (
+	// sswuz is the Simplified SWU parameter Z.
+	sswuz Elt
+
+	// sswuc1 is -B/A.
+	sswuc1 Elt
+
+	// sswuc2 is B/(Z*A), the value of x1 in the exceptional case.
+	sswuc2 Elt
+
+	// fieldone is the field element 1.
+	fieldone Elt
+)
+
+func init() {
+	p, b := curvename.P, curvename.B
+	a := big.NewInt(-3)
+	z := big.NewInt(ConstSSWUZ)
+
+	c1 := new(big.Int).ModInverse(new(big.Int).Mod(a, p), p)
+	c1.Mul(c1, b).Neg(c1)
+
+	c2 := new(big.Int).Mul(z, a)
+	c2.ModInverse(c2.Mod(c2, p), p)
+	c2.Mul(c2, b)
+
+	sswuz.SetInt(z)
+	sswuc1.SetInt(c1)
+	sswuc2.SetInt(c2)
+	fieldone.SetInt64(1)
+}
+
+// HashToCurve hashes msg to a point, using the hash_to_curve random oracle
+// encoding of [rfc9380] with expand_message_xmd and the Simplified SWU map. The
+// domain separation tag dst should be unique to the application.
+func HashToCurve(msg, dst []byte) *Point {
+	var u [2]Elt
+	hashtofield(u[:], msg, dst)
+
+	// The cofactor is 1, so no clearing is required.
+	p := new(Point)
+	p.p.CompleteAdd(maptocurve(&u[0]), maptocurve(&u[1]))
+	return p
+}
+
+// EncodeToCurve hashes msg to a point, using the encode_to_curve nonuniform
+// encoding of [rfc9380] with expand_message_xmd and the Simplified SWU map.
+// The output distribution is not uniform, so HashToCurve should be preferred
+// unless the application specifically allows a nonuniform encoding.
+func EncodeToCurve(msg, dst []byte) *Point {
+	var u [1]Elt
+	hashtofield(u[:], msg, dst)
+
+	p := new(Point)
+	p.p = *maptocurve(&u[0])
+	return p
+}
+
+// hashtofield sets u to field elements derived from msg, as specified by
+// hash_to_field in [rfc9380] Section 5.2.
+func hashtofield(u []Elt, msg, dst []byte) {
+	uniform := expandmessagexmd(msg, dst, len(u)*hashtofieldsize)
+	for i := range u {
+		// Elements are reduced from hashtofieldsize bytes, wider than the
+		// field, to make the bias negligible.
+		u[i].SetBytesWide(uniform[i*hashtofieldsize : (i+1)*hashtofieldsize])
+	}
+}
+
+// expandmessagexmd implements expand_message_xmd of [rfc9380] Section 5.3.1.
+// The requested length n must be small enough that the expansion requires at
+// most 255 hash blocks, which holds for all uses in this package.
+func expandmessagexmd(msg, dst []byte, n int) []byte {
+	// Oversized tags are hashed, as specified in [rfc9380] Section 5.3.3.
+	if len(dst) > 255 {
+		d := h2chash.New()
+		d.Write([]byte("H2C-OVERSIZE-DST-"))
+		d.Write(dst)
+		dst = d.Sum(nil)
+	}
+	dstprime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	// Step 7: b_0 = H(msg_prime)
+	d := h2chash.New()
+	d.Write(make([]byte, d.BlockSize()))
+	d.Write(msg)
+	d.Write([]byte{byte(n >> 8), byte(n), 0})
+	d.Write(dstprime)
+	b0 := d.Sum(nil)
+
+	// Steps 8-10: b_i = H(strxor(b_0, b_(i-1)) || I2OSP(i, 1) || DST_prime)
+	uniform := make([]byte, 0, n+len(b0))
+	bi := make([]byte, len(b0))
+	for i := 1; len(uniform) < n; i++ {
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+		d.Reset()
+		d.Write(bi)
+		d.Write([]byte{byte(i)})
+		d.Write(dstprime)
+		bi = d.Sum(nil)
+		uniform = append(uniform, bi...)
+	}
+
+	return uniform[:n]
+}
+
+// maptocurve maps u to a point with the Simplified SWU method of [rfc9380]
+// Section 6.6.2, in constant time.
+func maptocurve(u *Elt) *Projective {
+	var tv1, zu2, x1, x2, gx1, gx2, y1, y2 Elt
+
+	// Step 1: tv1 = inv0(Z² u⁴ + Z u²)
+	Sqr(&zu2, u)
+	Mul(&zu2, &zu2, &sswuz)
+	Sqr(&tv1, &zu2)
+	Add(&tv1, &tv1, &zu2)
+	exceptional := IsZero(&tv1)
+	Inv(&tv1, &tv1)
+
+	// Step 2: x1 = (-B / A) * (1 + tv1)
+	Add(&x1, &tv1, &fieldone)
+	Mul(&x1, &x1, &sswuc1)
+
+	// Step 3: If tv1 == 0, set x1 = B / (Z * A)
+	CMov(&x1, &sswuc2, exceptional)
+
+	// Step 4: gx1 = x1³ + A * x1 + B
+	rhs(&gx1, &x1)
+
+	// Step 5: x2 = Z * u² * x1
+	Mul(&x2, &zu2, &x1)
+
+	// Step 6: gx2 = x2³ + A * x2 + B
+	rhs(&gx2, &x2)
+
+	// Steps 7-8: select x1 and sqrt(gx1) if gx1 is square, otherwise x2 and
+	// sqrt(gx2).
+	square := Sqrt(&y1, &gx1)
+	Sqrt(&y2, &gx2)
+
+	a := new(Affine)
+	a.X = x2
+	a.Y = y2
+	CMov(&a.X, &x1, square)
+	CMov(&a.Y, &y1, square)
+
+	// Step 9: If sgn0(u) != sgn0(y), set y = -y
+	var neg Elt
+	Neg(&neg, &a.Y)
+	CMov(&a.Y, &neg, parity(u)^parity(&a.Y))
+
+	return a.Projective()
+}
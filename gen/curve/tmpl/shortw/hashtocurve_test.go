@@ -0,0 +1,157 @@
+// CodeGenerationWarning
+
+package shortw
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"testing"
+)
+
+// HashToCurveVectors is a file of test vectors in the format of the RFC 9380
+// reference vectors.
+type HashToCurveVectors struct {
+	Ciphersuite  string `json:"ciphersuite"`
+	DST          string `json:"dst"`
+	RandomOracle bool   `json:"randomOracle"`
+	Vectors      []struct {
+		P   HashToCurvePoint  `json:"P"`
+		Q0  HashToCurvePoint  `json:"Q0"`
+		Q1  *HashToCurvePoint `json:"Q1"`
+		Msg string            `json:"msg"`
+		U   []string          `json:"u"`
+	} `json:"vectors"`
+}
+
+// HashToCurvePoint is an affine point in hash to curve test vectors.
+type HashToCurvePoint struct {
+	X string `json:"x"`
+	Y string `json:"y"`
+}
+
+func (p HashToCurvePoint) Coordinates(t *testing.T) (x, y *big.Int) {
+	t.Helper()
+	return ParseHexInt(t, p.X), ParseHexInt(t, p.Y)
+}
+
+func LoadHashToCurveVectors(t *testing.T, filename string) *HashToCurveVectors {
+	t.Helper()
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := &HashToCurveVectors{}
+	if err := json.Unmarshal(b, v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestHashToCurveVectors(t *testing.T) {
+	v := LoadHashToCurveVectors(t, "testdata/hashtocurve_ro.json")
+	if !v.RandomOracle {
+		t.Fatal("expected random oracle suite")
+	}
+	dst := []byte(v.DST)
+
+	for _, c := range v.Vectors {
+		// Field elements.
+		var u [2]Elt
+		hashtofield(u[:], []byte(c.Msg), dst)
+		if len(c.U) != len(u) {
+			t.Fatalf("expected %d field elements", len(u))
+		}
+		for i := range u {
+			EqualInt(t, "u", ParseHexInt(t, c.U[i]), u[i].Int())
+		}
+
+		// Mapped points.
+		x, y := c.Q0.Coordinates(t)
+		EqualPoint(t, x, y, &Point{p: *maptocurve(&u[0])})
+		x, y = c.Q1.Coordinates(t)
+		EqualPoint(t, x, y, &Point{p: *maptocurve(&u[1])})
+
+		// Output.
+		x, y = c.P.Coordinates(t)
+		EqualPoint(t, x, y, HashToCurve([]byte(c.Msg), dst))
+	}
+}
+
+func TestEncodeToCurveVectors(t *testing.T) {
+	v := LoadHashToCurveVectors(t, "testdata/hashtocurve_nu.json")
+	if v.RandomOracle {
+		t.Fatal("expected nonuniform suite")
+	}
+	dst := []byte(v.DST)
+
+	for _, c := range v.Vectors {
+		var u [1]Elt
+		hashtofield(u[:], []byte(c.Msg), dst)
+		if len(c.U) != len(u) {
+			t.Fatalf("expected %d field elements", len(u))
+		}
+		EqualInt(t, "u", ParseHexInt(t, c.U[0]), u[0].Int())
+
+		x, y := c.P.Coordinates(t)
+		EqualPoint(t, x, y, EncodeToCurve([]byte(c.Msg), dst))
+	}
+}
+
+func TestMapToCurveRand(t *testing.T) {
+	for trial := 0; trial < ConstNumTrials; trial++ {
+		var u Elt
+		u.SetInt(RandFieldInt(t))
+
+		a := maptocurve(&u).Affine()
+		if a.IsOnCurve() != 1 {
+			t.Fatal("mapped point is not on the curve")
+		}
+
+		// The sign of y must match the sign of u.
+		if parity(&a.Y) != parity(&u) {
+			t.Fatal("sign mismatch")
+		}
+	}
+}
+
+func TestMapToCurveExceptional(t *testing.T) {
+	// The denominator Z² u⁴ + Z u² vanishes for u = 0.
+	var u Elt
+	a := maptocurve(&u).Affine()
+	if a.IsOnCurve() != 1 {
+		t.Fatal("mapped point is not on the curve")
+	}
+
+	// In this case x = B / (Z * A).
+	p := curvename.P
+	za := new(big.Int).Mul(big.NewInt(ConstSSWUZ), big.NewInt(-3))
+	expect := new(big.Int).ModInverse(za.Mod(za, p), p)
+	expect.Mul(expect, curvename.B).Mod(expect, p)
+	EqualInt(t, "x", expect, a.X.Int())
+}
+
+func TestExpandMessageXMDOversizeDST(t *testing.T) {
+	msg := []byte("abc")
+	dst := make([]byte, 256)
+
+	// The oversize tag is replaced with its hash.
+	d := h2chash.New()
+	d.Write([]byte("H2C-OVERSIZE-DST-"))
+	d.Write(dst)
+
+	got := expandmessagexmd(msg, dst, 32)
+	expect := expandmessagexmd(msg, d.Sum(nil), 32)
+	if string(got) != string(expect) {
+		t.Fatal("mismatch")
+	}
+}
+
+func BenchmarkHashToCurve(b *testing.B) {
+	msg := []byte("abc")
+	dst := []byte("QUUX-V01-CS02-with-benchmark")
+	for i := 0; i < b.N; i++ {
+		HashToCurve(msg, dst)
+	}
+}
@@ -0,0 +1,44 @@
+// CodeGenerationWarning
+
+package shortw
+
+import (
+	"testing"
+
+	"golang.org/x/sys/cpu"
+)
+
+// ForEachISA runs f with each implementation of the assembly functions,
+// forcing the selection normally made by CPU feature detection. The ADX
+// implementations are skipped if the processor does not support them.
+func ForEachISA(t *testing.T, f func(t *testing.T)) {
+	defer func(field, scalar bool) {
+		hasADX, scalarhasadx = field, scalar
+	}(hasADX, scalarhasadx)
+
+	for _, adx := range []bool{false, true} {
+		name := "Baseline"
+		if adx {
+			name = "ADX"
+		}
+		t.Run(name, func(t *testing.T) {
+			if adx && !(cpu.X86.HasBMI2 && cpu.X86.HasADX) {
+				t.Skip("processor does not support ADX")
+			}
+			hasADX, scalarhasadx = adx, adx
+			f(t)
+		})
+	}
+}
+
+func TestCurveISAs(t *testing.T) {
+	ForEachISA(t, func(t *testing.T) {
+		t.Run("AddRand", TestCurveAddRand)
+		t.Run("DoubleRand", TestCurveDoubleRand)
+		t.Run("ScalarMultRand", TestCurveScalarMultRand)
+		t.Run("ScalarBaseMultRand", TestCurveScalarBaseMultRand)
+		t.Run("InverseRand", TestCurveInverseRand)
+		t.Run("PointScalarMultEdgeCases", TestPointScalarMultEdgeCases)
+		t.Run("PointDoubleScalarBaseMultVarTimeRand", TestPointDoubleScalarBaseMultVarTimeRand)
+	})
+}
@@ -26,15 +26,22 @@ func (k *scalar) uint64s() *[words]uint64 {
 // FixedWindowRecode recodes the odd scalar k into a signed fixed window
 // representation with digits in the set {±1, ±3, ..., ±(2^(w-1)-1)}.
 func (k *scalar) FixedWindowRecode() []int32 {
+	return k.FixedWindowRecodeBits(ConstBitSize)
+}
+
+// FixedWindowRecodeBits recodes the odd scalar k, which must be less than 2^r,
+// into a signed fixed window representation of (r+w-2)/(w-1) + 1 digits.
+func (k *scalar) FixedWindowRecodeBits(r int) []int32 {
 	// Implementation follows [msrecclibpaper] Algorithm 6.
 	const (
-		w    = ConstW                  // window parameter
-		r    = ConstBitSize            // bit size
-		t    = (r + (w - 2)) / (w - 1) // length of the window representation
-		mask = (1 << w) - 1            // w-bit mask
-		val  = 1 << (w - 1)            // 2ʷ⁻¹
+		w    = ConstW       // window parameter
+		mask = (1 << w) - 1 // w-bit mask
+		val  = 1 << (w - 1) // 2ʷ⁻¹
 	)
 
+	// Length of the window representation.
+	t := (r + (w - 2)) / (w - 1)
+
 	digits := make([]int32, t+1)
 	K := *k
 
@@ -75,6 +82,19 @@ func (k *scalar) SubInt32(v int32) {
 	}
 }
 
+// SubSigned subtracts the signed integer v from k. Unlike SubInt32, v is
+// sign-extended to the full width of k, so the carry out of the low word is
+// propagated when v is negative.
+func (k *scalar) SubSigned(v int32) {
+	kw := k.uint64s()
+	ext := uint64(int64(v) >> 63)
+	var borrow uint64
+	kw[0], borrow = bits.Sub64(kw[0], uint64(int64(v)), 0)
+	for i := 1; i < words; i++ {
+		kw[i], borrow = bits.Sub64(kw[i], ext, borrow)
+	}
+}
+
 // Rsh shifts the scalar k right by s.
 func (k *scalar) Rsh(s uint) {
 	kw := k.uint64s()
@@ -83,3 +103,44 @@ func (k *scalar) Rsh(s uint) {
 	}
 	kw[words-1] >>= s
 }
+
+// WNAFRecodeVarTime recodes k into width-w non-adjacent form, least significant
+// digit first. Non-zero digits are odd with absolute value less than 2^(w-1),
+// and any w consecutive digits contain at most one non-zero digit. Runs in
+// variable time, so must only be used with public scalars.
+func (k *scalar) WNAFRecodeVarTime(w uint) []int32 {
+	var (
+		mask = int32(1)<<w - 1 // w-bit mask
+		half = int32(1) << (w - 1)
+	)
+
+	digits := make([]int32, 0, ConstBitSize+1)
+	K := *k
+
+	for !K.IsZeroVarTime() {
+		var digit int32
+		if K[0]&1 == 1 {
+			digit = int32(K[0]) & mask
+			if digit >= half {
+				digit -= 1 << w
+			}
+			// Negative digits round k up to a multiple of 2ʷ, which carries
+			// out of the low word when its high bits are all ones.
+			K.SubSigned(digit)
+		}
+		digits = append(digits, digit)
+		K.Rsh(1)
+	}
+
+	return digits
+}
+
+// IsZeroVarTime reports whether k is zero. Runs in variable time.
+func (k *scalar) IsZeroVarTime() bool {
+	for _, x := range k.uint64s() {
+		if x != 0 {
+			return false
+		}
+	}
+	return true
+}
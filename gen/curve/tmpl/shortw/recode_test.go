@@ -39,6 +39,38 @@ func TestScalarFixedWindowRecode(t *testing.T) {
 	}
 }
 
+func TestScalarFixedWindowRecodeBits(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for r := 1; r < 2*ConstW; r++ {
+		for trial := 0; trial < ConstNumTrials; trial++ {
+			// Random odd k < 2ʳ.
+			k := new(big.Int).Rand(src, new(big.Int).Lsh(big.NewInt(1), uint(r)))
+			k.SetBit(k, 0, 1)
+
+			var K scalar
+			K.SetIntRaw(k)
+			digits := K.FixedWindowRecodeBits(r)
+
+			if len(digits) != (r+ConstW-2)/(ConstW-1)+1 {
+				t.Fatalf("r = %d: unexpected length %d", r, len(digits))
+			}
+
+			x := new(big.Int)
+			for i := len(digits) - 1; i >= 0; i-- {
+				if (digits[i] & 1) != 1 {
+					t.Fatalf("r = %d: digit %d is not odd", r, i)
+				}
+				x.Lsh(x, ConstW-1)
+				x.Add(x, big.NewInt(int64(digits[i])))
+			}
+
+			if k.Cmp(x) != 0 {
+				t.Fatalf("r = %d: k = %x digits = %d", r, k, digits)
+			}
+		}
+	}
+}
+
 func TestScalarSubInt(t *testing.T) {
 	for trial := 0; trial < ConstNumTrials; trial++ {
 		x := RandScalarNonZero(t)
@@ -78,3 +110,77 @@ func TestScalarRsh(t *testing.T) {
 		}
 	}
 }
+
+func TestScalarWNAFRecodeVarTime(t *testing.T) {
+	for w := uint(2); w <= 8; w++ {
+		for trial := 0; trial < ConstNumTrials; trial++ {
+			CheckWNAFRecodeVarTime(t, RandScalarNonZero(t), w)
+		}
+	}
+}
+
+func TestScalarWNAFRecodeVarTimeCarry(t *testing.T) {
+	// Scalars with all-ones words, for which rounding up to a multiple of 2ʷ
+	// carries between words. Random scalars rarely exercise this.
+	one := big.NewInt(1)
+	ks := []*big.Int{new(big.Int).Sub(curvename.Params().N, one)}
+	for n := uint(64); n < ConstBitSize; n += 64 {
+		for _, s := range []uint{0, 1, 5} {
+			k := new(big.Int).Lsh(one, n+s)
+			ks = append(ks, k.Sub(k, one))
+		}
+	}
+
+	for w := uint(2); w <= 8; w++ {
+		for _, k := range ks {
+			CheckWNAFRecodeVarTime(t, k, w)
+		}
+	}
+}
+
+// CheckWNAFRecodeVarTime checks the width-w NAF recoding of k.
+func CheckWNAFRecodeVarTime(t *testing.T, k *big.Int, w uint) {
+	t.Helper()
+
+	var K scalar
+	K.SetIntRaw(k)
+	digits := K.WNAFRecodeVarTime(w)
+
+	// Verify digits are zero or odd and in range, and that non-zero digits are
+	// separated by at least w-1 zeros.
+	last := -int(w)
+	for i, digit := range digits {
+		if digit == 0 {
+			continue
+		}
+		if (digit&1) != 1 || abs(digit) >= 1<<(w-1) {
+			t.Fatalf("w=%d: invalid digit %d at position %d", w, digit, i)
+		}
+		if i-last < int(w) {
+			t.Fatalf("w=%d: adjacent non-zero digits at positions %d and %d", w, last, i)
+		}
+		last = i
+	}
+
+	// Confirm the sum is correct.
+	x := new(big.Int)
+	for i := len(digits) - 1; i >= 0; i-- {
+		x.Lsh(x, 1)
+		x.Add(x, big.NewInt(int64(digits[i])))
+	}
+
+	if k.Cmp(x) != 0 {
+		t.Logf("     k = %x", k)
+		t.Logf("     w = %d", w)
+		t.Logf("digits = %d", digits)
+		t.Logf("   got = %x", x)
+		t.FailNow()
+	}
+}
+
+func TestScalarWNAFRecodeVarTimeZero(t *testing.T) {
+	var K scalar
+	if digits := K.WNAFRecodeVarTime(5); len(digits) != 0 {
+		t.Fatalf("expected empty recoding of zero; got %d", digits)
+	}
+}
@@ -1,6 +1,9 @@
 package shortw
 
-import "math/big"
+import (
+	"crypto"
+	"math/big"
+)
 
 // Curve parameters.
 const (
@@ -11,6 +14,9 @@ const (
 	ConstGxHex         = "aa87ca22be8b05378eb1c71ef320ad746e1d3b628ba79b9859f741e082542a385502f25dbf55296c3a545e3872760ab7"
 	ConstGyHex         = "3617de4a96262c6f5d9e98bf9292dc29f8f41dbd289a147ce9da3113b5f0b8c00a60b1ce1d7e819d7a431d7c90ea0e5f"
 	ConstBitSize       = 384
+	ConstFieldSize     = 48
+	ConstScalarSize    = 48
+	ConstA             = -3
 )
 
 // Implementation parameters.
@@ -18,50 +24,188 @@ const (
 	ConstW = 6
 )
 
+// GLV parameters. The stub curve has no endomorphism, so the stub jacobian
+// Endomorphism multiplies by a cube root of unity λ modulo N directly.
+const (
+	ConstGLV          = false
+	ConstGLVLambdaHex = "e273252dc3efff2abba03bda3774ede565c7a094a75f52416dcd519c183413ab7e6d8d103453f7f796d3b3b210439ef"
+	ConstGLVA1Hex     = "e5319a455c971af52e2d2e06076778bbd5cfcea600626ba2"
+	ConstGLVB1Hex     = "-2f13e33420062e6cc2e356bf100a628f4842991eb7c133db"
+	ConstGLVA2Hex     = "2f13e33420062e6cc2e356bf100a628f4842991eb7c133db"
+	ConstGLVB2Hex     = "114457d797c9d4961f11084c51771db4b1e1267c4b8239f7d"
+	ConstGLVG1Hex     = "114457d797c9d4961f11084c51771db4b1e1267c4b8239f7d"
+	ConstGLVG2Hex     = "2f13e33420062e6cc2e356bf100a628f4842991eb7c133db"
+	ConstGLVBits      = 193
+)
+
+// Hash to curve parameters.
+const (
+	ConstHashToCurveHash = crypto.SHA384
+	ConstHashToFieldSize = 72
+	ConstSSWUZ           = -12
+)
+
+// Elt is a stub field element type, holding the little-endian bytes of an
+// integer modulo p.
+type Elt [ConstFieldSize]byte
+
+func (x *Elt) SetInt(y *big.Int) *Elt {
+	y = new(big.Int).Mod(y, curvename.P)
+	for i := range x {
+		x[i] = 0
+	}
+	bs := y.Bytes()
+	for i, b := range bs {
+		x[len(bs)-1-i] = b
+	}
+	return x
+}
+
+func (x *Elt) SetInt64(y int64) *Elt {
+	return x.SetInt(big.NewInt(y))
+}
+
+func (x *Elt) SetBytes(b []byte) *Elt {
+	return x.SetInt(new(big.Int).SetBytes(b))
+}
+
+func (x *Elt) SetBytesWide(b []byte) *Elt {
+	return x.SetBytes(b)
+}
+
+func (x *Elt) Int() *big.Int {
+	var be Elt
+	for i := range x {
+		be[len(x)-1-i] = x[i]
+	}
+	return new(big.Int).SetBytes(be[:])
+}
+
+func (x *Elt) SetCanonicalBytes(b []byte) uint {
+	y := new(big.Int).SetBytes(b)
+	x.SetInt(y)
+	if y.Cmp(curvename.P) < 0 {
+		return 1
+	}
+	return 0
+}
+
+func (x *Elt) FillBytes(b []byte) []byte {
+	return x.Int().FillBytes(b)
+}
+
+func CMov(z, x *Elt, c uint) {
+	if c != 0 {
+		*z = *x
+	}
+}
+
+// hasADX stubs the flag selecting ADX implementations of field operations.
+var hasADX bool
+
+func Add(z, x, y *Elt) { z.SetInt(new(big.Int).Add(x.Int(), y.Int())) }
+func Sub(z, x, y *Elt) { z.SetInt(new(big.Int).Sub(x.Int(), y.Int())) }
+func Mul(z, x, y *Elt) { z.SetInt(new(big.Int).Mul(x.Int(), y.Int())) }
+func Sqr(z, x *Elt)    { Mul(z, x, x) }
+func Neg(z, x *Elt)    { z.SetInt(new(big.Int).Neg(x.Int())) }
+
+func Equal(x, y *Elt) uint {
+	if *x == *y {
+		return 1
+	}
+	return 0
+}
+
+func IsZero(x *Elt) uint {
+	var zero Elt
+	return Equal(x, &zero)
+}
+
+func Sqrt(z, x *Elt) uint {
+	r := new(big.Int).ModSqrt(x.Int(), curvename.P)
+	if r == nil {
+		return 0
+	}
+	z.SetInt(r)
+	return 1
+}
+
+func Inv(z, x *Elt) {
+	inv := new(big.Int).ModInverse(x.Int(), curvename.P)
+	if inv == nil {
+		inv = new(big.Int)
+	}
+	z.SetInt(inv)
+}
+
 // Affine is a stub affine point type.
 type Affine struct {
-	X, Y big.Int
+	X, Y Elt
 }
 
 func NewAffine(x, y *big.Int) *Affine {
 	a := new(Affine)
-	a.X.Set(x)
-	a.Y.Set(y)
+	a.X.SetInt(x)
+	a.Y.SetInt(y)
 	return a
 }
 
 func (a *Affine) Set(q *Affine) {
-	a.X.Set(&q.X)
-	a.Y.Set(&q.Y)
+	*a = *q
 }
 
 func (a *Affine) Coordinates() (X, Y *big.Int) {
-	return new(big.Int).Set(&a.X), new(big.Int).Set(&a.Y)
+	return a.X.Int(), a.Y.Int()
 }
 
 func (a *Affine) Jacobian() *Jacobian {
-	j := &Jacobian{}
-	j.a.Set(a)
+	j := &Jacobian{X: a.X, Y: a.Y}
+	j.Z.SetInt64(1)
 	return j
 }
 
 func (a *Affine) Projective() *Projective {
-	p := &Projective{}
-	p.a.Set(a)
+	p := &Projective{X: a.X, Y: a.Y}
+	p.Z.SetInt64(1)
 	return p
 }
 
+func (a *Affine) IsOnCurve() uint {
+	if curvename.Params().IsOnCurve(a.X.Int(), a.Y.Int()) {
+		return 1
+	}
+	return 0
+}
+
+// stubaffine builds a normalized stub point from affine coordinates, where
+// (0,0) represents the point at infinity.
+func stubaffine(x, y *big.Int) (X, Y, Z Elt) {
+	X.SetInt(x)
+	Y.SetInt(y)
+	if x.Sign() != 0 || y.Sign() != 0 {
+		Z.SetInt64(1)
+	}
+	return
+}
+
 // Jacobian is a stub jacobian point type.
 type Jacobian struct {
-	a Affine
+	X, Y, Z Elt
 }
 
 func (p *Jacobian) Set(q *Jacobian) {
-	p.a.Set(&q.a)
+	*p = *q
 }
 
 func (p *Jacobian) Affine() *Affine {
-	return &p.a
+	a := new(Affine)
+	var zinv, t Elt
+	Inv(&zinv, &p.Z)
+	Sqr(&t, &zinv)
+	Mul(&a.X, &p.X, &t)
+	Mul(&t, &t, &zinv)
+	Mul(&a.Y, &p.Y, &t)
+	return a
 }
 
 func (p *Jacobian) CMov(q *Jacobian, c uint) {
@@ -72,128 +216,63 @@ func (p *Jacobian) CMov(q *Jacobian, c uint) {
 
 func (p *Jacobian) CNeg(c uint) {
 	if c != 0 {
-		p.Neg()
+		Neg(&p.Y, &p.Y)
 	}
 }
 
-func (p *Jacobian) Neg() {
-	y := new(big.Int).Neg(&p.a.Y)
-	y.Mod(y, curvename.P)
-	p.a.Y.Set(y)
-}
-
 func (p *Jacobian) Add(q, r *Jacobian) {
-	x, y := curvename.Params().Add(&q.a.X, &q.a.Y, &r.a.X, &r.a.Y)
-	p.a.X.Set(x)
-	p.a.Y.Set(y)
+	x1, y1 := q.Affine().Coordinates()
+	x2, y2 := r.Affine().Coordinates()
+	p.X, p.Y, p.Z = stubaffine(curvename.Params().Add(x1, y1, x2, y2))
 }
 
 func (p *Jacobian) Double(q *Jacobian) {
-	x, y := curvename.Params().Double(&q.a.X, &q.a.Y)
-	p.a.X.Set(x)
-	p.a.Y.Set(y)
+	p.X, p.Y, p.Z = stubaffine(curvename.Params().Double(q.Affine().Coordinates()))
+}
+
+func (p *Jacobian) Endomorphism(q *Jacobian) {
+	lambda, _ := new(big.Int).SetString(ConstGLVLambdaHex, 16)
+	x, y := q.Affine().Coordinates()
+	p.X, p.Y, p.Z = stubaffine(curvename.Params().ScalarMult(x, y, lambda.Bytes()))
 }
 
 func (p *Jacobian) Projective() *Projective {
 	pr := &Projective{}
-	pr.a.Set(&p.a)
+	pr.X, pr.Y, pr.Z = stubaffine(p.Affine().Coordinates())
 	return pr
 }
 
 // Projective is a stub projective point type.
 type Projective struct {
-	a Affine
+	X, Y, Z Elt
 }
 
 func (p *Projective) Affine() *Affine {
-	return &p.a
+	a := new(Affine)
+	var zinv Elt
+	Inv(&zinv, &p.Z)
+	Mul(&a.X, &p.X, &zinv)
+	Mul(&a.Y, &p.Y, &zinv)
+	return a
 }
 
 func (p *Projective) CNeg(c uint) {
 	if c != 0 {
-		p.Neg()
+		Neg(&p.Y, &p.Y)
 	}
 }
 
-func (p *Projective) Neg() {
-	y := new(big.Int).Neg(&p.a.Y)
-	y.Mod(y, curvename.P)
-	p.a.Y.Set(y)
-}
-
 func (p *Projective) CompleteAdd(q, r *Projective) {
-	x, y := curvename.Params().Add(&q.a.X, &q.a.Y, &r.a.X, &r.a.Y)
-	p.a.X.Set(x)
-	p.a.Y.Set(y)
+	x1, y1 := q.Affine().Coordinates()
+	x2, y2 := r.Affine().Coordinates()
+	X, Y, Z := stubaffine(curvename.Params().Add(x1, y1, x2, y2))
+	p.X, p.Y, p.Z = X, Y, Z
+	if Z == (Elt{}) {
+		p.Y.SetInt64(1)
+	}
 }
 
 // lookup position idx in tbl.
 func lookup(p *Jacobian, tbl []Jacobian, idx int) {
 	p.Set(&tbl[idx])
 }
-
-// scalarsize is the size of a scalar field element in bytes.
-const scalarsize = ConstBitSize / 8
-
-// scalar is a stub scalar field element type.
-type scalar [scalarsize]byte
-
-// TODO(mbm): use ec3 itself to codegen the scalar type stub
-// This will reduce duplication and help retain compatibility.
-
-func (k *scalar) SetInt(x *big.Int) {
-	k.SetIntRaw(x)
-}
-
-func (k *scalar) SetIntRaw(x *big.Int) {
-	if x.Sign() < 0 || x.Cmp(curvename.N) >= 0 {
-		x = new(big.Int).Mod(x, curvename.N)
-	}
-
-	for i := range k {
-		k[i] = 0
-	}
-
-	bs := x.Bytes()
-	for i, b := range bs {
-		k[len(bs)-1-i] = b
-	}
-}
-
-// Int converts to a big integer.
-func (k *scalar) Int() *big.Int {
-	return k.IntRaw()
-}
-
-// IntRaw converts to a big integer.
-func (k *scalar) IntRaw() *big.Int {
-	// Endianness swap.
-	var be scalar
-	for i := 0; i < scalarsize; i++ {
-		be[scalarsize-1-i] = k[i]
-	}
-	// Build big.Int.
-	return new(big.Int).SetBytes(be[:])
-}
-
-// SetBytesRaw interprets b as the bytes of a big-endian unsigned integer, and sets k to that value.
-func (k *scalar) SetBytesRaw(b []byte) {
-	k.SetIntRaw(new(big.Int).SetBytes(b))
-}
-
-func scalarcmov(z, x *scalar, c uint) {
-	if c != 0 {
-		*z = *x
-	}
-}
-
-func scalarneg(z, x *scalar) {
-	neg := new(big.Int).Neg(x.IntRaw())
-	neg.Mod(neg, curvename.N)
-	z.SetIntRaw(neg)
-}
-
-func scalarinv(z, x *scalar) {
-	inv := new(big.Int).ModInverse(x.Int(), curvename.N)
-	z.SetInt(inv)
-}
@@ -24,15 +24,19 @@ var sizes = types.SizesFor("gc", "amd64")
 
 type Asm struct {
 	cfg   fp.Config
+	isa   asm.ISA
 	field asmfp.Builder
 	ctx   *build.Context
 }
 
-func NewAsm(cfg fp.Config) *Asm {
+// NewAsm builds an assembly generator for formulae over the field, using
+// instructions from the given instruction set.
+func NewAsm(cfg fp.Config, isa asm.ISA) *Asm {
 	ctx := build.NewContext()
 	return &Asm{
 		cfg:   cfg,
-		field: cfg.Field.Build(ctx),
+		isa:   isa,
+		field: cfg.Field.Build(ctx, isa),
 		ctx:   ctx,
 	}
 }
@@ -192,18 +196,13 @@ func (a *Asm) Function(name string, p *ast.Program, outputs []ast.Variable) erro
 			if err != nil {
 				return err
 			}
-			x := mp.CopyIntoRegisters(a.ctx, ops[1])
-			mp.Sqr(a.ctx, m, x)
-			a.field.ReduceDouble(ops[0], m)
+			a.mul(m, ops[0], ops[1], nil)
 		case ast.Mul:
 			ops, err := a.operands(stack, asgn.LHS, e.X, e.Y)
 			if err != nil {
 				return err
 			}
-			x := mp.CopyIntoRegisters(a.ctx, ops[1])
-			y := mp.CopyIntoRegisters(a.ctx, ops[2])
-			mp.Mul(a.ctx, m, x, y)
-			a.field.ReduceDouble(ops[0], m)
+			a.mul(m, ops[0], ops[1], ops[2])
 		case ast.Sub:
 			ops, err := a.operands(stack, asgn.LHS, e.X, e.Y)
 			if err != nil {
@@ -222,7 +221,7 @@ func (a *Asm) Function(name string, p *ast.Program, outputs []ast.Variable) erro
 			y := mp.CopyIntoRegisters(a.ctx, ops[2])
 			a.field.Add(x, y)
 			mp.Copy(a.ctx, ops[0], x)
-		case ast.Inv, ast.Neg, ast.Cond, ast.Constant:
+		case ast.Inv, ast.Neg, ast.Cond, ast.Constant, ast.Integer:
 			return xerrors.Errorf("operation %T is not supported in assembly", e)
 		default:
 			return errutil.UnexpectedType(e)
@@ -242,6 +241,40 @@ func (a *Asm) Function(name string, p *ast.Program, outputs []ast.Variable) erro
 	return nil
 }
 
+// mul generates code to write the product of x and y to z. If y is nil, x is
+// squared. The m parameter is scratch space for a double-width product.
+func (a *Asm) mul(m, z, x, y mp.Int) {
+	// Use direct field multiplication if available, except for squaring which
+	// benefits more from dedicated squaring. Memory operands are used as they
+	// are, to leave registers for the computation.
+	if f, ok := a.field.(asmfp.Multiplier); ok && y != nil {
+		r := f.Mul(a.addressable(x), a.addressable(y))
+		mp.Copy(a.ctx, z, r)
+		return
+	}
+
+	// Otherwise compute the double-width product and reduce.
+	x = mp.CopyIntoRegisters(a.ctx, x)
+	if y == nil {
+		mp.Sqr(a.ctx, a.isa, m, x)
+	} else {
+		y = mp.CopyIntoRegisters(a.ctx, y)
+		mp.Mul(a.ctx, a.isa, m, x, y)
+	}
+	a.field.ReduceDouble(z, m)
+}
+
+// addressable returns x, copied into registers if it has limbs that are not
+// registers or memory, such as immediates.
+func (a *Asm) addressable(x mp.Int) mp.Int {
+	for _, limb := range x {
+		if !operand.IsRegister(limb) && !operand.IsMem(limb) {
+			return mp.CopyIntoRegisters(a.ctx, x)
+		}
+	}
+	return x
+}
+
 func (a *Asm) operands(vars map[ast.Variable]mp.Int, ops ...ast.Operand) ([]mp.Int, error) {
 	xs := make([]mp.Int, 0, len(ops))
 	for _, op := range ops {
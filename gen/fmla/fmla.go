@@ -10,9 +10,9 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/mmcloughlin/avo/build"
 	"golang.org/x/xerrors"
 
+	"github.com/mmcloughlin/ec3/asm"
 	"github.com/mmcloughlin/ec3/efd/op3"
 	"github.com/mmcloughlin/ec3/efd/op3/ast"
 	"github.com/mmcloughlin/ec3/gen"
@@ -229,6 +229,57 @@ func NewAsmFunctionDefault(f Function) AsmFunction {
 	}
 }
 
+// Equation is a function reporting whether two variables computed by a formula
+// are equal. The generated function returns 1 if the equation holds and 0
+// otherwise, in constant time.
+type Equation struct {
+	Name     string
+	Receiver Parameter
+	Params   []Parameter
+	Globals  []Parameter
+	Formula  *ast.Program
+	LHS      ast.Variable
+	RHS      ast.Variable
+}
+
+func (Equation) private() {}
+
+// Function returns the function evaluating the equation, with a single
+// condition result.
+func (e Equation) Function() Function {
+	return Function{
+		Name:     e.Name,
+		Receiver: e.Receiver,
+		Params:   e.Params,
+		Results:  []Parameter{Condition("ok", W)},
+		Globals:  e.Globals,
+		Formula:  e.Formula,
+	}
+}
+
+// Program returns the program computing both sides of the equation.
+func (e Equation) Program() (*ast.Program, error) {
+	p, err := op3.Pare(e.Formula, []ast.Variable{e.LHS, e.RHS})
+	if err != nil {
+		return nil, err
+	}
+
+	p, err = op3.Lower(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify that all inputs have corresponding variables in the function.
+	variables := e.Function().Variables()
+	for _, input := range op3.Inputs(p) {
+		if _, ok := variables[input]; !ok {
+			return nil, xerrors.Errorf("no variable defined for program input %s", input)
+		}
+	}
+
+	return p, nil
+}
+
 // Lookup is a table lookup function for a given point representation.
 type Lookup struct {
 	Name string
@@ -259,10 +310,21 @@ func Package(cfg Config) (gen.Files, error) {
 
 	fs.Add("point.go", b)
 
-	// Generate assembly.
-	if asm := p.Asm(); asm != nil {
-		err := fs.CompileAsm(cfg.PackageName, "fmla", asm)
-		if err != nil {
+	// Generate assembly. Functions using field multiplication are generated
+	// for each instruction set, in separate files.
+	if p.asm != nil {
+		if err := fs.CompileAsm(cfg.PackageName, "fmla", p.asm.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, isa := range asm.ISAs {
+		a, ok := p.isaasm[isa]
+		if !ok {
+			continue
+		}
+		prefix := "fmla_" + strings.ToLower(isa.String())
+		if err := fs.CompileAsm(cfg.PackageName, prefix, a.Context()); err != nil {
 			return nil, err
 		}
 	}
@@ -273,7 +335,11 @@ func Package(cfg Config) (gen.Files, error) {
 type pointops struct {
 	Config
 	gocode.Generator
-	asm *Asm
+
+	// asm generates functions independent of the instruction set, and isaasm
+	// those specific to each.
+	asm    *Asm
+	isaasm map[asm.ISA]*Asm
 }
 
 func (p *pointops) Generate() ([]byte, error) {
@@ -295,6 +361,8 @@ func (p *pointops) Generate() ([]byte, error) {
 			p.function(c)
 		case AsmFunction:
 			p.asmfunction(c)
+		case Equation:
+			p.equation(c)
 		default:
 			return nil, errutil.UnexpectedType(c)
 		}
@@ -303,19 +371,21 @@ func (p *pointops) Generate() ([]byte, error) {
 	return p.Formatted()
 }
 
-// Asm returns generated assembly, if any.
-func (p *pointops) Asm() *build.Context {
+func (p *pointops) asmbuilder() *Asm {
 	if p.asm == nil {
-		return nil
+		p.asm = NewAsm(p.Field, asm.Baseline)
 	}
-	return p.asm.Context()
+	return p.asm
 }
 
-func (p *pointops) asmbuilder() *Asm {
-	if p.asm == nil {
-		p.asm = NewAsm(p.Field)
+func (p *pointops) isaasmbuilder(isa asm.ISA) *Asm {
+	if p.isaasm == nil {
+		p.isaasm = map[asm.ISA]*Asm{}
 	}
-	return p.asm
+	if _, ok := p.isaasm[isa]; !ok {
+		p.isaasm[isa] = NewAsm(p.Field, isa)
+	}
+	return p.isaasm[isa]
 }
 
 func (p *pointops) constant(c Constant) {
@@ -378,6 +448,34 @@ func (p *pointops) function(f Function) {
 	// Function header.
 	p.header(f)
 
+	// Function body.
+	p.body(f, prog)
+
+	p.footer(f)
+}
+
+func (p *pointops) equation(e Equation) {
+	// Determine program.
+	prog, err := e.Program()
+	if err != nil {
+		p.SetError(err)
+		return
+	}
+
+	// Function header.
+	f := e.Function()
+	p.header(f)
+
+	// Compute both sides and compare.
+	variables := p.body(f, prog)
+	p.Linef("ok = %s(%s, %s)", p.Field.Name("Equal"), variables[e.LHS].Pointer(), variables[e.RHS].Pointer())
+
+	p.footer(f)
+}
+
+// body generates code for the program prog within function f. Returns the
+// mapping from program variables to code.
+func (p *pointops) body(f Function, prog *ast.Program) map[ast.Variable]Variable {
 	// Setup mapping from formula variables to code, and allocate any necessary
 	// temporaries.
 	variables := f.Variables()
@@ -407,10 +505,16 @@ func (p *pointops) function(f Function) {
 			p.Linef("%s = %s", variables[a.LHS].Value(), variables[e].Value())
 		case ast.Constant:
 			p.setint64(variables[a.LHS], int64(e))
+		case ast.Integer:
+			if !e.X.IsInt64() {
+				p.SetError(xerrors.Errorf("integer %s out of range", e))
+				return variables
+			}
+			p.setint64(variables[a.LHS], e.X.Int64())
 		case ast.Pow:
 			if e.N != 2 {
 				p.SetError(errutil.AssertionFailure("power expected to be square"))
-				return
+				return variables
 			}
 			p.call("Sqr", a.LHS, e, variables)
 		case ast.Inv:
@@ -427,11 +531,11 @@ func (p *pointops) function(f Function) {
 			p.Linef("CMov(%s, %s, %s)", variables[a.LHS].Pointer(), variables[e.X].Pointer(), variables[e.C].Value())
 		default:
 			p.SetError(errutil.UnexpectedType(e))
-			return
+			return variables
 		}
 	}
 
-	p.footer(f)
+	return variables
 }
 
 func (p *pointops) asmfunction(f AsmFunction) {
@@ -445,12 +549,14 @@ func (p *pointops) asmfunction(f AsmFunction) {
 	// Enter function.
 	p.header(f.Function)
 
-	// Generate assembly version of function.
-	asm := p.asmbuilder()
+	// Generate assembly versions of the function for each instruction set.
 	outputs := ParametersVariableNames(f.Outputs()...)
-	if err := asm.Function(f.AsmName, prog, outputs); err != nil {
-		p.SetError(err)
-		return
+	for _, isa := range asm.ISAs {
+		a := p.isaasmbuilder(isa)
+		if err := a.Function(f.AsmName+isa.String(), prog, outputs); err != nil {
+			p.SetError(err)
+			return
+		}
 	}
 
 	// Call the assembly function. (Arguments in string order.)
@@ -468,7 +574,12 @@ func (p *pointops) asmfunction(f AsmFunction) {
 		args = append(args, arg)
 	}
 
-	p.Linef("%s(%s)", f.AsmName, strings.Join(args, ", "))
+	// Dispatch on the instruction set.
+	p.Linef("if %s {", p.Field.ADXFlag())
+	p.Linef("%s%s(%s)", f.AsmName, asm.ADX, strings.Join(args, ", "))
+	p.Linef("} else {")
+	p.Linef("%s%s(%s)", f.AsmName, asm.Baseline, strings.Join(args, ", "))
+	p.Linef("}")
 
 	// Leave function.
 	p.footer(f.Function)
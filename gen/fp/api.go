@@ -1,13 +1,16 @@
 package fp
 
 import (
+	"fmt"
 	"math/big"
 	"strings"
 
 	"github.com/mmcloughlin/addchain/acc"
 	"github.com/mmcloughlin/addchain/acc/ir"
 	"github.com/mmcloughlin/addchain/acc/pass"
+	"golang.org/x/xerrors"
 
+	"github.com/mmcloughlin/ec3/asm"
 	"github.com/mmcloughlin/ec3/gen"
 	"github.com/mmcloughlin/ec3/internal/bigint"
 	"github.com/mmcloughlin/ec3/internal/errutil"
@@ -20,6 +23,11 @@ type api struct {
 }
 
 func API(cfg Config) ([]byte, error) {
+	cfg, err := cfg.WithChains()
+	if err != nil {
+		return nil, err
+	}
+
 	a := &api{
 		Config:    cfg,
 		Generator: gocode.NewGenerator(),
@@ -31,7 +39,12 @@ func (a *api) Generate() ([]byte, error) {
 	a.CodeGenerationWarning(gen.GeneratedBy)
 	a.Package(a.Config.PackageName)
 
-	a.Import("math/big")
+	imports := []string{"errors", "math/big"}
+	if a.Inversion == InversionSafeGCD {
+		imports = append(imports, "encoding/binary", "math/bits")
+	}
+	imports = append(imports, "golang.org/x/sys/cpu")
+	a.Import(imports...)
 
 	// Define element type.
 	a.NL()
@@ -57,8 +70,13 @@ func (a *api) Generate() ([]byte, error) {
 		a.SetInt64(raw)
 		a.SetInt(raw)
 		a.SetBytes(raw)
+		a.SetBytesWide(raw)
 		a.Int(raw)
+		a.SetCanonicalBytes(raw)
+		a.SetBytesStrict(raw)
+		a.FillBytes(raw)
 	}
+	a.IsCanonical()
 
 	// Encoding and decoding for montgomery fields.
 	if a.Montgomery() {
@@ -67,8 +85,19 @@ func (a *api) Generate() ([]byte, error) {
 	}
 
 	// Implement field operations.
+	a.Multiplication()
+	a.Vector()
 	a.Negate()
 	a.Inverse()
+	a.BatchInverse()
+	a.Exponentiations()
+	a.IsZero()
+
+	if a.SqrtChain != nil {
+		a.Sqrt()
+		a.IsSquare()
+		a.Legendre()
+	}
 
 	return a.Formatted()
 }
@@ -123,6 +152,61 @@ func (a *api) SetBytes(raw bool) {
 	a.LeaveBlock()
 }
 
+// SetBytesWide generates a constant-time function to reduce an integer of up to
+// twice the element size modulo p. The input is split into halves lo and hi of
+// the element size, and the result computed as lo*cₗ + hi*cₕ with two field
+// multiplications. The constants account for the factor introduced by
+// multiplication and the encoding of the result, so that cₗ encodes 1 and cₕ
+// encodes 2ˢ, where s is the element size in bits. The reduction in
+// multiplication accepts the unreduced halves, since their products with the
+// constants are less than p times the multiplication factor.
+func (a *api) SetBytesWide(raw bool) {
+	p := a.Field.Prime()
+
+	// Multiplication divides by m, and encoding multiplies by e.
+	m, e := bigint.One(), bigint.One()
+	if a.Montgomery() {
+		m = bigint.Pow2(uint(a.Field.ElementBits()))
+		if !raw {
+			e = m
+		}
+	}
+
+	lo := new(big.Int).Mul(m, e)
+	lo.Mod(lo, p)
+	hi := new(big.Int).Lsh(lo, uint(8*a.Field.ElementSize()))
+	hi.Mod(hi, p)
+
+	clo, chi := rawname("widelo", raw), rawname("widehi", raw)
+	a.Commentf("%s and %s are the multipliers for the low and high halves of the input to %s.", a.Name(clo), a.Name(chi), rawname("SetBytesWide", raw))
+	a.DefineVar(clo, lo)
+	a.DefineVar(chi, hi)
+
+	name := rawname("SetBytesWide", raw)
+	a.Commentf("%s sets x to the big-endian integer b reduced modulo p, in constant", name)
+	a.Commentf("time. The slice b must be at most 2*%s bytes long. For uniform sampling,", a.Size())
+	a.Comment("b should be longer than the encoding of p by the number of bits of security.")
+	a.rawcomment(raw)
+	a.Printf("func (x %s) %s(b []byte) %s", a.PointerType(), name, a.PointerType())
+	a.EnterBlock()
+
+	a.Comment("Split into little-endian halves.")
+	a.Linef("var w [2 * %s]byte", a.Size())
+	a.Linef("for i := range b {")
+	a.Linef("w[i] = b[len(b)-1-i]")
+	a.Linef("}")
+	a.Linef("var lo, hi %s", a.Type())
+	a.Linef("copy(lo[:], w[:%s])", a.Size())
+	a.Linef("copy(hi[:], w[%s:])", a.Size())
+	a.NL()
+
+	a.Call("Mul", "&lo", "&lo", "&"+a.Name(clo))
+	a.Call("Mul", "&hi", "&hi", "&"+a.Name(chi))
+	a.Call("Add", "x", "&lo", "&hi")
+	a.Linef("return x")
+	a.LeaveBlock()
+}
+
 // SetInt generates a function to construct a field element from a big integer.
 func (a *api) SetInt(raw bool) {
 	a.Commentf("%s constructs a field element from a big integer.", rawname("SetInt", raw))
@@ -180,6 +264,104 @@ func (a *api) Int(raw bool) {
 	a.LeaveBlock()
 }
 
+// SetCanonicalBytes generates a constant-time function to set a field element
+// from big-endian bytes, reporting whether the value was canonical.
+func (a *api) SetCanonicalBytes(raw bool) {
+	name := rawname("SetCanonicalBytes", raw)
+	a.Commentf("%s sets x to the big-endian integer b, which must be at most %s bytes long.", name, a.Size())
+	a.Comment("Returns 1 if the value is less than p and 0 otherwise, in constant time.")
+	a.rawcomment(raw)
+	a.Printf("func (x %s) %s(b []byte) uint", a.PointerType(), name)
+	a.EnterBlock()
+
+	a.Comment("Copy bytes into field element.")
+	a.Linef("i := 0")
+	a.Linef("for ; i < len(b); i++ {")
+	a.Linef("x[i] = b[len(b)-1-i]")
+	a.Linef("}")
+	a.Linef("for ; i < %s; i++ {", a.Size())
+	a.Linef("x[i] = 0")
+	a.Linef("}")
+
+	a.Comment("Compute the borrow of x - p, which is set if and only if x < p.")
+	a.Linef("var borrow uint")
+	a.Linef("for i := 0; i < %s; i++ {", a.Size())
+	a.Linef("borrow = ((uint(x[i]) - uint(%s[i]) - borrow) >> 8) & 1", a.Name("prime"))
+	a.Linef("}")
+
+	if !raw && a.Montgomery() {
+		a.Comment("Encode into the Montgomery domain.")
+		a.Call("Encode", "x", "x")
+	}
+
+	a.Linef("return borrow")
+	a.LeaveBlock()
+}
+
+// SetBytesStrict generates a function to set a field element from its exact
+// big-endian encoding, rejecting non-canonical values.
+func (a *api) SetBytesStrict(raw bool) {
+	name := rawname("SetBytesStrict", raw)
+	n := (a.Field.Prime().BitLen() + 7) / 8
+	a.Commentf("%s sets x to the big-endian integer b, which must be %d bytes long and", name, n)
+	a.Comment("less than p. Otherwise an error is returned and x is unchanged.")
+	a.rawcomment(raw)
+	a.Printf("func (x %s) %s(b []byte) (%s, error)", a.PointerType(), name, a.PointerType())
+	a.EnterBlock()
+	a.Linef("if len(b) != %d {", n)
+	a.Linef("return nil, errors.New(\"invalid field element encoding length\")")
+	a.Linef("}")
+	a.Linef("var t %s", a.Type())
+	a.Linef("if t.%s(b) != 1 {", rawname("SetCanonicalBytes", raw))
+	a.Linef("return nil, errors.New(\"non-canonical field element encoding\")")
+	a.Linef("}")
+	a.Linef("*x = t")
+	a.Linef("return x, nil")
+	a.LeaveBlock()
+}
+
+// IsCanonical generates a function to check whether bytes encode an integer
+// less than p.
+func (a *api) IsCanonical() {
+	name := a.Name("IsCanonical")
+	a.Commentf("%s returns 1 if the big-endian integer b, which must be at most %s bytes", name, a.Size())
+	a.Comment("long, is less than p and 0 otherwise, in constant time.")
+	a.Printf("func %s(b []byte) uint", name)
+	a.EnterBlock()
+	a.Linef("var x %s", a.Type())
+	a.Linef("return x.%s(b)", rawname("SetCanonicalBytes", true))
+	a.LeaveBlock()
+}
+
+// FillBytes generates a function to write a field element to a byte slice.
+func (a *api) FillBytes(raw bool) {
+	name := rawname("FillBytes", raw)
+	a.Commentf("%s sets b to the big-endian encoding of x and returns it. The slice b", name)
+	a.Comment("must be at least as long as the encoding of p; any extra leading bytes are zeroed.")
+	a.rawcomment(raw)
+	a.Printf("func (x %s) %s(b []byte) []byte", a.PointerType(), name)
+	a.EnterBlock()
+
+	if !raw && a.Montgomery() {
+		a.Linef("var z %s", a.Type())
+		a.Comment("Decode from the Montgomery domain.")
+		a.Call("Decode", "&z", "x")
+	} else {
+		a.Linef("z := *x")
+	}
+
+	a.Comment("Write bytes in reverse order.")
+	a.Linef("for i := range b {")
+	a.Linef("b[len(b)-1-i] = 0")
+	a.Linef("if i < %s {", a.Size())
+	a.Linef("b[len(b)-1-i] = z[i]")
+	a.Linef("}")
+	a.Linef("}")
+
+	a.Linef("return b")
+	a.LeaveBlock()
+}
+
 // Decode generates a decode function for Montgomery fields.
 func (a *api) Decode() {
 	one := a.Name("one")
@@ -207,6 +389,86 @@ func (a *api) Encode() {
 	a.LeaveBlock()
 }
 
+// Multiplication generates the multiplication functions. These are
+// implemented in assembly for each instruction set, and dispatched on CPU
+// features detected at initialization.
+func (a *api) Multiplication() {
+	a.Commentf("%s reports whether the processor supports the BMI2 and ADX instructions used by the fastest multiplication code. Otherwise baseline implementations are used.", a.ADXFlag())
+	a.Linef("var %s = cpu.X86.HasBMI2 && cpu.X86.HasADX", a.ADXFlag())
+
+	a.NL()
+	a.Commentf("%s computes z = x*y (mod p).", a.Name("Mul"))
+	a.Function(a.Name("Mul"), a.Signature("z", "x", "y"))
+	a.dispatch("mul", "z", "x", "y")
+	a.LeaveBlock()
+
+	a.Commentf("%s computes z = x² (mod p).", a.Name("Sqr"))
+	a.Function(a.Name("Sqr"), a.Signature("z", "x"))
+	a.dispatch("sqr", "z", "x")
+	a.LeaveBlock()
+}
+
+// Vector generates functions operating on slices of field elements. These
+// are implemented in assembly loops, avoiding the call overhead of operating on
+// one element at a time.
+func (a *api) Vector() {
+	a.Commentf("%s computes z[i] = x[i] + y[i] (mod p) for each i.", a.Name("AddVec"))
+	a.veccomment()
+	a.Printf("func %s(z, x, y []%s)", a.Name("AddVec"), a.Type())
+	a.EnterBlock()
+	a.veccheck("AddVec", "z", "x", "y")
+	a.dispatch("addvec", "z", "x", "y")
+	a.LeaveBlock()
+
+	a.Commentf("%s computes z[i] = x[i]*y[i] (mod p) for each i.", a.Name("MulVec"))
+	a.veccomment()
+	a.Printf("func %s(z, x, y []%s)", a.Name("MulVec"), a.Type())
+	a.EnterBlock()
+	a.veccheck("MulVec", "z", "x", "y")
+	a.dispatch("mulvec", "z", "x", "y")
+	a.LeaveBlock()
+
+	a.Commentf("%s computes z[i] = x[i]² (mod p) for each i.", a.Name("SqrVec"))
+	a.veccomment()
+	a.Printf("func %s(z, x []%s)", a.Name("SqrVec"), a.Type())
+	a.EnterBlock()
+	a.veccheck("SqrVec", "z", "x")
+	a.dispatch("sqrvec", "z", "x")
+	a.LeaveBlock()
+}
+
+func (a *api) veccomment() {
+	a.Comment(
+		"The slices must have the same length. The output may be identical to an",
+		"input, but must not otherwise overlap it.",
+	)
+}
+
+// veccheck generates code to panic if the named slices have different lengths,
+// and return if they are empty.
+func (a *api) veccheck(name string, slices ...string) {
+	conds := []string{}
+	for _, s := range slices[1:] {
+		conds = append(conds, fmt.Sprintf("len(%s) != len(%s)", s, slices[0]))
+	}
+	a.Linef("if %s {", strings.Join(conds, " || "))
+	a.Linef("panic(%q)", a.Name(name)+": slice lengths differ")
+	a.Linef("}")
+	a.Linef("if len(%s) == 0 {", slices[0])
+	a.Linef("return")
+	a.Linef("}")
+}
+
+// dispatch generates a call to the implementation of the named function for
+// the supported instruction set.
+func (a *api) dispatch(name string, args ...interface{}) {
+	a.Linef("if %s {", a.ADXFlag())
+	a.Call(name+asm.ADX.String(), args...)
+	a.Linef("} else {")
+	a.Call(name+asm.Baseline.String(), args...)
+	a.Linef("}")
+}
+
 // Negate generates a negation function. This is currently implemented using
 // subtraction.
 func (a *api) Negate() {
@@ -217,18 +479,109 @@ func (a *api) Negate() {
 }
 
 func (a *api) Inverse() {
-	// Function header.
-	a.Commentf("%s computes z = 1/x (mod p).", a.Name("Inv"))
-	a.Function(a.Name("Inv"), a.Signature("z", "x"))
+	if a.Inversion == InversionSafeGCD {
+		a.InverseSafeGCD()
+		return
+	}
+
+	// Confirm the supplied chain computes the expected exponent.
+	if err := verify(a.InverseChain, InverseExponent(a.Field.Prime())); err != nil {
+		a.SetError(xerrors.Errorf("inversion: %w", err))
+		return
+	}
+
+	a.exponentiation("Inv", a.InverseChain, fmt.Sprintf("%s computes z = 1/x (mod p).", a.Name("Inv")))
+}
+
+// Exponentiations generates the fixed-exponent functions requested in the
+// configuration.
+func (a *api) Exponentiations() {
+	for _, e := range a.Config.Exponentiations {
+		t, err := target(e.Chain)
+		if err != nil {
+			a.SetError(xerrors.Errorf("exponentiation %q: %w", e.Name, err))
+			return
+		}
+		if e.Exponent != nil && t.Cmp(e.Exponent) != 0 {
+			a.SetError(xerrors.Errorf("exponentiation %q: chain computes %#x: expected %#x", e.Name, t, e.Exponent))
+			return
+		}
 
-	// Comment describing the addition chain.
-	p := a.InverseChain.Clone()
+		a.exponentiation(e.Name, e.Chain,
+			fmt.Sprintf("%s computes z = x^e (mod p), where", a.Name(e.Name)),
+			"",
+			fmt.Sprintf("\te = %#x", t),
+		)
+	}
+}
+
+// exponentiation generates a function computing z = x^e, where e is the target
+// of the addition chain p. The doc comment is given by lines.
+func (a *api) exponentiation(name string, p *ir.Program, lines ...string) {
+	a.Comment(lines...)
+	a.Function(a.Name(name), a.Signature("z", "x"))
+	a.Comment("Exponentiation is derived from the addition chain:", "")
+	a.chain(p)
+	a.LeaveBlock()
+}
+
+// BatchInverse generates a function to invert many elements with Montgomery's
+// trick, at the cost of one inversion and three multiplications per element.
+// Zero elements are replaced by one in the running product so they do not
+// affect the others.
+func (a *api) BatchInverse() {
+	one := a.Name("batchone")
+	a.Commentf("%s is the field element 1, encoded, for use by %s.", one, a.Name("BatchInv"))
+	a.Linef("var %s = new(%s).SetInt64(1)", one, a.Type())
+
+	a.Commentf("%s computes z[i] = 1/x[i] (mod p) for each i, using a single inversion.", a.Name("BatchInv"))
+	a.Comment(
+		"As with "+a.Name("Inv")+", the inverse of zero is zero. The slices must have the same",
+		"length, and z may be x. A temporary slice of len(x) elements is allocated to",
+		"hold intermediate products.",
+	)
+	a.Printf("func %s(z, x []%s)", a.Name("BatchInv"), a.Type())
+	a.EnterBlock()
+	a.veccheck("BatchInv", "z", "x")
+	a.Linef("var zero %s", a.Type())
+	a.NL()
+
+	a.Comment("Compute prefix products t[i] = x[0] * ... * x[i-1].")
+	a.Linef("t := make([]%s, len(x))", a.Type())
+	a.Linef("acc := *%s", one)
+	a.Linef("for i := range x {")
+	a.Linef("xi := x[i]")
+	a.Call("CMov", "&xi", one, a.Name("IsZero")+"(&xi)")
+	a.Linef("t[i] = acc")
+	a.Call("Mul", "&acc", "&acc", "&xi")
+	a.Linef("}")
+	a.NL()
+
+	a.Comment("Invert the product, then peel off one element at a time.")
+	a.Linef("var inv %s", a.Type())
+	a.Call("Inv", "&inv", "&acc")
+	a.Linef("for i := len(x) - 1; i >= 0; i-- {")
+	a.Linef("xi := x[i]")
+	a.Linef("iszero := %s(&xi)", a.Name("IsZero"))
+	a.Call("CMov", "&xi", one, "iszero")
+	a.Linef("var zi %s", a.Type())
+	a.Call("Mul", "&zi", "&inv", "&t[i]")
+	a.Call("Mul", "&inv", "&inv", "&xi")
+	a.Call("CMov", "&zi", "&zero", "iszero")
+	a.Linef("z[i] = zi")
+	a.Linef("}")
+	a.LeaveBlock()
+}
+
+// chain generates the body of a function computing z = x^e, where e is the
+// target of the addition chain p.
+func (a *api) chain(p *ir.Program) {
+	p = p.Clone()
 	script, err := acc.String(p)
 	if err != nil {
 		a.SetError(err)
 		return
 	}
-	a.Comment("Inversion computation is derived from the addition chain:", "")
 	a.Comment(strings.Split(script, "\n")...)
 
 	if err := pass.Eval(p); err != nil {
@@ -251,10 +604,18 @@ func (a *api) Inverse() {
 	}
 
 	// Allocate required temporaries.
-	n := len(p.Temporaries)
+	if n := len(p.Temporaries); n > 0 {
+		a.NL()
+		a.Commentf("Allocate %d temporaries.", n)
+		a.Linef("var t [%d]%s", n, a.Type())
+	}
+
+	// The output may be written before the last use of the input, so take a
+	// copy to allow z and x to alias.
 	a.NL()
-	a.Commentf("Allocate %d temporaries.", n)
-	a.Linef("var t [%d]%s", n, a.Type())
+	a.Comment("Copy the input, since z and x may alias.")
+	a.Linef("xc := *x")
+	a.Linef("x = &xc")
 
 	for _, inst := range p.Instructions {
 		a.NL()
@@ -277,6 +638,164 @@ func (a *api) Inverse() {
 			a.SetError(errutil.UnexpectedType(op))
 		}
 	}
+}
 
+// IsZero generates a constant-time zero test. Zero has the same representation
+// in every encoding.
+func (a *api) IsZero() {
+	a.Commentf("%s returns 1 if x is zero and 0 otherwise, in constant time.", a.Name("IsZero"))
+	a.Printf("func %s(x %s) uint", a.Name("IsZero"), a.PointerType())
+	a.EnterBlock()
+	a.Linef("var zero %s", a.Type())
+	a.Linef("return %s(x, &zero)", a.Name("Equal"))
+	a.LeaveBlock()
+}
+
+// SqrtExponent returns the exponent that must be computed by the square root
+// addition chain for prime p. The square root algorithm depends on p:
+//
+//	p ≡ 3 (mod 4): exponentiation by (p+1)/4
+//	p ≡ 5 (mod 8): Atkin's algorithm, requiring exponent (p-5)/8
+//	otherwise:     constant-time Tonelli-Shanks, requiring exponent (q-1)/2
+//	               where p - 1 = 2ˢ q with q odd
+func SqrtExponent(p *big.Int) *big.Int {
+	e := new(big.Int)
+	switch {
+	case p.Bit(0) == 1 && p.Bit(1) == 1:
+		e.Add(p, bigint.One())
+		e.Rsh(e, 2)
+	case p.Bit(0) == 1 && p.Bit(1) == 0 && p.Bit(2) == 1:
+		e.Sub(p, big.NewInt(5))
+		e.Rsh(e, 3)
+	default:
+		_, q := twoadic(p)
+		e.Rsh(q, 1)
+	}
+	return e
+}
+
+// twoadic returns s and odd q such that p - 1 = 2ˢ q.
+func twoadic(p *big.Int) (uint, *big.Int) {
+	q := new(big.Int).Sub(p, bigint.One())
+	s := q.TrailingZeroBits()
+	q.Rsh(q, s)
+	return s, q
+}
+
+// DefineElement defines a field element variable set to the integer value x,
+// encoded into the Montgomery domain if required.
+func (a *api) DefineElement(name string, x *big.Int) {
+	if a.Montgomery() {
+		x = new(big.Int).Lsh(x, uint(a.Field.ElementBits()))
+		x.Mod(x, a.Field.Prime())
+	}
+	a.DefineVar(name, x)
+}
+
+// Sqrt generates a square root function, using the algorithm appropriate for
+// the field prime.
+func (a *api) Sqrt() {
+	p := a.Field.Prime()
+
+	// Confirm the supplied chain computes the expected exponent.
+	if err := verify(a.SqrtChain, SqrtExponent(p)); err != nil {
+		a.SetError(xerrors.Errorf("square root: %w", err))
+		return
+	}
+
+	// Exponentiation function.
+	a.exponentiation("sqrtexp", a.SqrtChain,
+		fmt.Sprintf("%s computes z = x^e (mod p) for the exponent e required by %s.", a.Name("sqrtexp"), a.Name("Sqrt")),
+	)
+
+	// Constants.
+	one := a.Name("sqrtone")
+	a.Commentf("%s is the field element 1.", one)
+	a.DefineElement("sqrtone", bigint.One())
+
+	s, q := twoadic(p)
+	tonelli := s > 2
+	if tonelli {
+		// Find the smallest non-residue.
+		z := big.NewInt(2)
+		for big.Jacobi(z, p) != -1 {
+			z.Add(z, bigint.One())
+		}
+		c := new(big.Int).Exp(z, q, p)
+
+		a.Commentf("%s is %s^q, where p - 1 = 2^%d q with q odd and %s is a non-square.", a.Name("sqrtc"), z, s, z)
+		a.DefineElement("sqrtc", c)
+	}
+
+	// Function header.
+	a.Commentf("%s computes z = √x (mod p), returning 1 if x is a square and 0", a.Name("Sqrt"))
+	a.Comment("otherwise. If x is not a square the value of z is undefined.")
+	a.Printf("func %s(z, x %s) uint", a.Name("Sqrt"), a.PointerType())
+	a.EnterBlock()
+	a.Linef("var r, r2 %s", a.Type())
+
+	switch {
+	case s == 1:
+		a.Comment("Since p ≡ 3 (mod 4), r = x^((p+1)/4) is a root if one exists.")
+		a.Call("sqrtexp", "&r", "x")
+	case s == 2:
+		a.Comment("Since p ≡ 5 (mod 8), apply Atkin's algorithm:")
+		a.Comment("t = (2x)^((p-5)/8), i = 2xt², r = xt(i - 1).")
+		a.Linef("var x2, t, i %s", a.Type())
+		a.Call("Add", "&x2", "x", "x")
+		a.Call("sqrtexp", "&t", "&x2")
+		a.Call("Sqr", "&i", "&t")
+		a.Call("Mul", "&i", "&i", "&x2")
+		a.Call("Sub", "&i", "&i", "&"+one)
+		a.Call("Mul", "&r", "x", "&t")
+		a.Call("Mul", "&r", "&r", "&i")
+	default:
+		a.Comment("Constant-time Tonelli-Shanks, following RFC 9380 Appendix I.4.")
+		a.Linef("var t, b, c, rc, tc %s", a.Type())
+		a.Call("sqrtexp", "&r", "x")
+		a.Call("Sqr", "&t", "&r")
+		a.Call("Mul", "&t", "&t", "x")
+		a.Call("Mul", "&r", "&r", "x")
+		a.Linef("b = t")
+		a.Linef("c = %s", a.Name("sqrtc"))
+		a.Linef("for i := %d; i >= 2; i-- {", s)
+		a.Linef("for j := 1; j <= i-2; j++ {")
+		a.Call("Sqr", "&b", "&b")
+		a.Linef("}")
+		a.Linef("e := %s(&b, &%s)", a.Name("Equal"), one)
+		a.Call("Mul", "&rc", "&r", "&c")
+		a.Call("CMov", "&r", "&rc", "e^1")
+		a.Call("Sqr", "&c", "&c")
+		a.Call("Mul", "&tc", "&t", "&c")
+		a.Call("CMov", "&t", "&tc", "e^1")
+		a.Linef("b = t")
+		a.Linef("}")
+	}
+
+	a.Comment("Check the candidate root.")
+	a.Call("Sqr", "&r2", "&r")
+	a.Linef("ok := %s(&r2, x)", a.Name("Equal"))
+	a.Linef("*z = r")
+	a.Linef("return ok")
+	a.LeaveBlock()
+}
+
+// IsSquare generates a function to determine whether an element is a square.
+func (a *api) IsSquare() {
+	a.Commentf("%s returns 1 if x is a square (including zero) and 0 otherwise.", a.Name("IsSquare"))
+	a.Printf("func %s(x %s) uint", a.Name("IsSquare"), a.PointerType())
+	a.EnterBlock()
+	a.Linef("var z %s", a.Type())
+	a.Linef("return %s(&z, x)", a.Name("Sqrt"))
+	a.LeaveBlock()
+}
+
+// Legendre generates a function to compute the Legendre symbol.
+func (a *api) Legendre() {
+	a.Commentf("%s returns the Legendre symbol of x: 0 if x is zero, 1 if x is a", a.Name("Legendre"))
+	a.Comment("non-zero square and -1 otherwise.")
+	a.Printf("func %s(x %s) int", a.Name("Legendre"), a.PointerType())
+	a.EnterBlock()
+	a.Linef("return int(2*%s(x)) - 1 - int(%s(x))", a.Name("IsSquare"), a.Name("IsZero"))
 	a.LeaveBlock()
 }
@@ -7,22 +7,28 @@ import (
 	"github.com/mmcloughlin/avo/attr"
 	"github.com/mmcloughlin/avo/build"
 	"github.com/mmcloughlin/avo/gotypes"
+	"github.com/mmcloughlin/avo/operand"
 
+	"github.com/mmcloughlin/ec3/asm"
 	"github.com/mmcloughlin/ec3/asm/fp"
 	"github.com/mmcloughlin/ec3/asm/mp"
 )
 
 type Asm struct {
 	cfg   Config
+	isa   asm.ISA
 	field fp.Builder
 	ctx   *build.Context
 }
 
-func NewAsm(cfg Config) *Asm {
+// NewAsm builds an assembly generator for the field, using instructions from
+// the given instruction set.
+func NewAsm(cfg Config, isa asm.ISA) *Asm {
 	ctx := build.NewContext()
 	return &Asm{
 		cfg:   cfg,
-		field: cfg.Field.Build(ctx),
+		isa:   isa,
+		field: cfg.Field.Build(ctx, isa),
 		ctx:   ctx,
 	}
 }
@@ -40,6 +46,73 @@ func (a Asm) Function(name string, params ...string) {
 	a.ctx.Signature(gotypes.NewSignature(nil, sig))
 }
 
+// VecFunction declares a function taking slices of field elements as
+// parameters.
+func (a Asm) VecFunction(name string, params ...string) {
+	a.ctx.Function(a.cfg.Name(name))
+	a.ctx.Pragma("noescape")
+	a.ctx.Attributes(attr.NOSPLIT)
+
+	vars := []*types.Var{}
+	for _, param := range params {
+		vars = append(vars, types.NewParam(token.NoPos, nil, param, types.NewSlice(a.cfg.Type())))
+	}
+	sig := types.NewSignature(nil, types.NewTuple(vars...), nil, false)
+	a.ctx.Signature(gotypes.NewSignature(nil, sig))
+}
+
+// param returns a function that loads the named field element parameter. The
+// load is deferred so that callers can delay it until the pointer is needed.
+func (a Asm) param(name string) func() mp.Int {
+	return func() mp.Int {
+		return mp.Param(a.ctx, name, a.field.Limbs())
+	}
+}
+
+// loop generates a loop over the elements of the named slice parameters, which
+// are assumed to be non-empty and of the same length. The body is called with
+// functions loading the current element of each slice. Pointers and the
+// remaining count are kept on the stack, so the body has the same registers
+// available as a function operating on single elements.
+func (a Asm) loop(params []string, body func(elts []func() mp.Int)) {
+	k := a.field.Limbs()
+	size := a.field.ElementSize()
+
+	// Store slice pointers and length on the stack.
+	ptrs := make([]operand.Mem, len(params))
+	for i, param := range params {
+		ptrs[i] = a.ctx.AllocLocal(8)
+		base := a.ctx.Load(a.ctx.Param(param).Base(), a.ctx.GP64())
+		a.ctx.MOVQ(base, ptrs[i])
+	}
+
+	n := a.ctx.AllocLocal(8)
+	length := a.ctx.Load(a.ctx.Param(params[0]).Len(), a.ctx.GP64())
+	a.ctx.MOVQ(length, n)
+
+	// Loop body.
+	a.ctx.Label("loop")
+
+	elts := make([]func() mp.Int, len(params))
+	for i := range ptrs {
+		ptr := ptrs[i]
+		elts[i] = func() mp.Int {
+			base := a.ctx.GP64()
+			a.ctx.MOVQ(ptr, base)
+			return mp.NewIntFromMem(operand.Mem{Base: base}, k)
+		}
+	}
+	body(elts)
+
+	// Advance to the next elements.
+	a.ctx.Comment("Advance.")
+	for _, ptr := range ptrs {
+		a.ctx.ADDQ(operand.U32(size), ptr)
+	}
+	a.ctx.DECQ(n)
+	a.ctx.JNE(operand.LabelRef("loop"))
+}
+
 func (a Asm) CMov() {
 	// Declare the function. We can't use the standard helper here since this
 	// doesn't only take field element arguments.
@@ -72,14 +145,92 @@ func (a Asm) CMov() {
 	a.ctx.RET()
 }
 
-func (a Asm) Add() {
-	a.Function("Add", "z", "x", "y")
+// Select generates a constant-time function setting z to x if c is 1 and y if
+// c is 0.
+func (a Asm) Select() {
+	a.ctx.Function(a.cfg.Name("Select"))
+	a.ctx.Doc(
+		a.cfg.Name("Select")+" sets z to x if c is 1 and y if c is 0, in constant time. The output",
+		"may alias either input.",
+	)
+	a.ctx.Pragma("noescape")
+	a.ctx.Attributes(attr.NOSPLIT)
+	params := types.NewTuple(
+		a.cfg.Param("z"),
+		a.cfg.Param("x"),
+		a.cfg.Param("y"),
+		types.NewParam(token.NoPos, nil, "c", types.Typ[types.Uint]),
+	)
+	sig := types.NewSignature(nil, params, nil, false)
+	a.ctx.Signature(gotypes.NewSignature(nil, sig))
 
-	// Load parameters.
-	zp := mp.Param(a.ctx, "z", a.field.Limbs())
+	// Load parameters. Only y needs to be in registers, since the conditional
+	// move may read from memory.
+	yp := mp.Param(a.ctx, "y", a.field.Limbs())
+	xp := mp.Param(a.ctx, "x", a.field.Limbs())
+	c := a.ctx.Load(a.ctx.Param("c"), a.ctx.GP64())
+	y := mp.CopyIntoRegisters(a.ctx, yp)
+
+	// Select and write to z.
+	mp.ConditionalMove(a.ctx, y, xp, c)
+	mp.Copy(a.ctx, mp.Param(a.ctx, "z", a.field.Limbs()), y)
+
+	a.ctx.RET()
+}
+
+// Equal generates a constant-time equality check. Field operations produce
+// fully reduced outputs, so equality of values is equality of limbs.
+func (a Asm) Equal() {
+	a.ctx.Function(a.cfg.Name("Equal"))
+	a.ctx.Doc(a.cfg.Name("Equal") + " returns 1 if x and y are equal and 0 otherwise, in constant time.")
+	a.ctx.Pragma("noescape")
+	a.ctx.Attributes(attr.NOSPLIT)
+	params := types.NewTuple(a.cfg.Param("x"), a.cfg.Param("y"))
+	results := types.NewTuple(types.NewParam(token.NoPos, nil, "", types.Typ[types.Uint]))
+	sig := types.NewSignature(nil, params, results, false)
+	a.ctx.Signature(gotypes.NewSignature(nil, sig))
+
+	// Accumulate the OR of the differences between limbs.
 	xp := mp.Param(a.ctx, "x", a.field.Limbs())
 	yp := mp.Param(a.ctx, "y", a.field.Limbs())
+	d := a.ctx.GP64()
+	a.ctx.MOVQ(xp[0], d)
+	a.ctx.XORQ(yp[0], d)
+	t := a.ctx.GP64()
+	for i := 1; i < a.field.Limbs(); i++ {
+		a.ctx.MOVQ(xp[i], t)
+		a.ctx.XORQ(yp[i], t)
+		a.ctx.ORQ(t, d)
+	}
+
+	// Negation sets the carry flag if and only if d is non-zero, so
+	// subtract-with-borrow and increment produce the result.
+	r := a.ctx.GP64()
+	a.ctx.NEGQ(d)
+	a.ctx.SBBQ(r, r)
+	a.ctx.INCQ(r)
+	a.ctx.Store(r, a.ctx.ReturnIndex(0))
 
+	a.ctx.RET()
+}
+
+func (a Asm) Add() {
+	a.Function("Add", "z", "x", "y")
+	a.add(a.param("z"), a.param("x")(), a.param("y")())
+	a.ctx.RET()
+}
+
+// AddVec generates a function adding slices of field elements.
+func (a Asm) AddVec() {
+	a.VecFunction("addvec"+a.isa.String(), "z", "x", "y")
+	a.loop([]string{"z", "x", "y"}, func(elts []func() mp.Int) {
+		a.add(elts[0], elts[1](), elts[2]())
+	})
+	a.ctx.RET()
+}
+
+// add generates code to write the sum of x and y to z.
+func (a Asm) add(z func() mp.Int, xp, yp mp.Int) {
 	// Bring into registers.
 	x := mp.CopyIntoRegisters(a.ctx, xp)
 	y := mp.CopyIntoRegisters(a.ctx, yp)
@@ -88,9 +239,7 @@ func (a Asm) Add() {
 	a.field.Add(x, y)
 
 	// Write back to memory.
-	mp.Copy(a.ctx, zp, x)
-
-	a.ctx.RET()
+	mp.Copy(a.ctx, z(), x)
 }
 
 func (a Asm) Sub() {
@@ -115,42 +264,60 @@ func (a Asm) Sub() {
 }
 
 func (a Asm) Mul() {
-	a.Function("Mul", "z", "x", "y")
-	k := a.field.Limbs()
-
-	// Load parameters.
-	z := mp.Param(a.ctx, "z", k)
-	x := mp.Param(a.ctx, "x", k)
-	y := mp.Param(a.ctx, "y", k)
+	a.Function("mul"+a.isa.String(), "z", "x", "y")
+	a.mul(a.param("z"), a.param("x")(), a.param("y")())
+	a.ctx.RET()
+}
 
-	// Perform multiplication.
-	// TODO(mbm): is it possible to store the intermediate result in registers?
-	m := mp.AllocLocal(a.ctx, 2*k)
-	mp.Mul(a.ctx, m, x, y)
+func (a Asm) Sqr() {
+	a.Function("sqr"+a.isa.String(), "z", "x")
+	a.mul(a.param("z"), a.param("x")(), nil)
+	a.ctx.RET()
+}
 
-	// Reduce.
-	a.ctx.Comment("Reduction.")
-	a.field.ReduceDouble(z, m)
+// MulVec generates a function multiplying slices of field elements.
+func (a Asm) MulVec() {
+	a.VecFunction("mulvec"+a.isa.String(), "z", "x", "y")
+	a.loop([]string{"z", "x", "y"}, func(elts []func() mp.Int) {
+		a.mul(elts[0], elts[1](), elts[2]())
+	})
+	a.ctx.RET()
+}
 
+// SqrVec generates a function squaring a slice of field elements.
+func (a Asm) SqrVec() {
+	a.VecFunction("sqrvec"+a.isa.String(), "z", "x")
+	a.loop([]string{"z", "x"}, func(elts []func() mp.Int) {
+		a.mul(elts[0], elts[1](), nil)
+	})
 	a.ctx.RET()
 }
 
-func (a Asm) Sqr() {
-	a.Function("Sqr", "z", "x")
+// mul generates code to write the product of x and y to z. If y is nil, x is
+// squared.
+func (a Asm) mul(zp func() mp.Int, x, y mp.Int) {
 	k := a.field.Limbs()
 
-	// Load parameters.
-	z := mp.Param(a.ctx, "z", k)
-	x := mp.Param(a.ctx, "x", k)
+	// Use direct field multiplication if available. The output parameter is
+	// loaded afterwards, leaving one more register for the computation.
+	// Squaring is excluded, since it benefits more from dedicated squaring.
+	if m, ok := a.field.(fp.Multiplier); ok && y != nil {
+		r := m.Mul(x, y)
+		mp.Copy(a.ctx, zp(), r)
+		return
+	}
 
-	// Perform square.
+	// Otherwise compute the double-width product.
 	// TODO(mbm): is it possible to store the intermediate result in registers?
+	z := zp()
 	m := mp.AllocLocal(a.ctx, 2*k)
-	mp.Sqr(a.ctx, m, x)
+	if y == nil {
+		mp.Sqr(a.ctx, a.isa, m, x)
+	} else {
+		mp.Mul(a.ctx, a.isa, m, x, y)
+	}
 
 	// Reduce.
 	a.ctx.Comment("Reduction.")
 	a.field.ReduceDouble(z, m)
-
-	a.ctx.RET()
 }
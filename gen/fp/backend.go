@@ -0,0 +1,47 @@
+package fp
+
+import (
+	"math/big"
+
+	"github.com/mmcloughlin/ec3/asm/fp"
+	"github.com/mmcloughlin/ec3/asm/fp/crandall"
+	"github.com/mmcloughlin/ec3/asm/fp/mont"
+	"github.com/mmcloughlin/ec3/prime"
+)
+
+// SelectField returns the field backend best suited to the prime p, based on
+// its classification. Crandall primes with small enough c use the dedicated
+// Crandall reduction. All other primes use Montgomery fields, with Interleaved
+// multiplication when the field is small enough to keep the accumulator in
+// registers. Montgomery-friendly primes (see prime.IsMontgomeryFriendly) need
+// no special selection, since the Montgomery backend detects them itself.
+func SelectField(p *big.Int) fp.Field {
+	q := prime.Classify(p)
+	if c, ok := q.(prime.Crandall); ok && crandall.Supported(c) {
+		return crandall.New(c)
+	}
+	return mont.NewWithMultiplication(q, SelectMultiplication(p))
+}
+
+// ParseField returns a field backend for the prime p with the named
+// multiplication method. The method "auto" selects the backend with
+// SelectField, otherwise a Montgomery field is returned.
+func ParseField(p prime.Prime, mul string) (fp.Field, error) {
+	if mul == "auto" {
+		return SelectField(p.Int()), nil
+	}
+	m, err := mont.ParseMultiplication(mul)
+	if err != nil {
+		return nil, err
+	}
+	return mont.NewWithMultiplication(p, m), nil
+}
+
+// SelectMultiplication returns the Montgomery multiplication method best suited
+// to the prime p.
+func SelectMultiplication(p *big.Int) mont.Multiplication {
+	if f := mont.New(prime.Classify(p)); f.Limbs() <= mont.MaxInterleavedLimbs {
+		return mont.Interleaved
+	}
+	return mont.Separated
+}
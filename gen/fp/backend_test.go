@@ -0,0 +1,61 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mmcloughlin/ec3/asm/fp"
+	"github.com/mmcloughlin/ec3/asm/fp/crandall"
+	"github.com/mmcloughlin/ec3/asm/fp/mont"
+	"github.com/mmcloughlin/ec3/prime"
+)
+
+func TestSelectField(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Prime  prime.Prime
+		Expect fp.Field
+	}{
+		{"p256", prime.NISTP256, mont.NewWithMultiplication(prime.NISTP256, mont.Interleaved)},
+		{"p384", prime.NISTP384, mont.NewWithMultiplication(prime.NISTP384, mont.Interleaved)},
+		{"p25519", prime.P25519, crandall.New(prime.P25519)},
+		{"secp256k1", prime.Secp256k1, mont.NewWithMultiplication(prime.NewOther(prime.Secp256k1.Int()), mont.Interleaved)},
+		{"p2213", prime.P2213, mont.NewWithMultiplication(prime.P2213, mont.Interleaved)},
+		{"p383187", prime.P383187, crandall.New(prime.P383187)},
+		{"p41417", prime.P41417, mont.NewWithMultiplication(prime.P41417, mont.Separated)},
+	}
+	for _, c := range cases {
+		c := c // scopelint
+		t.Run(c.Name, func(t *testing.T) {
+			got := SelectField(c.Prime.Int())
+			if !reflect.DeepEqual(got, c.Expect) {
+				t.Fatalf("SelectField(%s) = %#v; expect %#v", c.Prime, got, c.Expect)
+			}
+		})
+	}
+}
+
+func TestParseField(t *testing.T) {
+	p := prime.P25519
+	cases := []struct {
+		Multiplication string
+		Expect         fp.Field
+	}{
+		{"auto", crandall.New(p)},
+		{"separated", mont.NewWithMultiplication(p, mont.Separated)},
+		{"interleaved", mont.NewWithMultiplication(p, mont.Interleaved)},
+	}
+	for _, c := range cases {
+		got, err := ParseField(p, c.Multiplication)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, c.Expect) {
+			t.Errorf("ParseField(%s, %q) = %#v; expect %#v", p, c.Multiplication, got, c.Expect)
+		}
+	}
+
+	if _, err := ParseField(p, "unknown"); err == nil {
+		t.Fatal("expected error for unknown multiplication")
+	}
+}
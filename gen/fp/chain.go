@@ -0,0 +1,145 @@
+package fp
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/mmcloughlin/addchain/acc"
+	"github.com/mmcloughlin/addchain/acc/ir"
+	"github.com/mmcloughlin/addchain/acc/pass"
+	"github.com/mmcloughlin/addchain/alg"
+	"github.com/mmcloughlin/addchain/alg/contfrac"
+	"github.com/mmcloughlin/addchain/alg/dict"
+	"github.com/mmcloughlin/addchain/alg/exec"
+	"github.com/mmcloughlin/addchain/alg/opt"
+	"golang.org/x/xerrors"
+)
+
+// DefaultAlgorithm is a single addition chain algorithm that finds reasonable
+// chains for cryptographic exponents in around a second.
+func DefaultAlgorithm() alg.ChainAlgorithm {
+	return opt.Algorithm{
+		Algorithm: dict.NewAlgorithm(
+			dict.Hybrid{K: 5, T: 64},
+			contfrac.NewAlgorithm(contfrac.DichotomicStrategy{}),
+		),
+	}
+}
+
+// ChainSearch configures how addition chains are found when they are not
+// provided explicitly.
+type ChainSearch struct {
+	// Algorithms to run, keeping the shortest result. If empty,
+	// DefaultAlgorithm is used. The full ensemble of the addchain library finds
+	// better chains but takes minutes.
+	Algorithms []alg.ChainAlgorithm
+
+	// CacheDir is an optional directory where found chains are saved in acc
+	// format, keyed by the exponent and the algorithms used.
+	CacheDir string
+}
+
+// Chain returns an addition chain computing the exponent e, from the cache if
+// possible.
+func (s ChainSearch) Chain(e *big.Int) (*ir.Program, error) {
+	as := s.Algorithms
+	if len(as) == 0 {
+		as = []alg.ChainAlgorithm{DefaultAlgorithm()}
+	}
+
+	if s.CacheDir == "" {
+		return Chain(e, as)
+	}
+
+	// Check for a cached result.
+	filename := filepath.Join(s.CacheDir, cachekey(e, as)+".acc")
+	p, err := acc.LoadFile(filename)
+	if err == nil {
+		if err := verify(p, e); err != nil {
+			return nil, xerrors.Errorf("cached chain %s: %w", filename, err)
+		}
+		return p, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	// Search and save.
+	p, err = Chain(e, as)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(s.CacheDir, 0o755); err != nil {
+		return nil, err
+	}
+	if err := acc.Save(filename, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// cachekey identifies the result of a chain search for e with the given
+// algorithms.
+func cachekey(e *big.Int, as []alg.ChainAlgorithm) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%x\n", e)
+	for _, a := range as {
+		fmt.Fprintf(h, "%s\n", a)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)[:16])
+}
+
+// verify checks that p computes e.
+func verify(p *ir.Program, e *big.Int) error {
+	t, err := target(p)
+	if err != nil {
+		return err
+	}
+	if t.Cmp(e) != 0 {
+		return xerrors.Errorf("chain computes %#x: expected %#x", t, e)
+	}
+	return nil
+}
+
+// target returns the exponent computed by p.
+func target(p *ir.Program) (*big.Int, error) {
+	c := p.Clone()
+	if err := pass.Eval(c); err != nil {
+		return nil, err
+	}
+	return c.Chain.End(), nil
+}
+
+// Chain searches for an addition chain computing n with the given algorithms,
+// and returns the shortest.
+func Chain(n *big.Int, as []alg.ChainAlgorithm) (*ir.Program, error) {
+	if len(as) == 0 {
+		return nil, xerrors.New("no addition chain algorithms")
+	}
+
+	rs := exec.NewParallel().Execute(n, as)
+
+	var best *exec.Result
+	for i := range rs {
+		r := &rs[i]
+		if r.Err != nil {
+			return nil, xerrors.Errorf("algorithm %s: %w", r.Algorithm, r.Err)
+		}
+		if best == nil || len(r.Program) < len(best.Program) {
+			best = r
+		}
+	}
+
+	return acc.Decompile(best.Program)
+}
+
+// InverseExponent returns the exponent p-2 for inversion modulo the prime p by
+// Fermat's little theorem.
+func InverseExponent(p *big.Int) *big.Int {
+	return new(big.Int).Sub(p, big.NewInt(2))
+}
@@ -3,9 +3,13 @@ package fp
 import (
 	"go/token"
 	"go/types"
+	"math/big"
+	"strings"
 
 	"github.com/mmcloughlin/addchain/acc/ir"
+	"golang.org/x/xerrors"
 
+	"github.com/mmcloughlin/ec3/asm"
 	"github.com/mmcloughlin/ec3/asm/fp"
 	"github.com/mmcloughlin/ec3/asm/fp/mont"
 	"github.com/mmcloughlin/ec3/gen"
@@ -13,9 +17,30 @@ import (
 )
 
 type Config struct {
-	Field        fp.Field
+	Field fp.Field
+
+	// Inversion selects the inversion algorithm.
+	Inversion Inversion
+
+	// InverseChain is an optional addition chain for the inversion exponent
+	// p-2. If nil, a chain is found with ChainSearch. It is required for all
+	// inversion algorithms, since other algorithms are tested against it.
 	InverseChain *ir.Program
 
+	// Sqrt enables generation of square root functions.
+	Sqrt bool
+
+	// SqrtChain is an optional addition chain for the exponent required by the
+	// square root algorithm. See SqrtExponent. If nil and Sqrt is set, a chain
+	// is found with ChainSearch. Providing a chain implies Sqrt.
+	SqrtChain *ir.Program
+
+	// Exponentiations lists additional fixed-exponent functions to generate.
+	Exponentiations []Exponentiation
+
+	// ChainSearch configures how addition chains are found when not provided.
+	ChainSearch ChainSearch
+
 	PackageName     string
 	ElementTypeName string
 	FilenamePrefix  string
@@ -23,6 +48,20 @@ type Config struct {
 	name.Scheme
 }
 
+// Exponentiation specifies a generated function computing a fixed power of a
+// field element, with signature func(z, x *Elt).
+type Exponentiation struct {
+	// Name of the function. It is subject to the configured naming scheme.
+	Name string
+
+	// Exponent to compute. May be nil if Chain is provided.
+	Exponent *big.Int
+
+	// Chain is an optional addition chain computing Exponent. If nil, a chain
+	// is found with ChainSearch.
+	Chain *ir.Program
+}
+
 // Montgomery reports whether this is a montgomery field. Fields implemented
 // this way require encoding and decoding before field operations.
 func (c Config) Montgomery() bool {
@@ -30,6 +69,55 @@ func (c Config) Montgomery() bool {
 	return ok
 }
 
+// WithChains returns a copy of the configuration with all required addition
+// chains populated, finding those not provided with ChainSearch.
+func (c Config) WithChains() (Config, error) {
+	var err error
+	p := c.Field.Prime()
+
+	if c.InverseChain == nil {
+		c.InverseChain, err = c.ChainSearch.Chain(InverseExponent(p))
+		if err != nil {
+			return c, xerrors.Errorf("inversion chain: %w", err)
+		}
+	}
+
+	if c.Sqrt && c.SqrtChain == nil {
+		c.SqrtChain, err = c.ChainSearch.Chain(SqrtExponent(p))
+		if err != nil {
+			return c, xerrors.Errorf("square root chain: %w", err)
+		}
+	}
+
+	// Copy exponentiations so the caller's slice is not modified.
+	exps := make([]Exponentiation, len(c.Exponentiations))
+	copy(exps, c.Exponentiations)
+	for i := range exps {
+		e := &exps[i]
+		switch {
+		case e.Name == "":
+			return c, xerrors.Errorf("exponentiation %d: missing name", i)
+		case e.Chain != nil:
+			continue
+		case e.Exponent == nil:
+			return c, xerrors.Errorf("exponentiation %q: no exponent or chain", e.Name)
+		}
+		e.Chain, err = c.ChainSearch.Chain(e.Exponent)
+		if err != nil {
+			return c, xerrors.Errorf("exponentiation %q chain: %w", e.Name, err)
+		}
+	}
+	c.Exponentiations = exps
+
+	return c, nil
+}
+
+// ADXFlag returns the name of the variable reporting whether the ADX
+// implementations of assembly functions may be used.
+func (c Config) ADXFlag() string {
+	return c.Name("hasADX")
+}
+
 func (c Config) Type() *types.Named {
 	array := types.NewArray(types.Typ[types.Byte], int64(c.Field.ElementSize()))
 	name := types.NewTypeName(token.NoPos, nil, c.ElementTypeName, nil)
@@ -58,6 +146,11 @@ func (c Config) Signature(params ...string) *types.Signature {
 }
 
 func Package(cfg Config) (gen.Files, error) {
+	cfg, err := cfg.WithChains()
+	if err != nil {
+		return nil, err
+	}
+
 	fs := gen.Files{}
 
 	// Exported functions.
@@ -68,17 +161,48 @@ func Package(cfg Config) (gen.Files, error) {
 
 	fs.Add(cfg.FilenamePrefix+".go", b)
 
-	// Assembly backend.
-	a := NewAsm(cfg)
+	// Test the inverse against the addition chain method.
+	if cfg.Inversion != InversionChain {
+		b, err := InverseTest(cfg)
+		if err != nil {
+			return nil, err
+		}
+		fs.Add(cfg.FilenamePrefix+"_inv_test.go", b)
+	}
+
+	// Test squaring against multiplication.
+	b, err = SquareTest(cfg)
+	if err != nil {
+		return nil, err
+	}
+	fs.Add(cfg.FilenamePrefix+"_sqr_test.go", b)
+
+	// Assembly backend. Functions that multiply are generated for each
+	// instruction set, in separate files.
+	a := NewAsm(cfg, asm.Baseline)
 	a.CMov()
+	a.Select()
+	a.Equal()
 	a.Add()
 	a.Sub()
-	a.Mul()
-	a.Sqr()
 
 	if err := fs.CompileAsm(cfg.PackageName, cfg.FilenamePrefix+"_amd64", a.Context()); err != nil {
 		return nil, err
 	}
 
+	for _, isa := range asm.ISAs {
+		a := NewAsm(cfg, isa)
+		a.Mul()
+		a.Sqr()
+		a.AddVec()
+		a.MulVec()
+		a.SqrVec()
+
+		prefix := cfg.FilenamePrefix + "_" + strings.ToLower(isa.String()) + "_amd64"
+		if err := fs.CompileAsm(cfg.PackageName, prefix, a.Context()); err != nil {
+			return nil, err
+		}
+	}
+
 	return fs, nil
 }
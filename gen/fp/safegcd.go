@@ -0,0 +1,309 @@
+package fp
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/mmcloughlin/ec3/gen"
+	"github.com/mmcloughlin/ec3/internal/gocode"
+)
+
+// References:
+//
+//	[safegcd]  Daniel J. Bernstein and Bo-Yin Yang. Fast constant-time gcd computation and modular
+//	           inversion. Cryptology ePrint Archive, Report 2019/266. 2019.
+//	           https://eprint.iacr.org/2019/266
+
+// Inversion selects the algorithm used for field inversion.
+type Inversion int
+
+// Supported inversion algorithms.
+const (
+	// InversionChain computes inverses by exponentiation to p-2 with an
+	// addition chain, by Fermat's little theorem.
+	InversionChain Inversion = iota
+
+	// InversionSafeGCD computes inverses with the constant-time divstep
+	// algorithm of [safegcd], running a fixed number of iterations sufficient
+	// for all inputs. It does not need a good addition chain, but since
+	// divsteps are currently performed one at a time it is several times
+	// slower than addition chains for common primes.
+	InversionSafeGCD
+)
+
+// inversions maps names to inversion algorithms.
+var inversions = map[string]Inversion{
+	"chain":   InversionChain,
+	"safegcd": InversionSafeGCD,
+}
+
+// ParseInversion looks up an inversion algorithm by name.
+func ParseInversion(s string) (Inversion, error) {
+	i, ok := inversions[s]
+	if !ok {
+		return 0, xerrors.Errorf("unknown inversion %q", s)
+	}
+	return i, nil
+}
+
+func (i Inversion) String() string {
+	for s, n := range inversions {
+		if n == i {
+			return s
+		}
+	}
+	return fmt.Sprintf("Inversion(%d)", int(i))
+}
+
+// Divsteps returns the number of divsteps sufficient to compute the gcd of
+// integers f and g of at most d bits, according to [safegcd] Theorem 11.2.
+func Divsteps(d int) int {
+	if d < 46 {
+		return (49*d + 80) / 17
+	}
+	return (49*d + 57) / 17
+}
+
+// InverseSafeGCD generates an inversion function using the divstep algorithm.
+//
+// Starting from f = p and g = x, divsteps preserve gcd(f, g) and reach g = 0
+// and f = ±1. Alongside, the algorithm maintains d and e modulo p such that
+// f*c = d*x and g*c = e*x, for a constant c. At the end d = ±c/x. The constant
+// is chosen so that the result is correctly encoded: c = R² in the Montgomery
+// domain, or 1 otherwise.
+//
+// The implementation performs one divstep at a time on multi-precision
+// integers, with all branches replaced by masking.
+//
+// TODO(mbm): batch divsteps with transition matrices, as in [safegcd] Section 12
+func (a *api) InverseSafeGCD() {
+	k := a.Field.Limbs()
+	p := a.Field.Prime()
+
+	c := big.NewInt(1)
+	if a.Montgomery() {
+		c.Lsh(c, uint(2*a.Field.ElementBits()))
+		c.Mod(c, p)
+	}
+
+	// Constants.
+	a.Commentf("%s is the field prime as 64-bit limbs, for use by %s.", a.Name("invp"), a.Name("Inv"))
+	a.limbs("invp", p, k)
+
+	a.Commentf("%s is the scaling constant for %s, such that the result is encoded.", a.Name("invc"), a.Name("Inv"))
+	a.limbs("invc", c, k)
+
+	// Function header.
+	a.Commentf("%s computes z = 1/x (mod p).", a.Name("Inv"))
+	a.Comment(
+		"",
+		"Inversion uses the constant-time divstep algorithm of Bernstein and Yang,",
+		fmt.Sprintf("with a fixed number of iterations sufficient for all %d-bit inputs.", 64*k),
+	)
+	a.Function(a.Name("Inv"), a.Signature("z", "x"))
+
+	a.Comment("Signed integers f and g in two's complement, with an extra limb.")
+	a.Linef("var f, g [%d]uint64", k+1)
+	a.Linef("copy(f[:], %s[:])", a.Name("invp"))
+	a.Linef("for i := 0; i < %d; i++ {", k)
+	a.Linef("g[i] = binary.LittleEndian.Uint64(x[8*i:])")
+	a.Linef("}")
+	a.NL()
+
+	a.Comment("Maintain f*c = d*x and g*c = e*x (mod p).")
+	a.Linef("var d [%d]uint64", k)
+	a.Linef("e := %s", a.Name("invc"))
+	a.Linef("delta := int64(1)")
+	a.NL()
+
+	a.Linef("var t [%d]uint64", k+1)
+	a.Linef("var u [%d]uint64", k)
+	a.Linef("for n := 0; n < %d; n++ {", Divsteps(64*k))
+	a.Comment("Swap when delta > 0 and g is odd.")
+	a.Linef("odd := -(g[0] & 1)")
+	a.Linef("swap := odd & uint64((-delta)>>63)")
+	a.Linef("delta = 1 + (delta ^ int64(swap)) - int64(swap)")
+	a.NL()
+	a.Comment("g = (g + (odd ? (swap ? -f : f) : 0))/2, and f = g if swapped.")
+	a.Call("invcneg", "t[:]", "f[:]", "swap")
+	a.Call("invselect", "f[:]", "f[:]", "g[:]", "swap")
+	a.Call("invmask", "t[:]", "odd")
+	a.Call("invadd", "g[:]", "g[:]", "t[:]")
+	a.Call("invshr", "g[:]")
+	a.NL()
+	a.Comment("Likewise e = (e + (odd ? (swap ? -d : d) : 0))/2 (mod p), and d = e if swapped.")
+	a.Call("invsub", "u[:]", a.Name("invp")+"[:]", "d[:]")
+	a.Call("invselect", "u[:]", "d[:]", "u[:]", "swap")
+	a.Call("invselect", "d[:]", "d[:]", "e[:]", "swap")
+	a.Call("invmask", "u[:]", "odd")
+	a.Call("invaddmod", "e[:]", "e[:]", "u[:]")
+	a.Call("invhalvemod", "e[:]")
+	a.Linef("}")
+	a.NL()
+
+	a.Comment("Now f = ±1, so the inverse is ±d.")
+	a.Linef("neg := -(f[%d] >> 63)", k)
+	a.Call("invsub", "u[:]", a.Name("invp")+"[:]", "d[:]")
+	a.Call("invselect", "d[:]", "d[:]", "u[:]", "neg")
+	a.Linef("for i := 0; i < %d; i++ {", k)
+	a.Linef("binary.LittleEndian.PutUint64(z[8*i:], d[i])")
+	a.Linef("}")
+	a.LeaveBlock()
+
+	a.inversehelpers()
+}
+
+// limbs defines a variable holding x as k little-endian 64-bit limbs.
+func (a *api) limbs(name string, x *big.Int, k int) {
+	mask := new(big.Int).SetUint64(^uint64(0))
+	ws := make([]string, k)
+	for i := range ws {
+		w := new(big.Int).Rsh(x, uint(64*i))
+		ws[i] = fmt.Sprintf("%#x", w.And(w, mask))
+	}
+	a.Linef("var %s = [%d]uint64{%s}", a.Name(name), k, strings.Join(ws, ", "))
+}
+
+// inversehelpers generates the constant-time multi-precision helpers used by
+// the divstep inversion. All operate on little-endian 64-bit limbs.
+func (a *api) inversehelpers() {
+	k := a.Field.Limbs()
+	invp := a.Name("invp")
+
+	a.Commentf("%s sets z = y if c is all ones, and z = x if c is zero.", a.Name("invselect"))
+	a.Printf("func %s(z, x, y []uint64, c uint64)", a.Name("invselect"))
+	a.EnterBlock()
+	a.Linef("for i := range z {")
+	a.Linef("z[i] = x[i] ^ (c & (x[i] ^ y[i]))")
+	a.Linef("}")
+	a.LeaveBlock()
+
+	a.Commentf("%s sets z = -x if c is all ones, and z = x if c is zero.", a.Name("invcneg"))
+	a.Printf("func %s(z, x []uint64, c uint64)", a.Name("invcneg"))
+	a.EnterBlock()
+	a.Linef("carry := c & 1")
+	a.Linef("for i := range z {")
+	a.Linef("z[i], carry = bits.Add64(x[i]^c, 0, carry)")
+	a.Linef("}")
+	a.LeaveBlock()
+
+	a.Commentf("%s sets z = z & c for each limb.", a.Name("invmask"))
+	a.Printf("func %s(z []uint64, c uint64)", a.Name("invmask"))
+	a.EnterBlock()
+	a.Linef("for i := range z {")
+	a.Linef("z[i] &= c")
+	a.Linef("}")
+	a.LeaveBlock()
+
+	a.Commentf("%s sets z = x + y and returns the carry.", a.Name("invadd"))
+	a.Printf("func %s(z, x, y []uint64) uint64", a.Name("invadd"))
+	a.EnterBlock()
+	a.Linef("var carry uint64")
+	a.Linef("for i := range z {")
+	a.Linef("z[i], carry = bits.Add64(x[i], y[i], carry)")
+	a.Linef("}")
+	a.Linef("return carry")
+	a.LeaveBlock()
+
+	a.Commentf("%s sets z = x - y and returns the borrow.", a.Name("invsub"))
+	a.Printf("func %s(z, x, y []uint64) uint64", a.Name("invsub"))
+	a.EnterBlock()
+	a.Linef("var borrow uint64")
+	a.Linef("for i := range z {")
+	a.Linef("z[i], borrow = bits.Sub64(x[i], y[i], borrow)")
+	a.Linef("}")
+	a.Linef("return borrow")
+	a.LeaveBlock()
+
+	a.Commentf("%s shifts the signed integer z right by one bit.", a.Name("invshr"))
+	a.Printf("func %s(z []uint64)", a.Name("invshr"))
+	a.EnterBlock()
+	a.Linef("n := len(z) - 1")
+	a.Linef("for i := 0; i < n; i++ {")
+	a.Linef("z[i] = z[i]>>1 | z[i+1]<<63")
+	a.Linef("}")
+	a.Linef("z[n] = uint64(int64(z[n]) >> 1)")
+	a.LeaveBlock()
+
+	a.Commentf("%s sets z = x + y (mod p), for x < p and y ⩽ p.", a.Name("invaddmod"))
+	a.Printf("func %s(z, x, y []uint64)", a.Name("invaddmod"))
+	a.EnterBlock()
+	a.Linef("var t, s [%d]uint64", k)
+	a.Linef("carry := %s(t[:], x, y)", a.Name("invadd"))
+	a.Linef("borrow := %s(s[:], t[:], %s[:])", a.Name("invsub"), invp)
+	a.Call("invselect", "z", "s[:]", "t[:]", "-(borrow &^ carry)")
+	a.LeaveBlock()
+
+	a.Commentf("%s sets z = z/2 (mod p), for z < p.", a.Name("invhalvemod"))
+	a.Printf("func %s(z []uint64)", a.Name("invhalvemod"))
+	a.EnterBlock()
+	a.Linef("var t [%d]uint64", k)
+	a.Linef("copy(t[:], %s[:])", invp)
+	a.Call("invmask", "t[:]", "-(z[0] & 1)")
+	a.Linef("carry := %s(z, z, t[:])", a.Name("invadd"))
+	a.Linef("for i := 0; i < %d; i++ {", k-1)
+	a.Linef("z[i] = z[i]>>1 | z[i+1]<<63")
+	a.Linef("}")
+	a.Linef("z[%d] = z[%d]>>1 | carry<<63", k-1, k-1)
+	a.LeaveBlock()
+}
+
+// InverseTest generates a test file checking the configured inversion against
+// the addition chain inverse, which is generated in the test file as a
+// reference.
+func InverseTest(cfg Config) ([]byte, error) {
+	cfg, err := cfg.WithChains()
+	if err != nil {
+		return nil, err
+	}
+
+	a := &api{
+		Config:    cfg,
+		Generator: gocode.NewGenerator(),
+	}
+
+	a.CodeGenerationWarning(gen.GeneratedBy)
+	a.Package(a.PackageName)
+	a.Import("math/big", "math/rand", "testing")
+
+	// Reference implementation.
+	a.NL()
+	if err := verify(a.InverseChain, InverseExponent(a.Field.Prime())); err != nil {
+		return nil, xerrors.Errorf("inversion: %w", err)
+	}
+	a.exponentiation("invchain", a.InverseChain,
+		fmt.Sprintf("%s computes z = 1/x (mod p) by exponentiation, as a reference for %s.", a.Name("invchain"), a.Name("Inv")),
+	)
+
+	// Test.
+	pm1 := fmt.Sprintf("new(big.Int).Sub(%s, big.NewInt(1))", a.Name("p"))
+	a.Printf("func Test%sInvChain(t *testing.T)", strings.Title(a.FilenamePrefix))
+	a.EnterBlock()
+	a.Linef("r := rand.New(rand.NewSource(1))")
+	a.Linef("xs := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), %s}", pm1)
+	a.Linef("for trial := 0; trial < 1024; trial++ {")
+	a.Linef("xs = append(xs, new(big.Int).Rand(r, %s))", a.Name("p"))
+	a.Linef("}")
+	a.NL()
+	a.Linef("for _, xi := range xs {")
+	a.Linef("var x, got, expect %s", a.Type())
+	a.Linef("x.SetInt(xi)")
+	a.Call("Inv", "&got", "&x")
+	a.Call("invchain", "&expect", "&x")
+	a.Linef("if got != expect {")
+	a.Linef("t.Fatalf(\"x = %%s: got %%x expect %%x\", xi, got, expect)")
+	a.Linef("}")
+	a.NL()
+	a.Comment("Inverse in place.")
+	a.Call("Inv", "&x", "&x")
+	a.Linef("if x != expect {")
+	a.Linef("t.Fatalf(\"x = %%s: in place: got %%x expect %%x\", xi, x, expect)")
+	a.Linef("}")
+	a.Linef("}")
+	a.LeaveBlock()
+
+	return a.Formatted()
+}
@@ -0,0 +1,90 @@
+package fp
+
+import (
+	"strings"
+
+	"github.com/mmcloughlin/ec3/gen"
+	"github.com/mmcloughlin/ec3/internal/gocode"
+)
+
+// SquareTest generates a test file checking the dedicated squaring function
+// against multiplication, with benchmarks comparing the two.
+func SquareTest(cfg Config) ([]byte, error) {
+	a := &api{
+		Config:    cfg,
+		Generator: gocode.NewGenerator(),
+	}
+
+	a.CodeGenerationWarning(gen.GeneratedBy)
+	a.Package(a.PackageName)
+	a.Import("math/big", "math/rand", "testing")
+
+	title := strings.Title(a.FilenamePrefix)
+	p := a.Name("p")
+
+	// Test.
+	a.NL()
+	a.Printf("func Test%sSqrMul(t *testing.T)", title)
+	a.EnterBlock()
+	a.Comment("Include values with long runs of ones, which exercise the carry chains when", "doubling cross products.")
+	a.Linef("r := rand.New(rand.NewSource(1))")
+	a.Linef("xs := []*big.Int{big.NewInt(0), big.NewInt(1), new(big.Int).Sub(%s, big.NewInt(1)), new(big.Int).Rsh(%s, 1)}", p, p)
+	a.Linef("for n := uint(64); n < uint(%s.BitLen()); n += 64 {", p)
+	a.Linef("ones := new(big.Int).Lsh(big.NewInt(1), n)")
+	a.Linef("xs = append(xs, ones.Sub(ones, big.NewInt(1)))")
+	a.Linef("}")
+	a.Linef("for trial := 0; trial < 1024; trial++ {")
+	a.Linef("xs = append(xs, new(big.Int).Rand(r, %s))", p)
+	a.Linef("}")
+	a.NL()
+	a.Linef("for _, xi := range xs {")
+	a.Linef("var x, got, expect %s", a.Type())
+	a.Linef("x.SetInt(xi)")
+	a.Call("Sqr", "&got", "&x")
+	a.Call("Mul", "&expect", "&x", "&x")
+	a.Linef("if got != expect {")
+	a.Linef("t.Fatalf(\"x = %%s: got %%x expect %%x\", xi, got, expect)")
+	a.Linef("}")
+	a.Linef("}")
+	a.LeaveBlock()
+
+	// Benchmarks.
+	a.NL()
+	a.benchmark(title+"Mul", "Mul", "z", "x", "y")
+	a.NL()
+	a.benchmark(title+"Sqr", "Sqr", "z", "x")
+	a.NL()
+	a.Commentf("Benchmark%sSqrMul squares with the multiply, for comparison with the", title)
+	a.Comment("dedicated squaring.")
+	a.benchmark(title+"SqrMul", "Mul", "z", "x", "x")
+
+	return a.Formatted()
+}
+
+// benchmark generates a benchmark of the named function with output z and
+// random inputs. The benchmark doc comment, if any, must precede the call.
+func (a *api) benchmark(bench, name, z string, inputs ...string) {
+	seen := map[string]bool{}
+	vars := []string{}
+	args := []interface{}{"&" + z}
+	for _, in := range inputs {
+		if !seen[in] {
+			vars = append(vars, in)
+			seen[in] = true
+		}
+		args = append(args, "&"+in)
+	}
+
+	a.Printf("func Benchmark%s(b *testing.B)", bench)
+	a.EnterBlock()
+	a.Linef("r := rand.New(rand.NewSource(1))")
+	a.Linef("var %s, %s %s", strings.Join(vars, ", "), z, a.Type())
+	for _, v := range vars {
+		a.Linef("%s.SetInt(new(big.Int).Rand(r, %s))", v, a.Name("p"))
+	}
+	a.Linef("b.ResetTimer()")
+	a.Linef("for i := 0; i < b.N; i++ {")
+	a.Call(name, args...)
+	a.Linef("}")
+	a.LeaveBlock()
+}
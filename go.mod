@@ -7,6 +7,7 @@ require (
 	github.com/google/subcommands v1.2.0
 	github.com/mmcloughlin/addchain v0.0.0-20200606044109-0f719b9ccad8
 	github.com/mmcloughlin/avo v0.0.0-20200523190732-4439b6b2c061
+	golang.org/x/sys v0.0.0-20190602015325-4c4f7f33c9ed
 	golang.org/x/tools v0.0.0-20200606014950-c42cb6316fb6
 	golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543
 	gopkg.in/yaml.v2 v2.3.0
@@ -0,0 +1,501 @@
+// Package ecdsavectors generates ECDSA test vectors in the Wycheproof JSON
+// format.
+//
+// Verification groups follow the Wycheproof "EcdsaVerify" schema, with DER
+// encoded signatures over messages hashed with the group hash function. Each
+// group contains valid signatures and a selection of invalid ones derived by
+// mutating the signature values and their encoding. Further groups exercise
+// edge cases in the verification scalars u1 and u2. In addition, an "EcdsaSign"
+// group records deterministic RFC 6979 signatures for a fixed private key.
+//
+// Output is reproducible for a given random source. Signatures are computed
+// with an independent math/big implementation and cross-checked against
+// crypto/ecdsa. Note these are not vectors from the Wycheproof project itself.
+package ecdsavectors
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"math/big"
+	"math/rand"
+
+	"github.com/mmcloughlin/ec3/internal/errutil"
+	"github.com/mmcloughlin/ec3/internal/weierstrass"
+)
+
+// File is a Wycheproof test vector file.
+type File struct {
+	Algorithm     string   `json:"algorithm"`
+	Header        []string `json:"header"`
+	NumberOfTests int      `json:"numberOfTests"`
+	TestGroups    []*Group `json:"testGroups"`
+}
+
+// Group is a group of tests sharing a key and hash function.
+type Group struct {
+	Type  string  `json:"type"`
+	Key   Key     `json:"key"`
+	SHA   string  `json:"sha"`
+	Tests []*Test `json:"tests"`
+}
+
+// Key is an ECDSA key. The private part is only present in signing groups.
+type Key struct {
+	Curve        string `json:"curve"`
+	KeySize      int    `json:"keySize"`
+	Type         string `json:"type"`
+	Uncompressed string `json:"uncompressed"`
+	Wx           string `json:"wx"`
+	Wy           string `json:"wy"`
+	D            string `json:"d,omitempty"`
+}
+
+// Test is a single test case.
+type Test struct {
+	TcID    int      `json:"tcId"`
+	Comment string   `json:"comment"`
+	Msg     string   `json:"msg"`
+	Sig     string   `json:"sig"`
+	Result  string   `json:"result"`
+	Flags   []string `json:"flags"`
+}
+
+// Generator builds test vectors for a curve and hash function. Curves with
+// a other than -3 must be given as a weierstrass.Curve.
+type Generator struct {
+	Curve    elliptic.Curve
+	HashName string
+	Hash     crypto.Hash
+	Rand     *rand.Rand
+
+	tcid int
+}
+
+// File generates a test vector file with the given number of verification
+// groups, each with sigs valid signatures and their invalid mutations.
+func (g *Generator) File(keys, sigs int) *File {
+	f := &File{
+		Algorithm: "ECDSA",
+		Header: []string{
+			fmt.Sprintf("Test vectors of type EcdsaVerify and EcdsaSign for %s with %s.", g.Curve.Params().Name, g.HashName),
+			"Generated by ec3 with an independent math/big implementation.",
+		},
+	}
+
+	for i := 0; i < keys; i++ {
+		f.TestGroups = append(f.TestGroups, g.VerifyGroup(g.scalar(), sigs))
+	}
+	f.TestGroups = append(f.TestGroups, g.OnesGroups()...)
+	f.TestGroups = append(f.TestGroups, g.SignGroup(g.scalar(), sigs))
+
+	for _, grp := range f.TestGroups {
+		f.NumberOfTests += len(grp.Tests)
+	}
+
+	return f
+}
+
+// VerifyGroup generates verification tests for private key d.
+func (g *Generator) VerifyGroup(d *big.Int, sigs int) *Group {
+	grp := &Group{
+		Type: "EcdsaVerify",
+		Key:  g.key(d),
+		SHA:  g.HashName,
+	}
+
+	N := g.Curve.Params().N
+	add := func(comment string, msg []byte, sig []byte, result string, flags ...string) {
+		grp.Tests = append(grp.Tests, g.test(comment, msg, sig, result, flags))
+	}
+	addrs := func(comment string, msg []byte, r, s *big.Int, result string, flags ...string) {
+		add(comment, msg, der(r, s), result, flags...)
+	}
+
+	for i := 0; i < sigs; i++ {
+		msg := g.message()
+		r, s := g.sign(d, g.scalar(), msg)
+
+		addrs("valid signature", msg, r, s, "valid")
+
+		// Variations on valid signatures.
+		addrs("negated s", msg, r, new(big.Int).Sub(N, s), "valid", "SignatureMalleability")
+
+		// Modified values.
+		addrs("modified r", msg, new(big.Int).Add(r, big.NewInt(1)), s, "invalid")
+		addrs("modified s", msg, r, new(big.Int).Add(s, big.NewInt(1)), "invalid")
+		addrs("swapped r and s", msg, s, r, "invalid")
+		addrs("r + n", msg, new(big.Int).Add(r, N), s, "invalid", "ArithmeticError")
+		addrs("s + n", msg, r, new(big.Int).Add(s, N), "invalid", "ArithmeticError")
+		addrs("r = 0", msg, new(big.Int), s, "invalid", "RangeCheck")
+		addrs("s = 0", msg, r, new(big.Int), "invalid", "RangeCheck")
+		addrs("r = n", msg, new(big.Int).Set(N), s, "invalid", "RangeCheck")
+		addrs("s = n", msg, r, new(big.Int).Set(N), "invalid", "RangeCheck")
+		addrs("r = s = 1", msg, big.NewInt(1), big.NewInt(1), "invalid")
+
+		modified := append([]byte{}, msg...)
+		modified[0] ^= 1
+		addrs("modified message", modified, r, s, "invalid")
+
+		// Encoding errors.
+		sig := der(r, s)
+		add("trailing garbage", msg, append(append([]byte{}, sig...), 0), "invalid", "BerEncodedSignature")
+		add("truncated signature", msg, sig[:len(sig)-1], "invalid", "BerEncodedSignature")
+		add("empty signature", msg, []byte{}, "invalid", "BerEncodedSignature")
+		add("wrong sequence tag", msg, append([]byte{0x31}, sig[1:]...), "invalid", "BerEncodedSignature")
+		add("long form length", msg, longform(sig), "invalid", "BerEncodedSignature")
+		add("padded r", msg, seq(integer(pad(r)), integer(minimal(s))), "invalid", "BerEncodedSignature")
+		add("negative r", msg, seq(integer(neg(r)), integer(minimal(s))), "invalid", "BerEncodedSignature")
+	}
+
+	return grp
+}
+
+// OnesGroups generates verification tests for which the scalars u1 and u2
+// computed during verification have words of all one bits. Such values exercise
+// carry propagation in scalar recoding, and are almost never produced by random
+// signatures. Each test requires its own public key, so is in its own group.
+func (g *Generator) OnesGroups() []*Group {
+	N := g.Curve.Params().N
+	ones := func(n int) *big.Int {
+		x := new(big.Int).Lsh(big.NewInt(1), uint(n))
+		return x.Sub(x, big.NewInt(1))
+	}
+
+	var grps []*Group
+	for n := 64; n+1 < N.BitLen(); n += 64 {
+		for _, u := range [][2]*big.Int{
+			{ones(n), ones(n + 1)},
+			{ones(n + 1), ones(n)},
+		} {
+			grps = append(grps, g.SpecialGroup(u[0], u[1], "u1 and u2 with all-ones words"))
+		}
+	}
+	return grps
+}
+
+// SpecialGroup generates a verification group with a signature for which
+// verification computes the given scalars u1 and u2. The public key is chosen to
+// make the signature valid.
+func (g *Generator) SpecialGroup(u1, u2 *big.Int, comment string) *Group {
+	params := g.Curve.Params()
+	N := params.N
+
+	// Verification computes u1 = e/s and u2 = r/s, and accepts if r is the x
+	// coordinate of R = u1*G + u2*Q. Given e, we have s = e/u1 and r = u2*s, so
+	// it remains to find a point R with this x coordinate and solve for Q.
+	for {
+		msg := g.message()
+		e := bits2int(g.digest(msg), N.BitLen())
+		e.Mod(e, N)
+
+		s := new(big.Int).ModInverse(u1, N)
+		s.Mul(s, e).Mod(s, N)
+		r := new(big.Int).Mul(u2, s)
+		r.Mod(r, N)
+		if r.Sign() == 0 || s.Sign() == 0 {
+			continue
+		}
+
+		rx, ry, ok := lift(g.Curve, r)
+		if !ok {
+			continue
+		}
+
+		// Q = (R - u1*G) / u2.
+		nu1 := new(big.Int).Sub(N, u1)
+		x, y := g.Curve.ScalarBaseMult(nu1.Bytes())
+		x, y = g.Curve.Add(rx, ry, x, y)
+		u2inv := new(big.Int).ModInverse(u2, N)
+		qx, qy := g.Curve.ScalarMult(x, y, u2inv.Bytes())
+		if qx.Sign() == 0 && qy.Sign() == 0 {
+			continue
+		}
+
+		pub := &ecdsa.PublicKey{Curve: g.Curve, X: qx, Y: qy}
+		if !ecdsa.Verify(pub, g.digest(msg), r, s) {
+			panic(errutil.AssertionFailure("special case signature failed verification"))
+		}
+
+		grp := &Group{
+			Type: "EcdsaVerify",
+			Key:  g.point(qx, qy),
+			SHA:  g.HashName,
+		}
+		grp.Tests = append(grp.Tests, g.test(comment, msg, der(r, s), "valid", []string{"ArithmeticError"}))
+		return grp
+	}
+}
+
+// lift returns a point with x coordinate x on the curve, if one exists. Curves
+// other than weierstrass.Curve are assumed to have a = -3, as for the
+// crypto/elliptic curves.
+func lift(c elliptic.Curve, x *big.Int) (*big.Int, *big.Int, bool) {
+	w, ok := c.(*weierstrass.Curve)
+	if !ok {
+		w = weierstrass.New(c.Params(), big.NewInt(-3))
+	}
+	y, ok := w.Lift(x)
+	if !ok {
+		return nil, nil, false
+	}
+	return x, y, true
+}
+
+// SignGroup generates deterministic signing tests for private key d.
+func (g *Generator) SignGroup(d *big.Int, sigs int) *Group {
+	grp := &Group{
+		Type: "EcdsaSign",
+		Key:  g.key(d),
+		SHA:  g.HashName,
+	}
+	grp.Key.D = hex.EncodeToString(d.FillBytes(make([]byte, size(g.Curve.Params().N))))
+
+	for i := 0; i < sigs; i++ {
+		msg := g.message()
+		r, s := g.sign(d, nonce(g.Hash, d, g.digest(msg), g.Curve.Params().N), msg)
+		grp.Tests = append(grp.Tests, g.test("deterministic signature", msg, der(r, s), "valid", nil))
+	}
+
+	return grp
+}
+
+func (g *Generator) test(comment string, msg, sig []byte, result string, flags []string) *Test {
+	g.tcid++
+	if flags == nil {
+		flags = []string{}
+	}
+	return &Test{
+		TcID:    g.tcid,
+		Comment: comment,
+		Msg:     hex.EncodeToString(msg),
+		Sig:     hex.EncodeToString(sig),
+		Result:  result,
+		Flags:   flags,
+	}
+}
+
+// key returns the public key for d.
+func (g *Generator) key(d *big.Int) Key {
+	return g.point(g.Curve.ScalarBaseMult(d.Bytes()))
+}
+
+// point returns the public key (x, y).
+func (g *Generator) point(x, y *big.Int) Key {
+	params := g.Curve.Params()
+	n := size(params.P)
+	return Key{
+		Curve:        params.Name,
+		KeySize:      params.BitSize,
+		Type:         "EcPublicKey",
+		Uncompressed: hex.EncodeToString(elliptic.Marshal(g.Curve, x, y)),
+		Wx:           hex.EncodeToString(x.FillBytes(make([]byte, n))),
+		Wy:           hex.EncodeToString(y.FillBytes(make([]byte, n))),
+	}
+}
+
+// sign computes the signature of msg with private key d and nonce k. The
+// signature is checked with crypto/ecdsa.
+func (g *Generator) sign(d, k *big.Int, msg []byte) (r, s *big.Int) {
+	digest := g.digest(msg)
+	r, s = Sign(g.Curve, d, k, digest)
+
+	x, y := g.Curve.ScalarBaseMult(d.Bytes())
+	pub := &ecdsa.PublicKey{Curve: g.Curve, X: x, Y: y}
+	if !ecdsa.Verify(pub, digest, r, s) {
+		panic(errutil.AssertionFailure("generated signature failed verification"))
+	}
+
+	return r, s
+}
+
+func (g *Generator) digest(msg []byte) []byte {
+	h := g.Hash.New()
+	h.Write(msg)
+	return h.Sum(nil)
+}
+
+// message returns a random message.
+func (g *Generator) message() []byte {
+	msg := make([]byte, 1+g.Rand.Intn(64))
+	g.Rand.Read(msg)
+	return msg
+}
+
+// scalar returns a random scalar in [1, N).
+func (g *Generator) scalar() *big.Int {
+	N := g.Curve.Params().N
+	n1 := new(big.Int).Sub(N, big.NewInt(1))
+	k := new(big.Int).Rand(g.Rand, n1)
+	return k.Add(k, big.NewInt(1))
+}
+
+// Sign computes the ECDSA signature of digest with private key d and nonce k.
+func Sign(c elliptic.Curve, d, k *big.Int, digest []byte) (r, s *big.Int) {
+	N := c.Params().N
+	r, _ = c.ScalarBaseMult(k.Bytes())
+	r.Mod(r, N)
+
+	e := bits2int(digest, N.BitLen())
+	s = new(big.Int).Mul(r, d)
+	s.Add(s, e)
+	s.Mul(s, new(big.Int).ModInverse(k, N))
+	s.Mod(s, N)
+
+	if r.Sign() == 0 || s.Sign() == 0 {
+		panic(errutil.AssertionFailure("degenerate signature"))
+	}
+
+	return r, s
+}
+
+// nonce computes the deterministic nonce of RFC 6979 section 3.2.
+func nonce(h crypto.Hash, x *big.Int, digest []byte, q *big.Int) *big.Int {
+	qlen := q.BitLen()
+	rlen := size(q)
+
+	// Step b-d.
+	V := make([]byte, h.Size())
+	K := make([]byte, h.Size())
+	for i := range V {
+		V[i] = 1
+	}
+
+	// Step d-g.
+	xo := x.FillBytes(make([]byte, rlen))
+	h1 := bits2int(digest, qlen)
+	h1.Mod(h1, q)
+	ho := h1.FillBytes(make([]byte, rlen))
+
+	for _, b := range []byte{0, 1} {
+		K = mac(h.New, K, V, []byte{b}, xo, ho)
+		V = mac(h.New, K, V)
+	}
+
+	// Step h.
+	for {
+		var T []byte
+		for len(T) < rlen {
+			V = mac(h.New, K, V)
+			T = append(T, V...)
+		}
+		k := bits2int(T, qlen)
+		if k.Sign() > 0 && k.Cmp(q) < 0 {
+			return k
+		}
+		K = mac(h.New, K, V, []byte{0})
+		V = mac(h.New, K, V)
+	}
+}
+
+func mac(h func() hash.Hash, key []byte, data ...[]byte) []byte {
+	m := hmac.New(h, key)
+	for _, d := range data {
+		m.Write(d)
+	}
+	return m.Sum(nil)
+}
+
+// bits2int converts b to an integer, keeping the qlen leftmost bits.
+func bits2int(b []byte, qlen int) *big.Int {
+	x := new(big.Int).SetBytes(b)
+	if excess := 8*len(b) - qlen; excess > 0 {
+		x.Rsh(x, uint(excess))
+	}
+	return x
+}
+
+// SelfCheck confirms the RFC 6979 implementation against the P-256 known
+// answer test from RFC 6979 appendix A.2.5.
+func SelfCheck() error {
+	c := elliptic.P256()
+	d, _ := new(big.Int).SetString("c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721", 16)
+	for _, t := range []struct{ Msg, R, S string }{
+		{"sample", "efd48b2aacb6a8fd1140dd9cd45e81d69d2c877b56aaf991c34d0ea84eaf3716", "f7cb1c942d657c41d436c7a1b6e29f65f3e900dbb9aff4064dc4ab2f843acda8"},
+		{"test", "f1abb023518351cd71d881567b1ea663ed3efcf6c5132b354f28d3b0b7d38367", "019f4113742a2b14bd25926b49c649155f267e60d3814b4c0cc84250e46f0083"},
+	} {
+		h := crypto.SHA256.New()
+		h.Write([]byte(t.Msg))
+		digest := h.Sum(nil)
+		r, s := Sign(c, d, nonce(crypto.SHA256, d, digest, c.Params().N), digest)
+		if fmt.Sprintf("%064x", r) != t.R || fmt.Sprintf("%064x", s) != t.S {
+			return fmt.Errorf("rfc 6979 known answer test failed for message %q", t.Msg)
+		}
+	}
+	return nil
+}
+
+// der encodes the signature (r, s) in DER.
+func der(r, s *big.Int) []byte {
+	return seq(integer(minimal(r)), integer(minimal(s)))
+}
+
+// minimal returns the minimal two's complement encoding of the non-negative x.
+func minimal(x *big.Int) []byte {
+	b := x.Bytes()
+	if len(b) == 0 || b[0]&0x80 != 0 {
+		b = append([]byte{0}, b...)
+	}
+	return b
+}
+
+// pad returns the encoding of x with a redundant leading zero byte.
+func pad(x *big.Int) []byte {
+	return append([]byte{0}, minimal(x)...)
+}
+
+// neg returns the encoding of x without the leading zero byte required to
+// make it positive, if it has its top bit set. Otherwise the top bit is set
+// directly.
+func neg(x *big.Int) []byte {
+	b := minimal(x)
+	if b[0] == 0 && len(b) > 1 {
+		return b[1:]
+	}
+	b[0] |= 0x80
+	return b
+}
+
+func seq(elements ...[]byte) []byte {
+	var content []byte
+	for _, e := range elements {
+		content = append(content, e...)
+	}
+	return tlv(0x30, content)
+}
+
+func integer(b []byte) []byte {
+	return tlv(0x02, b)
+}
+
+func tlv(tag byte, content []byte) []byte {
+	b := []byte{tag}
+	switch n := len(content); {
+	case n < 0x80:
+		b = append(b, byte(n))
+	case n < 0x100:
+		b = append(b, 0x81, byte(n))
+	default:
+		b = append(b, 0x82, byte(n>>8), byte(n))
+	}
+	return append(b, content...)
+}
+
+// longform re-encodes the outer sequence length of sig in a non-minimal form.
+func longform(sig []byte) []byte {
+	content := sig[2:]
+	if sig[1] == 0x81 {
+		content = sig[3:]
+	}
+	b := []byte{sig[0], 0x82, byte(len(content) >> 8), byte(len(content))}
+	return append(b, content...)
+}
+
+// size returns the length of x in bytes.
+func size(x *big.Int) int {
+	return (x.BitLen() + 7) / 8
+}
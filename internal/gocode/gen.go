@@ -25,6 +25,8 @@ func (g *Generator) Package(name string) {
 	g.Linef("package %s", name)
 }
 
+// Import writes an import declaration for the given packages. Standard library
+// packages are placed in a separate group before all others.
 func (g *Generator) Import(pkgs ...string) {
 	g.NL()
 	if len(pkgs) == 1 {
@@ -32,13 +34,35 @@ func (g *Generator) Import(pkgs ...string) {
 		return
 	}
 
-	g.Linef("import (")
+	var std, other []string
 	for _, pkg := range pkgs {
+		if isstd(pkg) {
+			std = append(std, pkg)
+		} else {
+			other = append(other, pkg)
+		}
+	}
+
+	g.Linef("import (")
+	for _, pkg := range std {
+		g.Linef("\t%q", pkg)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		g.NL()
+	}
+	for _, pkg := range other {
 		g.Linef("\t%q", pkg)
 	}
 	g.Linef(")")
 }
 
+// isstd reports whether pkg is a standard library import path. Following
+// goimports, these are the paths without a dot in the first element.
+func isstd(pkg string) bool {
+	first := strings.SplitN(pkg, "/", 2)[0]
+	return !strings.Contains(first, ".")
+}
+
 // Comment writes comment lines prefixed with "// ".
 func (g *Generator) Comment(lines ...string) {
 	for _, line := range lines {
@@ -0,0 +1,300 @@
+// Package h2cvectors generates hash-to-curve test vectors in the JSON format of
+// the RFC 9380 reference vectors.
+//
+// Vectors are generated for the suites CURVE_XMD:HASH_SSWU_RO_ and
+// CURVE_XMD:HASH_SSWU_NU_ using the messages and domain separation tags of RFC
+// 9380 Appendix J, with an independent math/big implementation. Only curves
+// with a = -3 and non-zero b are supported, for which the Simplified SWU map
+// applies directly. SelfCheck confirms the implementation against known answers
+// from the RFC.
+package h2cvectors
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/elliptic"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/mmcloughlin/ec3/internal/errutil"
+)
+
+// Suite is a hash-to-curve suite for a curve with a = -3.
+type Suite struct {
+	Curve         elliptic.Curve
+	Hash          crypto.Hash
+	HashName      string
+	SecurityLevel int
+	RandomOracle  bool
+
+	// Z is the Simplified SWU parameter, as a field element.
+	Z *big.Int
+}
+
+// messages are the test messages of RFC 9380 Appendix J.
+var messages = []string{
+	"",
+	"abc",
+	"abcdef0123456789",
+	"q128_" + strings.Repeat("q", 128),
+	"a512_" + strings.Repeat("a", 512),
+}
+
+// File is a test vector file.
+type File struct {
+	L            string   `json:"L"`
+	Z            string   `json:"Z"`
+	Ciphersuite  string   `json:"ciphersuite"`
+	Curve        string   `json:"curve"`
+	DST          string   `json:"dst"`
+	Expand       string   `json:"expand"`
+	Field        Field    `json:"field"`
+	Hash         string   `json:"hash"`
+	K            string   `json:"k"`
+	Map          Map      `json:"map"`
+	RandomOracle bool     `json:"randomOracle"`
+	Vectors      []Vector `json:"vectors"`
+}
+
+// Field describes the base field.
+type Field struct {
+	M string `json:"m"`
+	P string `json:"p"`
+}
+
+// Map names the mapping to the curve.
+type Map struct {
+	Name string `json:"name"`
+}
+
+// Vector is a single test vector.
+type Vector struct {
+	P   Point    `json:"P"`
+	Q0  Point    `json:"Q0"`
+	Q1  *Point   `json:"Q1,omitempty"`
+	Msg string   `json:"msg"`
+	U   []string `json:"u"`
+}
+
+// Point is an affine point.
+type Point struct {
+	X string `json:"x"`
+	Y string `json:"y"`
+}
+
+// Ciphersuite returns the suite identifier.
+func (s Suite) Ciphersuite() string {
+	mode := "NU_"
+	if s.RandomOracle {
+		mode = "RO_"
+	}
+	name := strings.ReplaceAll(s.Curve.Params().Name, "-", "")
+	return fmt.Sprintf("%s_XMD:%s_SSWU_%s", name, s.HashName, mode)
+}
+
+// DST returns the domain separation tag used in the RFC test vectors.
+func (s Suite) DST() string {
+	return "QUUX-V01-CS02-with-" + s.Ciphersuite()
+}
+
+// L returns the number of bytes hashed per field element.
+func (s Suite) L() int {
+	return (s.Curve.Params().P.BitLen() + s.SecurityLevel + 7) / 8
+}
+
+// File generates test vectors for the suite.
+func (s Suite) File() *File {
+	params := s.Curve.Params()
+	dst := []byte(s.DST())
+	f := &File{
+		L:            fmt.Sprintf("%#x", s.L()),
+		Z:            s.hex(s.Z),
+		Ciphersuite:  s.Ciphersuite(),
+		Curve:        "NIST " + params.Name,
+		DST:          string(dst),
+		Expand:       "XMD",
+		Field:        Field{M: "0x1", P: s.hex(params.P)},
+		Hash:         strings.ToLower(strings.ReplaceAll(s.HashName, "-", "")),
+		K:            fmt.Sprintf("%#x", s.SecurityLevel),
+		Map:          Map{Name: "SSWU"},
+		RandomOracle: s.RandomOracle,
+	}
+
+	for _, msg := range messages {
+		v := Vector{Msg: msg}
+		if s.RandomOracle {
+			u := s.HashToField([]byte(msg), dst, 2)
+			x0, y0 := s.MapToCurve(u[0])
+			x1, y1 := s.MapToCurve(u[1])
+			x, y := s.Curve.Add(x0, y0, x1, y1)
+			v.P = s.point(x, y)
+			v.Q0 = s.point(x0, y0)
+			q1 := s.point(x1, y1)
+			v.Q1 = &q1
+			v.U = []string{s.hex(u[0]), s.hex(u[1])}
+		} else {
+			u := s.HashToField([]byte(msg), dst, 1)
+			x, y := s.MapToCurve(u[0])
+			v.P = s.point(x, y)
+			v.Q0 = v.P
+			v.U = []string{s.hex(u[0])}
+		}
+		f.Vectors = append(f.Vectors, v)
+	}
+
+	return f
+}
+
+func (s Suite) point(x, y *big.Int) Point {
+	if !s.Curve.IsOnCurve(x, y) {
+		panic(errutil.AssertionFailure("generated point is not on the curve"))
+	}
+	return Point{X: s.hex(x), Y: s.hex(y)}
+}
+
+func (s Suite) hex(x *big.Int) string {
+	n := (s.Curve.Params().P.BitLen() + 7) / 8
+	return "0x" + hex.EncodeToString(x.FillBytes(make([]byte, n)))
+}
+
+// HashToField implements hash_to_field of RFC 9380 Section 5.2 for m = 1.
+func (s Suite) HashToField(msg, dst []byte, count int) []*big.Int {
+	L := s.L()
+	uniform, err := ExpandMessageXMD(s.Hash, msg, dst, count*L)
+	if err != nil {
+		panic(err)
+	}
+	u := make([]*big.Int, count)
+	for i := range u {
+		u[i] = new(big.Int).SetBytes(uniform[i*L : (i+1)*L])
+		u[i].Mod(u[i], s.Curve.Params().P)
+	}
+	return u
+}
+
+// MapToCurve implements the Simplified SWU map of RFC 9380 Section 6.6.2 for
+// curves with a = -3.
+func (s Suite) MapToCurve(u *big.Int) (x, y *big.Int) {
+	params := s.Curve.Params()
+	p := params.P
+	mod := func(x *big.Int) *big.Int { return x.Mod(x, p) }
+	A := big.NewInt(-3)
+	B := params.B
+	Z := s.Z
+	g := func(x *big.Int) *big.Int {
+		x3 := new(big.Int).Exp(x, big.NewInt(3), p)
+		return mod(x3.Add(x3, new(big.Int).Mul(A, x)).Add(x3, B))
+	}
+
+	// Step 1: tv1 = inv0(Z^2 * u^4 + Z * u^2)
+	u2 := mod(new(big.Int).Mul(u, u))
+	zu2 := mod(new(big.Int).Mul(Z, u2))
+	tv1 := mod(new(big.Int).Add(new(big.Int).Mul(zu2, zu2), zu2))
+	if tv1.Sign() != 0 {
+		tv1.ModInverse(tv1, p)
+	}
+
+	// Step 2-3: x1 = (-B / A) * (1 + tv1), or B / (Z * A) if tv1 == 0.
+	var x1 *big.Int
+	if tv1.Sign() == 0 {
+		x1 = mod(new(big.Int).Mul(B, new(big.Int).ModInverse(mod(new(big.Int).Mul(Z, A)), p)))
+	} else {
+		x1 = mod(new(big.Int).Mul(mod(new(big.Int).Neg(B)), new(big.Int).ModInverse(mod(new(big.Int).Set(A)), p)))
+		x1 = mod(x1.Mul(x1, new(big.Int).Add(tv1, big.NewInt(1))))
+	}
+
+	// Step 4-8: select the square of gx1 and gx2.
+	gx1 := g(x1)
+	x2 := mod(new(big.Int).Mul(zu2, x1))
+	gx2 := g(x2)
+	if y = new(big.Int).ModSqrt(gx1, p); y != nil {
+		x = x1
+	} else {
+		x = x2
+		y = new(big.Int).ModSqrt(gx2, p)
+	}
+
+	// Step 9: fix the sign of y.
+	if u.Bit(0) != y.Bit(0) {
+		y = mod(y.Neg(y))
+	}
+
+	return x, y
+}
+
+// ExpandMessageXMD implements expand_message_xmd of RFC 9380 Section 5.3.1.
+func ExpandMessageXMD(h crypto.Hash, msg, dst []byte, n int) ([]byte, error) {
+	if len(dst) > 255 {
+		d := h.New()
+		d.Write([]byte("H2C-OVERSIZE-DST-"))
+		d.Write(dst)
+		dst = d.Sum(nil)
+	}
+
+	b := h.Size()
+	ell := (n + b - 1) / b
+	if ell > 255 || n > 65535 {
+		return nil, errors.New("requested length too large")
+	}
+
+	dstprime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	d := h.New()
+	d.Write(make([]byte, h.New().BlockSize()))
+	d.Write(msg)
+	d.Write([]byte{byte(n >> 8), byte(n), 0})
+	d.Write(dstprime)
+	b0 := d.Sum(nil)
+
+	var uniform []byte
+	bi := make([]byte, b)
+	for i := 1; i <= ell; i++ {
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+		d.Reset()
+		d.Write(bi)
+		d.Write([]byte{byte(i)})
+		d.Write(dstprime)
+		bi = d.Sum(nil)
+		uniform = append(uniform, bi...)
+	}
+
+	return uniform[:n], nil
+}
+
+// SelfCheck confirms the implementation against known answers from RFC 9380
+// Appendices J.1.1 and K.1.
+func SelfCheck() error {
+	// expand_message_xmd with SHA-256.
+	uniform, err := ExpandMessageXMD(crypto.SHA256, nil, []byte("QUUX-V01-CS02-with-expander-SHA256-128"), 0x20)
+	if err != nil {
+		return err
+	}
+	expect, _ := hex.DecodeString("68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235")
+	if !bytes.Equal(uniform, expect) {
+		return errors.New("expand_message_xmd known answer test failed")
+	}
+
+	// P256_XMD:SHA-256_SSWU_RO_ with the empty message.
+	p := elliptic.P256().Params().P
+	s := Suite{
+		Curve:         elliptic.P256(),
+		Hash:          crypto.SHA256,
+		HashName:      "SHA-256",
+		SecurityLevel: 128,
+		RandomOracle:  true,
+		Z:             new(big.Int).Sub(p, big.NewInt(10)),
+	}
+	f := s.File()
+	P := f.Vectors[0].P
+	if P.X != "0x2c15230b26dbc6fc9a37051158c95b79656e17a1a920b11394ca91c44247d3e4" ||
+		P.Y != "0x8a7a74985cc5c776cdfe4b1f19884970453912e9d31528c060be9ab5c43e8415" {
+		return errors.New("P256_XMD:SHA-256_SSWU_RO_ known answer test failed")
+	}
+
+	return nil
+}
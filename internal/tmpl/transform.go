@@ -3,6 +3,7 @@ package tmpl
 import (
 	"fmt"
 	"go/ast"
+	"go/parser"
 	"go/token"
 	"strconv"
 	"strings"
@@ -99,6 +100,17 @@ func DefineIntHex(name string, value int) Transform {
 	return DefineLiteralf(name, token.INT, "%#x", value)
 }
 
+// DefineExpr replaces the identifier name with the Go expression expr.
+func DefineExpr(name, expr string) Transform {
+	x, err := parser.ParseExpr(expr)
+	if err != nil {
+		return visitor{
+			Error: func() error { return xerrors.Errorf("parse expression: %w", err) },
+		}
+	}
+	return replace(name, func(*ast.Ident) ast.Node { return x })
+}
+
 func DefineBool(name string, value bool) Transform {
 	return Rename(name, strconv.FormatBool(value))
 }
@@ -141,15 +153,18 @@ func Preprocessor() Transform {
 				return true
 			}
 
-			value, ok := evalbool(ifstmt.Cond)
+			// Else if statements are resolved with their parent.
+			if c.Index() < 0 {
+				return true
+			}
+
+			stmts, ok := branch(ifstmt)
 			if !ok {
 				return true
 			}
 
-			if value {
-				for _, stmt := range ifstmt.Body.List {
-					c.InsertBefore(stmt)
-				}
+			for _, stmt := range stmts {
+				c.InsertBefore(stmt)
 			}
 
 			c.Delete()
@@ -160,6 +175,32 @@ func Preprocessor() Transform {
 	}
 }
 
+// branch returns the statements of the branch taken by an if statement with a
+// constant condition. Chains of else if statements are followed while their
+// conditions are constant.
+func branch(ifstmt *ast.IfStmt) ([]ast.Stmt, bool) {
+	value, ok := evalbool(ifstmt.Cond)
+	if !ok {
+		return nil, false
+	}
+
+	if value {
+		return ifstmt.Body.List, true
+	}
+
+	switch e := ifstmt.Else.(type) {
+	case *ast.BlockStmt:
+		return e.List, true
+	case *ast.IfStmt:
+		if stmts, ok := branch(e); ok {
+			return stmts, true
+		}
+		return []ast.Stmt{e}, true
+	}
+
+	return nil, true
+}
+
 func evalbool(expr ast.Expr) (value, ok bool) {
 	ident, ok := expr.(*ast.Ident)
 	if !ok {
@@ -115,6 +115,20 @@ package pkg
 		Expect: `// Code generated by test. DO NOT EDIT.
 
 package pkg
+`,
+	},
+	{
+		Name: "define_expr",
+		Source: `package pkg
+import "crypto"
+var h crypto.Hash = ConstHash
+`,
+		Transforms: []Transform{
+			DefineExpr("ConstHash", "crypto.SHA256"),
+		},
+		Expect: `package pkg
+import "crypto"
+var h crypto.Hash = crypto.SHA256
 `,
 	},
 	{
@@ -140,7 +154,23 @@ func Foo(x int) int {
 		x += 11
 	}
 	x += 12
-	x += 13
+	if false {
+		x += 14
+	} else {
+		x += 15
+	}
+	if false {
+		x += 16
+	} else if true {
+		x += 17
+	} else {
+		x += 18
+	}
+	if false {
+		x += 19
+	} else if x > 100 {
+		x += 20
+	}
 	return x
 }
 `,
@@ -165,7 +195,14 @@ func Foo(x int) int {
 	x += 9
 
 	x += 12
-	x += 13
+
+	x += 15
+
+	x += 17
+
+	if x > 100 {
+		x += 20
+	}
 	return x
 }
 `,
@@ -0,0 +1,164 @@
+// Package weierstrass implements short Weierstrass curves y² = x³ + ax + b
+// with math/big, for use as a reference in tests and test vector generation.
+//
+// Unlike the generic implementation in crypto/elliptic, the coefficient a is
+// arbitrary, so curves such as secp256k1 with a = 0 are supported. The
+// implementation uses affine coordinates and is neither fast nor constant time.
+package weierstrass
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/mmcloughlin/ec3/internal/bigint"
+)
+
+// Curve is a short Weierstrass curve. It implements elliptic.Curve, and follows
+// the crypto/elliptic convention that (0,0) represents the point at infinity.
+type Curve struct {
+	*elliptic.CurveParams
+
+	// A is the curve coefficient a.
+	A *big.Int
+}
+
+// New returns the curve with parameters params and coefficient a.
+func New(params *elliptic.CurveParams, a *big.Int) *Curve {
+	return &Curve{
+		CurveParams: params,
+		A:           new(big.Int).Mod(a, params.P),
+	}
+}
+
+// Secp256k1 returns the curve secp256k1 specified in SEC 2, Version 2.0,
+// Section 2.4.1.
+func Secp256k1() *Curve {
+	return New(&elliptic.CurveParams{
+		Name:    "secp256k1",
+		P:       bigint.MustHex("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"),
+		N:       bigint.MustHex("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"),
+		B:       big.NewInt(7),
+		Gx:      bigint.MustHex("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"),
+		Gy:      bigint.MustHex("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"),
+		BitSize: 256,
+	}, big.NewInt(0))
+}
+
+// Params returns the parameters of the curve.
+func (c *Curve) Params() *elliptic.CurveParams {
+	return c.CurveParams
+}
+
+// Polynomial returns x³ + ax + b modulo p.
+func (c *Curve) Polynomial(x *big.Int) *big.Int {
+	y2 := new(big.Int).Mul(x, x)
+	y2.Add(y2, c.A)
+	y2.Mul(y2, x)
+	y2.Add(y2, c.B)
+	return y2.Mod(y2, c.P)
+}
+
+// IsOnCurve reports whether the given (x,y) lies on the curve.
+func (c *Curve) IsOnCurve(x, y *big.Int) bool {
+	if x.Sign() < 0 || x.Cmp(c.P) >= 0 || y.Sign() < 0 || y.Cmp(c.P) >= 0 {
+		return false
+	}
+	y2 := new(big.Int).Mul(y, y)
+	y2.Mod(y2, c.P)
+	return y2.Cmp(c.Polynomial(x)) == 0
+}
+
+// Lift returns a y coordinate such that (x,y) is on the curve, if one exists.
+func (c *Curve) Lift(x *big.Int) (*big.Int, bool) {
+	if x.Sign() < 0 || x.Cmp(c.P) >= 0 {
+		return nil, false
+	}
+	y := new(big.Int).ModSqrt(c.Polynomial(x), c.P)
+	if y == nil {
+		return nil, false
+	}
+	return y, true
+}
+
+// Add returns the sum of (x1,y1) and (x2,y2).
+func (c *Curve) Add(x1, y1, x2, y2 *big.Int) (x, y *big.Int) {
+	switch {
+	case infinity(x1, y1):
+		return bigint.Clone(x2), bigint.Clone(y2)
+	case infinity(x2, y2):
+		return bigint.Clone(x1), bigint.Clone(y1)
+	case x1.Cmp(x2) == 0 && y1.Cmp(y2) == 0:
+		return c.Double(x1, y1)
+	case x1.Cmp(x2) == 0:
+		return new(big.Int), new(big.Int)
+	}
+
+	// λ = (y2 - y1) / (x2 - x1)
+	l := new(big.Int).Sub(x2, x1)
+	l.ModInverse(l.Mod(l, c.P), c.P)
+	l.Mul(l, new(big.Int).Sub(y2, y1))
+
+	return c.line(l, x1, y1, x2)
+}
+
+// Double returns 2*(x1,y1).
+func (c *Curve) Double(x1, y1 *big.Int) (x, y *big.Int) {
+	if infinity(x1, y1) || y1.Sign() == 0 {
+		return new(big.Int), new(big.Int)
+	}
+
+	// λ = (3x₁² + a) / 2y₁
+	l := new(big.Int).Lsh(y1, 1)
+	l.ModInverse(l.Mod(l, c.P), c.P)
+	t := new(big.Int).Mul(x1, x1)
+	t.Mul(t, big.NewInt(3))
+	t.Add(t, c.A)
+	l.Mul(l, t)
+
+	return c.line(l, x1, y1, x1)
+}
+
+// line returns the third point of intersection of the curve with the line of
+// slope l through (x1,y1) and a point with x coordinate x2, negated.
+func (c *Curve) line(l, x1, y1, x2 *big.Int) (x, y *big.Int) {
+	l.Mod(l, c.P)
+
+	// x = λ² - x₁ - x₂
+	x = new(big.Int).Mul(l, l)
+	x.Sub(x, x1)
+	x.Sub(x, x2)
+	x.Mod(x, c.P)
+
+	// y = λ(x₁ - x) - y₁
+	y = new(big.Int).Sub(x1, x)
+	y.Mul(y, l)
+	y.Sub(y, y1)
+	y.Mod(y, c.P)
+
+	return x, y
+}
+
+// ScalarMult returns k*(x1,y1) where k is a number in big-endian form.
+func (c *Curve) ScalarMult(x1, y1 *big.Int, k []byte) (x, y *big.Int) {
+	x, y = new(big.Int), new(big.Int)
+	for _, b := range k {
+		for i := 7; i >= 0; i-- {
+			x, y = c.Double(x, y)
+			if (b>>uint(i))&1 == 1 {
+				x, y = c.Add(x, y, x1, y1)
+			}
+		}
+	}
+	return x, y
+}
+
+// ScalarBaseMult returns k*G, where G is the base point of the group and k is
+// an integer in big-endian form.
+func (c *Curve) ScalarBaseMult(k []byte) (x, y *big.Int) {
+	return c.ScalarMult(c.Gx, c.Gy, k)
+}
+
+// infinity reports whether (x,y) represents the point at infinity.
+func infinity(x, y *big.Int) bool {
+	return x.Sign() == 0 && y.Sign() == 0
+}
@@ -0,0 +1,101 @@
+package weierstrass
+
+import (
+	"crypto/elliptic"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/mmcloughlin/ec3/internal/bigint"
+)
+
+func TestCurveMatchesStandardLibrary(t *testing.T) {
+	std := elliptic.P256()
+	c := New(std.Params(), big.NewInt(-3))
+	r := rand.New(rand.NewSource(1))
+
+	for trial := 0; trial < 32; trial++ {
+		k1 := new(big.Int).Rand(r, c.N).Bytes()
+		k2 := new(big.Int).Rand(r, c.N).Bytes()
+		x1, y1 := std.ScalarBaseMult(k1)
+		x2, y2 := std.ScalarBaseMult(k2)
+
+		if !c.IsOnCurve(x1, y1) {
+			t.Fatal("point not on curve")
+		}
+
+		x, y := c.Add(x1, y1, x2, y2)
+		ex, ey := std.Add(x1, y1, x2, y2)
+		if x.Cmp(ex) != 0 || y.Cmp(ey) != 0 {
+			t.Fatal("add mismatch")
+		}
+
+		x, y = c.Double(x1, y1)
+		ex, ey = std.Double(x1, y1)
+		if x.Cmp(ex) != 0 || y.Cmp(ey) != 0 {
+			t.Fatal("double mismatch")
+		}
+
+		x, y = c.ScalarMult(x1, y1, k2)
+		ex, ey = std.ScalarMult(x1, y1, k2)
+		if x.Cmp(ex) != 0 || y.Cmp(ey) != 0 {
+			t.Fatal("scalar mult mismatch")
+		}
+	}
+}
+
+func TestSecp256k1KnownAnswers(t *testing.T) {
+	c := Secp256k1()
+	cases := []struct {
+		K    int64
+		X, Y string
+	}{
+		{
+			K: 2,
+			X: "c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5",
+			Y: "1ae168fea63dc339a3c58419466ceaeef7f632653266d0e1236431a950cfe52a",
+		},
+		{
+			K: 3,
+			X: "f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9",
+			Y: "388f7b0f632de8140fe337e62a37f3566500a99934c2231b6cb9fd7584b8e672",
+		},
+	}
+	for _, tc := range cases {
+		x, y := c.ScalarBaseMult(big.NewInt(tc.K).Bytes())
+		if !bigint.Equal(x, bigint.MustHex(tc.X)) || !bigint.Equal(y, bigint.MustHex(tc.Y)) {
+			t.Errorf("%d*G = (%x, %x)", tc.K, x, y)
+		}
+	}
+}
+
+func TestSecp256k1Order(t *testing.T) {
+	c := Secp256k1()
+	if !c.IsOnCurve(c.Gx, c.Gy) {
+		t.Fatal("generator not on curve")
+	}
+	x, y := c.ScalarBaseMult(c.N.Bytes())
+	if !infinity(x, y) {
+		t.Fatal("expected N*G to be the point at infinity")
+	}
+}
+
+func TestLift(t *testing.T) {
+	c := Secp256k1()
+	r := rand.New(rand.NewSource(1))
+	found := 0
+	for trial := 0; trial < 64; trial++ {
+		x := new(big.Int).Rand(r, c.P)
+		y, ok := c.Lift(x)
+		if !ok {
+			continue
+		}
+		found++
+		if !c.IsOnCurve(x, y) {
+			t.Fatal("lifted point not on curve")
+		}
+	}
+	if found == 0 {
+		t.Fatal("no points found")
+	}
+}
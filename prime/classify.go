@@ -0,0 +1,102 @@
+package prime
+
+import (
+	"math/big"
+
+	"github.com/mmcloughlin/ec3/internal/bigint"
+	"github.com/mmcloughlin/ec3/polynomial"
+)
+
+// Limits on the forms recognized by Classify.
+const (
+	// MaxCrandallBits is the largest bit length of c for which a prime 2ⁿ - c
+	// is classified as Crandall.
+	MaxCrandallBits = 32
+
+	// MaxSolinasTerms is the largest number of non-zero terms in the polynomial
+	// of a prime classified as Solinas.
+	MaxSolinasTerms = 5
+
+	// MinSolinasK is the smallest k for which a prime f(2ᵏ) is classified as
+	// Solinas. Smaller values would admit polynomials of impractically high
+	// degree.
+	MinSolinasK = 16
+)
+
+// Classify returns the most specific representation of p. Primes of the form
+// 2ⁿ - c for small c are Crandall. Otherwise, if the signed binary
+// representation of p is sparse and its exponents share a large common factor
+// k, it is Solinas. All other primes are returned as Other.
+func Classify(p *big.Int) Prime {
+	if q, ok := crandall(p); ok {
+		return q
+	}
+	if q, ok := solinas(p); ok {
+		return q
+	}
+	return NewOther(p)
+}
+
+// crandall attempts to represent p as a Crandall prime.
+func crandall(p *big.Int) (Crandall, bool) {
+	n := p.BitLen()
+	c := new(big.Int).Sub(bigint.Pow2(uint(n)), p)
+	if c.Sign() <= 0 || c.BitLen() > MaxCrandallBits {
+		return Crandall{}, false
+	}
+	return NewCrandall(n, int(c.Int64())), true
+}
+
+// solinas attempts to represent p as a Solinas prime.
+func solinas(p *big.Int) (Solinas, bool) {
+	terms := naf(p)
+	if len(terms) > MaxSolinasTerms {
+		return Solinas{}, false
+	}
+
+	// Determine the largest k dividing all exponents.
+	k := uint(0)
+	for _, t := range terms {
+		k = gcd(k, t.N)
+	}
+	if k < MinSolinasK {
+		return Solinas{}, false
+	}
+
+	for i := range terms {
+		terms[i].N /= k
+	}
+	return NewSolinas(terms, k), true
+}
+
+// naf returns the non-adjacent form of x, a signed binary representation with
+// the fewest non-zero digits. Terms are in increasing order of exponent.
+func naf(x *big.Int) polynomial.Polynomial {
+	x = bigint.Clone(x)
+	terms := polynomial.Polynomial{}
+	for n := uint(0); x.Sign() > 0; n++ {
+		if x.Bit(0) == 1 {
+			// Choose the digit ±1 that makes x divisible by 4.
+			a := 1 - 2*int64(x.Bit(1))
+			x.Sub(x, big.NewInt(a))
+			terms = append(terms, polynomial.Term{A: a, N: n})
+		}
+		x.Rsh(x, 1)
+	}
+	return terms
+}
+
+// gcd returns the greatest common divisor of a and b.
+func gcd(a, b uint) uint {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// IsMontgomeryFriendly reports whether p ≡ -1 (mod 2⁶⁴). For such primes the
+// Montgomery constant -p⁻¹ mod 2⁶⁴ is 1, so word-by-word Montgomery reduction
+// needs no multiplication to determine the quotient digit.
+func IsMontgomeryFriendly(p *big.Int) bool {
+	return bigint.TrailingZeros(new(big.Int).Add(p, bigint.One())) >= 64
+}
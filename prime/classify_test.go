@@ -0,0 +1,60 @@
+package prime
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestClassifyDistinguished(t *testing.T) {
+	for _, p := range Distinguished {
+		got := Classify(p.Int())
+		if reflect.TypeOf(got) != reflect.TypeOf(p) {
+			t.Errorf("Classify(%s) returned %T; expect %T", p, got, p)
+			continue
+		}
+		if got.String() != p.String() {
+			t.Errorf("Classify(%s) = %s", p, got)
+		}
+	}
+}
+
+func TestClassifyLargeCrandallConstant(t *testing.T) {
+	// secp256k1 is 2²⁵⁶ - 2³² - 977, which has too large a constant to be
+	// considered Crandall and too many terms to be Solinas.
+	if _, ok := Classify(Secp256k1.Int()).(Other); !ok {
+		t.Fatal("expected other")
+	}
+}
+
+func TestIsMontgomeryFriendly(t *testing.T) {
+	cases := []struct {
+		P      Prime
+		Expect bool
+	}{
+		{NISTP256, true},
+		{NISTP384, false},
+		{NISTP224, false},
+		{P25519, false},
+		{Secp256k1, false},
+	}
+	for _, c := range cases {
+		if got := IsMontgomeryFriendly(c.P.Int()); got != c.Expect {
+			t.Errorf("IsMontgomeryFriendly(%s) = %v; expect %v", c.P, got, c.Expect)
+		}
+	}
+}
+
+func TestNAF(t *testing.T) {
+	for x := int64(0); x < 1024; x++ {
+		terms := naf(big.NewInt(x))
+		if got := terms.Evaluate(big.NewInt(2)); got.Int64() != x {
+			t.Fatalf("naf(%d) evaluates to %s", x, got)
+		}
+		for i := 1; i < len(terms); i++ {
+			if terms[i].N-terms[i-1].N < 2 {
+				t.Fatalf("naf(%d) = %s has adjacent non-zero digits", x, terms)
+			}
+		}
+	}
+}
@@ -0,0 +1,282 @@
+// Command calibrate derives an empirical cost model from benchmarks of a
+// generated field implementation.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/big"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+
+	"golang.org/x/xerrors"
+
+	asmfp "github.com/mmcloughlin/ec3/asm/fp"
+	"github.com/mmcloughlin/ec3/efd/cost"
+	"github.com/mmcloughlin/ec3/gen"
+	"github.com/mmcloughlin/ec3/gen/fp"
+	"github.com/mmcloughlin/ec3/internal/cli"
+	"github.com/mmcloughlin/ec3/name"
+	"github.com/mmcloughlin/ec3/prime"
+)
+
+// Command line flags.
+var (
+	modulus   = flag.String("prime", prime.NISTP256.Int().String(), "field prime (decimal, or hex with 0x prefix)")
+	mul       = flag.String("mul", "auto", "field multiplication (auto, separated or interleaved)")
+	benchtime = flag.String("benchtime", "1s", "benchmark time passed to go test")
+	workdir   = flag.String("work", "", "work directory for the generated package (default temporary)")
+	output    = flag.String("output", "", "path to output weights file (default stdout)")
+)
+
+func main() {
+	log.SetPrefix("calibrate: ")
+	log.SetFlags(0)
+
+	flag.Parse()
+
+	p, ok := new(big.Int).SetString(*modulus, 0)
+	if !ok || !p.ProbablyPrime(20) {
+		log.Fatalf("invalid prime %q", *modulus)
+	}
+
+	field, err := fp.ParseField(prime.NewOther(p), *mul)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Prepare work directory.
+	dir := *workdir
+	if dir == "" {
+		tmp, err := ioutil.TempDir("", "calibrate")
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer os.RemoveAll(tmp)
+		dir = tmp
+	}
+
+	// Generate and benchmark.
+	fs, err := Package(field)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := fs.Output(dir); err != nil {
+		log.Fatal(err)
+	}
+
+	results, err := Benchmark(dir, *benchtime)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, op := range operations {
+		log.Printf("%s\t%.2f ns/op", op, results[op])
+	}
+
+	w, err := Calibrate(results)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Output.
+	_, out, err := cli.OpenOutput(*output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+
+	if err := cost.WriteWeights(out, w); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Package generates a standalone Go module containing an implementation of the
+// given field, together with benchmarks of its operations.
+func Package(field asmfp.Field) (gen.Files, error) {
+	cfg := fp.Config{
+		Field: field,
+
+		// The inversion chain is found with the default search, as in generated
+		// packages, so that the inversion benchmark measures a realistic chain.
+
+		PackageName:     "calibrate",
+		ElementTypeName: "Elt",
+		FilenamePrefix:  "fp",
+		Scheme:          name.Plain,
+	}
+
+	fs, err := fp.Package(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	mod, sum, err := Module()
+	if err != nil {
+		return nil, err
+	}
+
+	fs.Add("go.mod", mod)
+	fs.Add("go.sum", sum)
+	fs.Add("fp_test.go", []byte(benchmarks))
+
+	return fs, nil
+}
+
+// dependencies lists the modules imported by generated field packages.
+var dependencies = []string{
+	"golang.org/x/sys",
+}
+
+// Module returns go.mod and go.sum files for the generated package, with
+// dependencies pinned to the versions required by the current module.
+func Module() (mod, sum []byte, err error) {
+	modbuf := bytes.NewBufferString("module calibrate\n\ngo 1.14\n\n")
+	sumbuf := bytes.NewBuffer(nil)
+	for _, path := range dependencies {
+		out, err := exec.Command("go", "list", "-m", "-json", path).Output()
+		if err != nil {
+			return nil, nil, xerrors.Errorf("go list %s: %w", path, err)
+		}
+
+		var m struct {
+			Path, Version, Sum, GoModSum string
+		}
+		if err := json.Unmarshal(out, &m); err != nil {
+			return nil, nil, err
+		}
+
+		fmt.Fprintf(modbuf, "require %s %s\n", m.Path, m.Version)
+		fmt.Fprintf(sumbuf, "%s %s %s\n", m.Path, m.Version, m.Sum)
+		fmt.Fprintf(sumbuf, "%s %s/go.mod %s\n", m.Path, m.Version, m.GoModSum)
+	}
+	return modbuf.Bytes(), sumbuf.Bytes(), nil
+}
+
+// benchmarks is the source of the benchmark file added to the generated
+// package. The constant multiply benchmark computes 3*x in the same way
+// op3.Lower would, namely with additions.
+const benchmarks = `package calibrate
+
+import "testing"
+
+var x, y, z Elt
+
+func init() {
+	x.SetInt64(0x1f2e3d4c5b6a7988)
+	y.SetInt64(0x0123456789abcdef)
+}
+
+func BenchmarkMul(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Mul(&z, &x, &y)
+	}
+}
+
+func BenchmarkSqr(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Sqr(&z, &x)
+	}
+}
+
+func BenchmarkAdd(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Add(&z, &x, &y)
+	}
+}
+
+func BenchmarkSub(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Sub(&z, &x, &y)
+	}
+}
+
+func BenchmarkInv(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Inv(&z, &x)
+	}
+}
+
+func BenchmarkConstMul(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Add(&z, &x, &x)
+		Add(&z, &z, &x)
+	}
+}
+`
+
+// operations is the list of benchmarked operations.
+var operations = []string{"Mul", "Sqr", "Add", "Sub", "Inv", "ConstMul"}
+
+// benchline matches a line of benchmark output.
+var benchline = regexp.MustCompile(`^Benchmark(\w+?)(-\d+)?\s+\d+\s+([0-9.]+) ns/op`)
+
+// Benchmark runs benchmarks in the package at dir and returns the time per
+// operation in nanoseconds, keyed by benchmark name.
+func Benchmark(dir, benchtime string) (map[string]float64, error) {
+	cmd := exec.Command("go", "test", "-run=^$", "-bench=.", "-benchtime="+benchtime)
+	cmd.Dir = dir
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, xerrors.Errorf("go test: %w", err)
+	}
+
+	results := map[string]float64{}
+	s := bufio.NewScanner(bytes.NewReader(out))
+	for s.Scan() {
+		m := benchline.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+		ns, err := strconv.ParseFloat(m[3], 64)
+		if err != nil {
+			return nil, err
+		}
+		results[m[1]] = ns
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// Calibrate builds cost model weights from benchmark results, normalized such
+// that a multiply has weight one.
+func Calibrate(results map[string]float64) (cost.Weights, error) {
+	for _, op := range operations {
+		if results[op] <= 0 {
+			return cost.Weights{}, xerrors.Errorf("missing benchmark result for %s", op)
+		}
+	}
+
+	m := results["Mul"]
+	relative := func(ns float64) float64 {
+		return math.Round(1000*ns/m) / 1000
+	}
+
+	s := relative(results["Sqr"])
+	return cost.Weights{
+		I: relative(results["Inv"]),
+		M: 1,
+		S: s,
+		// Non-square powers in the database are almost always cubes, computed
+		// with a square and a multiply.
+		Pow: s + 1,
+		// Parameter multiplies are executed as general multiplies.
+		ParamM: 1,
+		Add:    relative((results["Add"] + results["Sub"]) / 2),
+		ConstM: relative(results["ConstMul"]),
+	}, nil
+}
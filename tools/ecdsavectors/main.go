@@ -0,0 +1,88 @@
+// Command ecdsavectors generates ECDSA test vectors in the Wycheproof JSON
+// format. See package internal/ecdsavectors for a description of the vectors.
+//
+// Output is reproducible for a given seed.
+package main
+
+import (
+	"crypto"
+	"crypto/elliptic"
+	_ "crypto/sha256" // register hash functions
+	_ "crypto/sha512"
+	"encoding/json"
+	"flag"
+	"log"
+	"math/rand"
+
+	"github.com/mmcloughlin/ec3/internal/cli"
+	"github.com/mmcloughlin/ec3/internal/ecdsavectors"
+)
+
+// Command line flags.
+var (
+	curvename = flag.String("curve", "P-256", "curve name")
+	hashname  = flag.String("hash", "SHA-256", "hash function")
+	numkeys   = flag.Int("keys", 2, "number of verification key groups")
+	numsigs   = flag.Int("sigs", 4, "number of valid signatures per key")
+	seed      = flag.Int64("seed", 1, "random seed")
+	output    = flag.String("output", "", "path to output file (default stdout)")
+)
+
+var curves = map[string]elliptic.Curve{
+	"P-224": elliptic.P224(),
+	"P-256": elliptic.P256(),
+	"P-384": elliptic.P384(),
+	"P-521": elliptic.P521(),
+}
+
+var hashes = map[string]crypto.Hash{
+	"SHA-224": crypto.SHA224,
+	"SHA-256": crypto.SHA256,
+	"SHA-384": crypto.SHA384,
+	"SHA-512": crypto.SHA512,
+}
+
+func main() {
+	log.SetPrefix("ecdsavectors: ")
+	log.SetFlags(0)
+
+	flag.Parse()
+
+	c, ok := curves[*curvename]
+	if !ok {
+		log.Fatalf("unknown curve %q", *curvename)
+	}
+
+	h, ok := hashes[*hashname]
+	if !ok {
+		log.Fatalf("unknown hash %q", *hashname)
+	}
+
+	if err := ecdsavectors.SelfCheck(); err != nil {
+		log.Fatal(err)
+	}
+
+	g := &ecdsavectors.Generator{
+		Curve:    c,
+		HashName: *hashname,
+		Hash:     h,
+		Rand:     rand.New(rand.NewSource(*seed)),
+	}
+
+	f := g.File(*numkeys, *numsigs)
+
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, out, err := cli.OpenOutput(*output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+
+	if _, err := out.Write(append(b, '\n')); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,71 @@
+// Command h2cvectors generates hash-to-curve test vectors for the NIST curves,
+// in the JSON format of the RFC 9380 reference vectors. See package
+// internal/h2cvectors for a description of the vectors. The implementation is
+// checked against known answers from the RFC before any output is produced.
+package main
+
+import (
+	"crypto"
+	"crypto/elliptic"
+	_ "crypto/sha256" // register hash functions
+	_ "crypto/sha512"
+	"encoding/json"
+	"flag"
+	"log"
+	"math/big"
+
+	"github.com/mmcloughlin/ec3/gen/curve"
+	"github.com/mmcloughlin/ec3/internal/cli"
+	"github.com/mmcloughlin/ec3/internal/h2cvectors"
+)
+
+// Command line flags.
+var (
+	curvename = flag.String("curve", "P-256", "curve name")
+	encode    = flag.Bool("nu", false, "generate vectors for the nonuniform encoding suite")
+	output    = flag.String("output", "", "path to output file (default stdout)")
+)
+
+var suites = map[string]h2cvectors.Suite{
+	"P-256": {Curve: elliptic.P256(), Hash: crypto.SHA256, HashName: "SHA-256", SecurityLevel: 128},
+	"P-384": {Curve: elliptic.P384(), Hash: crypto.SHA384, HashName: "SHA-384", SecurityLevel: 192},
+	"P-521": {Curve: elliptic.P521(), Hash: crypto.SHA512, HashName: "SHA-512", SecurityLevel: 256},
+}
+
+func main() {
+	log.SetPrefix("h2cvectors: ")
+	log.SetFlags(0)
+
+	flag.Parse()
+
+	s, ok := suites[*curvename]
+	if !ok {
+		log.Fatalf("unknown curve %q", *curvename)
+	}
+	s.RandomOracle = !*encode
+
+	params := s.Curve.Params()
+	a := new(big.Int).Sub(params.P, big.NewInt(3))
+	s.Z = curve.SSWUZ(params.P, a, params.B)
+
+	if err := h2cvectors.SelfCheck(); err != nil {
+		log.Fatal(err)
+	}
+
+	f := s.File()
+
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, out, err := cli.OpenOutput(*output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+
+	if _, err := out.Write(append(b, '\n')); err != nil {
+		log.Fatal(err)
+	}
+}
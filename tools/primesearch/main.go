@@ -0,0 +1,279 @@
+// Command primesearch searches for primes with structure suited to fast field
+// arithmetic.
+//
+// The search mode selects the candidates:
+//
+//	solinas  f(2ᵏ) for monic polynomials f with coefficients in {-1, 0, 1},
+//	         constant term ±1 and a bounded number of non-zero terms
+//	mont     Montgomery-friendly primes 2ᵇ - 2⁶⁴j - 1, which are ≡ -1 mod 2⁶⁴
+//	sqrt     primes 2ᵇ - c with c ≡ 1 mod 4, which are ≡ 3 mod 4 and so admit
+//	         the fast square root
+//
+// In the mont and sqrt modes, candidates are taken in increasing order of j or
+// c for each bit size b. In all modes results may additionally be restricted to
+// Montgomery-friendly primes or primes ≡ 3 mod 4. Output is either Go source
+// ready to paste into the prime package, or a JSON listing.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"strings"
+
+	"github.com/mmcloughlin/ec3/internal/bigint"
+	"github.com/mmcloughlin/ec3/internal/cli"
+	"github.com/mmcloughlin/ec3/polynomial"
+	"github.com/mmcloughlin/ec3/prime"
+)
+
+// Command line flags.
+var (
+	mode      = flag.String("mode", "solinas", "search mode (solinas, mont or sqrt)")
+	trials    = flag.Int("trials", 32, "how many trials of the Miller-Rabin test")
+	k         = flag.Uint("k", 32, "solinas mode: polynomial variable exponent, primes are f(2^k)")
+	weight    = flag.Uint("weight", 4, "solinas mode: maximum number of non-zero polynomial terms")
+	step      = flag.Uint("step", 64, "mont and sqrt modes: step between bit sizes searched")
+	count     = flag.Int("count", 1, "mont and sqrt modes: number of primes to report per bit size")
+	maxoffset = flag.Uint64("max-offset", 1<<16, "mont and sqrt modes: bound on the offset j or c")
+	minbits   = flag.Uint("min-bits", 192, "minimum prime size in bits")
+	maxbits   = flag.Uint("max-bits", 512, "maximum prime size in bits")
+	mont      = flag.Bool("mont", false, "only report Montgomery-friendly primes (p = -1 mod 2^64)")
+	sqrt      = flag.Bool("sqrt", false, "only report primes with p = 3 mod 4")
+	format    = flag.String("format", "go", "output format (go or json)")
+	output    = flag.String("output", "", "path to output file (default stdout)")
+)
+
+func main() {
+	log.SetPrefix("primesearch: ")
+	log.SetFlags(0)
+
+	flag.Parse()
+
+	write, ok := writers[*format]
+	if !ok {
+		log.Fatalf("unknown format %q", *format)
+	}
+
+	// accept reports whether the candidate should be reported.
+	accept := func(r *Result) bool {
+		switch {
+		case r.Bits < int(*minbits) || r.Bits > int(*maxbits):
+		case *mont && !r.MontgomeryFriendly:
+		case *sqrt && !r.ThreeModFour:
+		case !r.Prime.Int().ProbablyPrime(*trials):
+		default:
+			return true
+		}
+		return false
+	}
+
+	results := []*Result{}
+	switch *mode {
+	case "solinas":
+		if *k < 2 {
+			log.Fatal("k must be at least 2")
+		}
+		if *weight < 2 {
+			log.Fatal("weight must be at least 2")
+		}
+
+		// Search each degree in the bit range.
+		for d := (*minbits + *k - 1) / *k; d*(*k) <= *maxbits; d++ {
+			Search(*k, d, *weight, func(p prime.Prime) {
+				if r := NewResult(p); accept(r) {
+					results = append(results, r)
+				}
+			})
+		}
+
+	case "mont", "sqrt":
+		search := SearchSqrt
+		if *mode == "mont" {
+			if *minbits <= 64 {
+				log.Fatal("min-bits must exceed 64 in mont mode")
+			}
+			search = SearchMontgomeryFriendly
+		}
+		if *step == 0 {
+			log.Fatal("step must be positive")
+		}
+
+		// Report the first primes found for each size.
+		for b := *minbits; b <= *maxbits; b += *step {
+			found := 0
+			search(b, *maxoffset, func(p prime.Prime) {
+				if found >= *count {
+					return
+				}
+				if r := NewResult(p); accept(r) {
+					results = append(results, r)
+					found++
+				}
+			})
+		}
+
+	default:
+		log.Fatalf("unknown mode %q", *mode)
+	}
+
+	_, out, err := cli.OpenOutput(*output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+
+	if err := write(out, results); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Search calls emit with every Solinas candidate f(2ᵏ), where f is monic of
+// degree d with coefficients in {-1, 0, 1}, constant term ±1 and at most w
+// non-zero terms. Candidates are not tested for primality.
+func Search(k, d, w uint, emit func(prime.Prime)) {
+	lead := polynomial.Term{A: 1, N: d}
+	for _, a := range []int64{-1, 1} {
+		f := polynomial.Polynomial{{A: a, N: 0}}
+		middle(f, 1, d, w-2, func(f polynomial.Polynomial) {
+			g := append(polynomial.Polynomial{}, f...)
+			g = append(g, lead)
+			emit(prime.NewSolinas(g, k))
+		})
+	}
+}
+
+// SearchMontgomeryFriendly calls emit with the Montgomery-friendly candidates
+// 2ᵇ - 2⁶⁴j - 1 for 0 ⩽ j < n, in increasing order of j. These are the
+// numbers of b bits congruent to -1 modulo 2⁶⁴, in decreasing order. Requires
+// b > 64. Candidates are not tested for primality.
+func SearchMontgomeryFriendly(b uint, n uint64, emit func(prime.Prime)) {
+	top := bigint.Pow2(b)
+	for j := uint64(0); j < n; j++ {
+		x := new(big.Int).SetUint64(j)
+		x.Lsh(x, 64)
+		x.Add(x, bigint.One())
+		x.Sub(top, x)
+		if x.BitLen() != int(b) {
+			return
+		}
+		emit(prime.NewOther(x))
+	}
+}
+
+// SearchSqrt calls emit with the candidates 2ᵇ - c for 0 < c < n with
+// c ≡ 1 mod 4, in increasing order of c. These are congruent to 3 modulo 4.
+// Candidates are not tested for primality.
+func SearchSqrt(b uint, n uint64, emit func(prime.Prime)) {
+	for c := uint64(1); c < n && c < 1<<31; c += 4 {
+		emit(prime.NewCrandall(int(b), int(c)))
+	}
+}
+
+// middle extends f with up to w terms of exponent in [lo, hi), in increasing
+// order, calling emit for each extension.
+func middle(f polynomial.Polynomial, lo, hi, w uint, emit func(polynomial.Polynomial)) {
+	emit(f)
+	if w == 0 {
+		return
+	}
+	for n := lo; n < hi; n++ {
+		for _, a := range []int64{-1, 1} {
+			middle(append(f, polynomial.Term{A: a, N: n}), n+1, hi, w-1, emit)
+		}
+	}
+}
+
+// Result is a prime found by the search.
+type Result struct {
+	Prime              prime.Prime `json:"-"`
+	Name               string      `json:"name"`
+	Bits               int         `json:"bits"`
+	K                  uint        `json:"k,omitempty"`
+	Terms              []Term      `json:"terms,omitempty"`
+	Decimal            string      `json:"decimal"`
+	MontgomeryFriendly bool        `json:"montgomeryFriendly"`
+	ThreeModFour       bool        `json:"threeModFour"`
+}
+
+// Term is the polynomial term A*xᴺ.
+type Term struct {
+	A int64 `json:"a"`
+	N uint  `json:"n"`
+}
+
+// NewResult builds a result for p. Solinas polynomials are rewritten in the
+// largest possible k, and other primes take the representation found by
+// prime.Classify, so that results match the prime package definitions.
+func NewResult(p prime.Prime) *Result {
+	x := p.Int()
+	q := prime.Classify(x)
+	if s, ok := p.(prime.Solinas); ok {
+		if qs, ok := q.(prime.Solinas); ok && qs.K > s.K {
+			p = qs
+		}
+	} else if _, ok := q.(prime.Other); !ok {
+		p = q
+	}
+	r := &Result{
+		Prime:              p,
+		Name:               p.String(),
+		Bits:               x.BitLen(),
+		Decimal:            x.String(),
+		MontgomeryFriendly: prime.IsMontgomeryFriendly(x),
+		ThreeModFour:       new(big.Int).And(x, big.NewInt(3)).Int64() == 3,
+	}
+	if s, ok := p.(prime.Solinas); ok {
+		r.K = s.K
+		for _, t := range s.F {
+			r.Terms = append(r.Terms, Term{A: t.A, N: t.N})
+		}
+	}
+	return r
+}
+
+// writers maps format names to output functions.
+var writers = map[string]func(io.Writer, []*Result) error{
+	"go":   WriteGo,
+	"json": WriteJSON,
+}
+
+// WriteGo writes results as prime package constructor calls, each preceded by
+// a comment showing the prime.
+func WriteGo(w io.Writer, results []*Result) error {
+	for _, r := range results {
+		if _, err := fmt.Fprintf(w, "// %s\n%s\n\n", r.Name, constructor(r.Prime)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// constructor returns a prime package expression constructing p.
+func constructor(p prime.Prime) string {
+	switch p := p.(type) {
+	case prime.Solinas:
+		terms := []string{}
+		for _, t := range p.F {
+			terms = append(terms, fmt.Sprintf("{A: %d, N: %d}", t.A, t.N))
+		}
+		return fmt.Sprintf("prime.NewSolinas(polynomial.Polynomial{%s}, %d)", strings.Join(terms, ", "), p.K)
+	case prime.Crandall:
+		return fmt.Sprintf("prime.NewCrandall(%d, %d)", p.N, p.C)
+	default:
+		return fmt.Sprintf("prime.MustHex(%q)", p.Int().Text(16))
+	}
+}
+
+// WriteJSON writes results as a JSON array.
+func WriteJSON(w io.Writer, results []*Result) error {
+	b, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}